@@ -1,14 +1,18 @@
 package build
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"text/tabwriter"
+	"text/template"
 	"time"
 
 	"github.com/wailsapp/wails/v2/internal/colour"
@@ -49,15 +53,15 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 	compressFlags := ""
 	command.StringFlag("upxflags", "Flags to pass to upx", &compressFlags)
 
-	// Setup Platform flag
-	platform := runtime.GOOS + "/"
-	if system.IsAppleSilicon {
-		platform += "arm64"
-	} else {
-		platform += runtime.GOARCH
-	}
+	nsis := false
+	command.BoolFlag("nsis", "Windows only: create an installer with NSIS (if installed)", &nsis)
 
-	command.StringFlag("platform", "Platform to target. Comma separate multiple platforms", &platform)
+	nsisFlags := ""
+	command.StringFlag("nsisflags", "Flags to pass to makensis", &nsisFlags)
+
+	// Setup Platform flag
+	platform := hostPlatform()
+	command.StringFlag("platform", "Platform to target. Comma separate multiple platforms. 'current' is the current platform and 'all' is every platform that can be built on it", &platform)
 
 	// Verbosity
 	verbosity := 1
@@ -72,7 +76,7 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 	command.StringFlag("tags", "tags to pass to Go compiler (quoted and space separated)", &tags)
 
 	outputFilename := ""
-	command.StringFlag("o", "Output filename", &outputFilename)
+	command.StringFlag("o", "Output filename. May be a template for multiple platforms, EG: myapp-{{.Platform}}-{{.Arch}}{{.Ext}}", &outputFilename)
 
 	// Clean build directory
 	cleanBuildDirectory := false
@@ -93,20 +97,75 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 	debug := false
 	command.BoolFlag("debug", "Retains debug data in the compiled application", &debug)
 
+	portable := false
+	command.BoolFlag("portable", "Windows only: embed the WebView2 runtime in build/windows/webview2 so the app runs without WebView2 installed", &portable)
+
+	keepSymbols := false
+	command.BoolFlag("keepsymbols", "Keeps the symbol table and debug information in production builds, for crash reports", &keepSymbols)
+
+	debugSymbols := false
+	command.BoolFlag("debugsymbols", "Production builds only: strip the application and save its debug symbols next to it, as a .dSYM on macOS or a .debug file on Windows and Linux", &debugSymbols)
+
+	signIdentity := ""
+	command.StringFlag("codesign", "Mac only: sign the application bundle, and the code nested in it, with the given identity. Use '-' to sign ad hoc", &signIdentity)
+
+	windowsConsole := false
+	command.BoolFlag("windowsconsole", "Windows only: build a console application, which shows a console window for logging", &windowsConsole)
+
+	excludeAssets := false
+	command.BoolFlag("excludeassets", "Production builds only: exclude source maps, or the files matching build:excludeassets in wails.json, from the embedded frontend assets", &excludeAssets)
+
+	profile := false
+	command.BoolFlag("profile", "Serve the pprof endpoint on localhost in the application, for profiling", &profile)
+
+	arm64ec := false
+	command.BoolFlag("arm64ec", "Experimental, windows/arm64 only: build for the ARM64EC ABI. Requires a toolchain that supports windows/arm64ec", &arm64ec)
+
+	frontendDevServerURL := ""
+	command.StringFlag("frontenddevserverurl", "Debug builds only, Windows and Linux: load the frontend from the given dev server url, EG: http://localhost:3000", &frontendDevServerURL)
+
+	logFormat := "text"
+	command.StringFlag("logformat", "Log output format: text or json", &logFormat)
+
+	parallel := 1
+	command.IntFlag("parallel", "Build up to the given number of platforms at the same time", &parallel)
+
+	manifest := ""
+	command.StringFlag("manifest", "Write a JSON manifest of the built targets to the given file", &manifest)
+
+	timestamps := ""
+	command.StringFlag("timestamps", "Prefix log lines with a timestamp: elapsed or wallclock", &timestamps)
+
+	printConfig := false
+	command.BoolFlag("printconfig", "Print the effective build options of each target as JSON and exit without building", &printConfig)
+
 	command.Action(func() error {
 
-		quiet := verbosity == 0
+		// Only the config is printed with -printconfig
+		quiet := verbosity == 0 || printConfig
 
 		// Create logger
 		logger := clilogger.New(w)
 		logger.Mute(quiet)
+		format, err := clilogger.ParseFormat(logFormat)
+		if err != nil {
+			return err
+		}
+		logger.SetFormat(format)
+		timestampMode, err := clilogger.ParseTimestamps(timestamps)
+		if err != nil {
+			return err
+		}
+		logger.SetTimestamps(timestampMode)
+		// Show a spinner during long build phases, unless the output is logged by CI
+		logger.SetProgress(verbosity == 1 && !logger.IsJSON() && clilogger.IsTerminal(w))
 
 		// Validate output type
 		if !validTargetTypes.Contains(outputType) {
 			return fmt.Errorf("output type '%s' is not valid", outputType)
 		}
 
-		if !quiet {
+		if !quiet && !logger.IsJSON() {
 			app.PrintBanner()
 		}
 
@@ -115,22 +174,17 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 		if err != nil {
 			return fmt.Errorf("unable to find compiler: %s", compilerCommand)
 		}
-
-		// Tags
-		experimental := false
-		userTags := []string{}
-		for _, tag := range strings.Split(tags, " ") {
-			thisTag := strings.TrimSpace(tag)
-			if thisTag != "" {
-				userTags = append(userTags, thisTag)
-			}
-			if thisTag == "exp" {
-				experimental = true
-			}
+		toolchain, err := build.DetectToolchain(compilerPath)
+		if err != nil {
+			return err
 		}
 
-		if runtime.GOOS == "linux" && !experimental {
-			return fmt.Errorf("Linux version coming soon!")
+		// Tags: those given with -tags come first, followed by those in WAILS_BUILD_TAGS that aren't already given
+		userTags := mergeTags(parseTags(tags), parseTags(os.Getenv(buildTagsEnvVar)))
+		experimental := slicer.String(userTags).Contains("exp")
+
+		if arm64ec && !experimental {
+			return fmt.Errorf("flag 'arm64ec' is experimental: add 'exp' to the build tags with -tags or %s to use it, EG: -tags exp", buildTagsEnvVar)
 		}
 
 		// Webview2 installer strategy (download by default)
@@ -152,6 +206,10 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 			}
 		}
 
+		if portable && webview2 != "download" && webview2 != "embed" {
+			return fmt.Errorf("the 'webview2' flag can't be '%s' for portable builds", webview2)
+		}
+
 		mode := build.Production
 		modeString := "Production"
 		if debug {
@@ -159,38 +217,114 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 			modeString = "Debug"
 		}
 
+		if parallel < 1 {
+			return fmt.Errorf("invalid value for flag 'parallel': %d. It must be at least 1", parallel)
+		}
+
+		if debugSymbols && keepSymbols {
+			return fmt.Errorf("flags 'debugsymbols' and 'keepsymbols' can't be used together")
+		}
+		if debugSymbols && debug {
+			logger.Warning("Warning: debugsymbols flag only supported for production builds. Ignoring.")
+		}
+
+		if frontendDevServerURL != "" {
+			if !debug {
+				return fmt.Errorf("flag 'frontenddevserverurl' can only be used with '-debug'")
+			}
+			devServerURL, err := url.Parse(frontendDevServerURL)
+			if err != nil || devServerURL.Scheme == "" || devServerURL.Host == "" {
+				return fmt.Errorf("invalid url for flag 'frontenddevserverurl': %s", frontendDevServerURL)
+			}
+		}
+
 		var targets slicer.StringSlicer
-		targets.AddSlice(strings.Split(platform, ","))
+		for _, target := range strings.Split(platform, ",") {
+			switch target = normalisePlatform(target); target {
+			case "":
+			case "current":
+				targets.Add(hostPlatform())
+			case "all":
+				buildable, unbuildable := buildablePlatforms()
+				targets.AddSlice(buildable)
+				if len(unbuildable) > 0 {
+					logger.Println("Skipping platforms that can't be built on %s: %s", hostPlatform(), strings.Join(unbuildable, ","))
+				}
+			default:
+				targets.Add(target)
+			}
+		}
 		targets.Deduplicate()
+		platform = targets.Join(",")
+
+		// A toolchain for the wrong architecture fails in confusing ways, so it is reported before building
+		if !printConfig {
+			warnings, err := toolchain.Check(targets.AsSlice())
+			if err != nil {
+				return err
+			}
+			for _, warning := range warnings {
+				logger.Warning("Warning: %s", warning)
+			}
+		}
 
 		// Create BuildOptions
 		buildOptions := &build.Options{
-			Logger:              logger,
-			OutputType:          outputType,
-			OutputFile:          outputFilename,
-			CleanBuildDirectory: cleanBuildDirectory,
-			Mode:                mode,
-			Pack:                !noPackage,
-			LDFlags:             ldflags,
-			Compiler:            compilerCommand,
-			SkipModTidy:         skipModTidy,
-			Verbosity:           verbosity,
-			ForceBuild:          forceBuild,
-			IgnoreFrontend:      skipFrontend,
-			Compress:            compress,
-			CompressFlags:       compressFlags,
-			UserTags:            userTags,
-			WebView2Strategy:    wv2rtstrategy,
-		}
-
-		// Start a new tabwriter
+			Logger:               logger,
+			OutputType:           outputType,
+			OutputFile:           outputFilename,
+			CleanBuildDirectory:  cleanBuildDirectory,
+			Mode:                 mode,
+			Pack:                 !noPackage,
+			LDFlags:              ldflags,
+			Compiler:             compilerCommand,
+			SkipModTidy:          skipModTidy,
+			Verbosity:            verbosity,
+			ForceBuild:           forceBuild,
+			IgnoreFrontend:       skipFrontend,
+			Compress:             compress,
+			CompressFlags:        compressFlags,
+			UserTags:             userTags,
+			WebView2Strategy:     wv2rtstrategy,
+			FrontendDevServerURL: frontendDevServerURL,
+			Portable:             portable,
+			KeepSymbols:          keepSymbols,
+			WindowsConsole:       windowsConsole,
+			ExcludeAssets:        excludeAssets,
+			Profile:              profile,
+			ARM64EC:              arm64ec,
+			DebugSymbols:         debugSymbols,
+			SignIdentity:         signIdentity,
+			NSIS:                 nsis,
+			NSISFlags:            nsisFlags,
+		}
+
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		buildOptions.ProjectDirectory = cwd
+		projectOptions, err := project.Load(cwd)
+		if err != nil {
+			return err
+		}
+
+		// Start a new tabwriter. The summary is logged line by line for json output.
+		var summary bytes.Buffer
 		w := new(tabwriter.Writer)
-		w.Init(os.Stdout, 8, 8, 0, '\t', 0)
+		if logger.IsJSON() || printConfig {
+			w.Init(&summary, 0, 8, 1, ' ', 0)
+		} else {
+			w.Init(os.Stdout, 8, 8, 0, '\t', 0)
+		}
 
 		// Write out the system information
 		fmt.Fprintf(w, "App Type: \t%s\n", buildOptions.OutputType)
 		fmt.Fprintf(w, "Platforms: \t%s\n", platform)
-		fmt.Fprintf(w, "Compiler: \t%s\n", compilerPath)
+		if projectOptions.Identifier != "" {
+			fmt.Fprintf(w, "Identifier: \t%s\n", projectOptions.Identifier)
+		}
+		fmt.Fprintf(w, "Compiler: \t%s (%s)\n", compilerPath, toolchain)
 		fmt.Fprintf(w, "Build Mode: \t%s\n", modeString)
 		fmt.Fprintf(w, "Skip Frontend: \t%t\n", skipFrontend)
 		fmt.Fprintf(w, "Compress: \t%t\n", buildOptions.Compress)
@@ -198,43 +332,86 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 		fmt.Fprintf(w, "Clean Build Dir: \t%t\n", buildOptions.CleanBuildDirectory)
 		fmt.Fprintf(w, "LDFlags: \t\"%s\"\n", buildOptions.LDFlags)
 		fmt.Fprintf(w, "Tags: \t[%s]\n", strings.Join(buildOptions.UserTags, ","))
+		if buildOptions.FrontendDevServerURL != "" {
+			fmt.Fprintf(w, "Frontend Dev Server: \t%s\n", buildOptions.FrontendDevServerURL)
+		}
+		if buildOptions.ExcludeAssets && !debug {
+			fmt.Fprintf(w, "Exclude Assets: \t%t\n", buildOptions.ExcludeAssets)
+		}
+		if buildOptions.DebugSymbols && !debug {
+			fmt.Fprintf(w, "Debug Symbols: \t%t\n", buildOptions.DebugSymbols)
+		}
+		if buildOptions.Profile {
+			fmt.Fprintf(w, "Profile: \t%t\n", buildOptions.Profile)
+		}
+		if buildOptions.SignIdentity != "" {
+			fmt.Fprintf(w, "Sign Identity: \t%s\n", buildOptions.SignIdentity)
+		}
+		if buildOptions.Portable {
+			fmt.Fprintf(w, "Portable: \t%t\n", buildOptions.Portable)
+		}
+		if buildOptions.NSIS {
+			fmt.Fprintf(w, "NSIS Installer: \t%t\n", buildOptions.NSIS)
+		}
+		if buildOptions.ARM64EC {
+			fmt.Fprintf(w, "ABI: \t%s (windows/arm64)\n", "arm64ec")
+		}
+		if strings.Contains(platform, "windows") {
+			// Debug builds always have a console
+			fmt.Fprintf(w, "Windows Console: \t%t\n", buildOptions.WindowsConsole || debug)
+		}
+		if parallel > 1 && targets.Length() > 1 {
+			fmt.Fprintf(w, "Parallel: \t%d\n", parallel)
+		}
 		if len(buildOptions.OutputFile) > 0 && targets.Length() == 1 {
 			fmt.Fprintf(w, "Output File: \t%s\n", buildOptions.OutputFile)
 		}
 		fmt.Fprintf(w, "\n")
 		w.Flush()
+		if logger.IsJSON() {
+			for _, line := range strings.Split(summary.String(), "\n") {
+				logger.Println(strings.Join(strings.Fields(line), " "))
+			}
+		}
 
-		err = checkGoModVersion(logger, updateGoMod)
-		if err != nil {
-			return err
+		if !printConfig {
+			err = checkGoModVersion(logger, updateGoMod)
+			if err != nil {
+				return err
+			}
 		}
 
-		cwd, err := os.Getwd()
-		if err != nil {
-			return err
+		// Every target is recorded in the manifest, including those that failed or were skipped
+		manifestEntries := make([]manifestEntry, targets.Length())
+		// The effective options of each target, for -printconfig
+		targetOptions := make([]*build.Options, targets.Length())
+
+		// The output filename is a template, rendered for each target
+		var outputTemplate *template.Template
+		if outputFilename != "" {
+			outputTemplate, err = parseOutputTemplate(outputFilename, targets.Length())
+			if err != nil {
+				return err
+			}
 		}
-		projectOptions, err := project.Load(cwd)
+		outputFiles := slicer.String()
+		var outputFilesLock sync.Mutex
+
+		// buildTarget builds a target with the given options and logger. Returns the manifest entry of the target.
+		buildTarget := func(index int, platform string, buildOptions *build.Options, logger *clilogger.CLILogger) (entry manifestEntry) {
+
+			entry = manifestEntry{Platform: platform, Mode: modeString}
+			buildOptions.Logger = logger
 
-		// Check platform
-		validPlatformArch := slicer.String([]string{
-			"darwin",
-			"darwin/amd64",
-			"darwin/arm64",
-			"darwin/universal",
-			"linux",
-			"linux/amd64",
-			"linux/arm64",
-			"windows",
-			"windows/amd64",
-			"windows/arm64",
-		})
-
-		targets.Each(func(platform string) {
-
-			if !validPlatformArch.Contains(platform) {
-				buildOptions.Logger.Println("platform '%s' is not supported - skipping. Supported platforms: %s", platform, validPlatformArch.Join(","))
+			// Calculate platform and arch
+			targetPlatform, targetArch, err := build.ParseTarget(platform)
+			if err != nil {
+				buildOptions.Logger.Println("%s - skipping. Supported platforms: %s", err.Error(), strings.Join(build.SupportedTargets(), ","))
+				entry.Error = err.Error()
 				return
 			}
+			buildOptions.Platform = targetPlatform
+			buildOptions.Arch = targetArch
 
 			desiredFilename := projectOptions.OutputFilename
 			if desiredFilename == "" {
@@ -242,36 +419,73 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 			}
 			desiredFilename = strings.TrimSuffix(desiredFilename, ".exe")
 
-			// Calculate platform and arch
-			platformSplit := strings.Split(platform, "/")
-			buildOptions.Platform = platformSplit[0]
-			if system.IsAppleSilicon {
-				buildOptions.Arch = "arm64"
-			} else {
-				buildOptions.Arch = runtime.GOARCH
-			}
-			if len(platformSplit) == 2 {
-				buildOptions.Arch = platformSplit[1]
-			}
+			entry.Platform = buildOptions.Platform
+			entry.Arch = buildOptions.Arch
 
+			// Add the flags and tags configured for the target in the project config
+			targetLDFlags := projectOptions.LDFlags(buildOptions.Platform, buildOptions.Arch)
+			targetTags := projectOptions.Tags(buildOptions.Platform, buildOptions.Arch)
+			buildOptions.LDFlags = strings.TrimSpace(ldflags + " " + targetLDFlags)
+			buildOptions.UserTags = append(append([]string{}, userTags...), targetTags...)
+
+			logger.SetTarget(platform)
 			banner := "Building target: " + platform
 			logger.Println(banner)
 			logger.Println(strings.Repeat("-", len(banner)))
+			if targetLDFlags != "" {
+				logger.Println("Target LDFlags: \"%s\"", targetLDFlags)
+			}
+			if len(targetTags) > 0 {
+				logger.Println("Target Tags: [%s]", strings.Join(targetTags, ","))
+			}
+			if buildOptions.ABI() != buildOptions.Arch {
+				logger.Println("ABI: %s", buildOptions.ABI())
+			}
 
+			buildOptions.Compress = compress
 			if compress && platform == "darwin/universal" {
-				logger.Println("Warning: compress flag unsupported for universal binaries. Ignoring.")
-				compress = false
+				logger.Warning("Warning: compress flag unsupported for universal binaries. Ignoring.")
+				buildOptions.Compress = false
+			}
+
+			if portable && buildOptions.Platform != "windows" {
+				logger.Warning("Warning: portable flag only supported for Windows. Ignoring.")
+			}
+
+			if nsis && buildOptions.Platform != "windows" {
+				logger.Warning("Warning: nsis flag only supported for Windows. Ignoring.")
+			}
+
+			buildOptions.FrontendDevServerURL = frontendDevServerURL
+			if frontendDevServerURL != "" && buildOptions.Platform == "darwin" {
+				logger.Warning("Warning: frontenddevserverurl flag only supported for Windows and Linux. Ignoring.")
+				buildOptions.FrontendDevServerURL = ""
+			}
+
+			if signIdentity != "" && buildOptions.Platform != "darwin" {
+				logger.Warning("Warning: codesign flag only supported for Mac. Ignoring.")
+			}
+
+			if arm64ec && (buildOptions.Platform != "windows" || buildOptions.Arch != "arm64") {
+				logger.Warning("Warning: arm64ec flag only supported for windows/arm64. Ignoring.")
 			}
 
 			switch buildOptions.Platform {
 			case "linux":
 				if runtime.GOOS != "linux" {
 					logger.Println("Crosscompiling to Linux not currently supported.\n")
+					entry.Error = "crosscompiling to Linux is not currently supported"
+					return
+				}
+				if !experimental {
+					logger.Println("Linux version coming soon! Add 'exp' to the build tags with -tags or %s to try it.\n", buildTagsEnvVar)
+					entry.Error = "building for Linux is experimental: add 'exp' to the build tags with -tags or " + buildTagsEnvVar
 					return
 				}
 			case "darwin":
 				if runtime.GOOS != "darwin" {
 					logger.Println("Crosscompiling to Mac not currently supported.\n")
+					entry.Error = "crosscompiling to Mac is not currently supported"
 					return
 				}
 				macTargets := targets.Filter(func(platform string) bool {
@@ -295,28 +509,265 @@ func AddBuildSubcommand(app *clir.Cli, w io.Writer) {
 				desiredFilename += ".exe"
 			}
 			buildOptions.OutputFile = desiredFilename
+			if outputTemplate != nil {
+				outputFile, err := renderOutputTemplate(outputTemplate, buildOptions.Platform, buildOptions.Arch, projectOptions.Info.ProductVersion)
+				outputFilesLock.Lock()
+				if err == nil && outputFiles.Contains(outputFile) {
+					err = fmt.Errorf("the output filename '%s' is the same as a previous target. Add {{.Platform}} and {{.Arch}} to the -o template", outputFile)
+				}
+				if err == nil {
+					outputFiles.Add(outputFile)
+				}
+				outputFilesLock.Unlock()
+				if err != nil {
+					logger.Error("Error: %s", err.Error())
+					entry.Error = err.Error()
+					return
+				}
+				buildOptions.OutputFile = outputFile
+			}
+
+			if printConfig {
+				// The options are printed with the defaults applied when building
+				err := build.ResolveOptions(buildOptions)
+				if err != nil {
+					logger.Error("Error: %s", err.Error())
+					entry.Error = err.Error()
+					return
+				}
+				targetOptions[index] = buildOptions.Clone()
+				return
+			}
 
 			// Start Time
 			start := time.Now()
 
 			outputFilename, err := build.Build(buildOptions)
+			entry.ElapsedMS = time.Since(start).Milliseconds()
 			if err != nil {
-				logger.Println("Error: ", err.Error())
+				logger.Error("Error: %s", err.Error())
+				entry.Error = err.Error()
 				return
 			}
-
-			// Subsequent iterations
-			buildOptions.IgnoreFrontend = true
-			buildOptions.CleanBuildDirectory = false
+			entry.Output = outputFilename
+			if info, err := os.Stat(outputFilename); err == nil {
+				entry.Size = info.Size()
+			}
 
 			// Output stats
-			buildOptions.Logger.Println(fmt.Sprintf("Built '%s' in %s.\n", outputFilename, time.Since(start).Round(time.Millisecond).String()))
+			if buildOptions.DebugSymbolsFile != "" {
+				buildOptions.Logger.Println("Debug Symbols: %s", buildOptions.DebugSymbolsFile)
+			}
+			if len(buildOptions.ExtraFilesCopied) > 0 {
+				buildOptions.Logger.Println("Extra Files: %s", strings.Join(buildOptions.ExtraFilesCopied, ", "))
+			}
+			if buildOptions.InstallerFile != "" {
+				entry.Installer = buildOptions.InstallerFile
+				buildOptions.Logger.Println("Installer: %s", buildOptions.InstallerFile)
+			}
+			buildOptions.Logger.Println(fmt.Sprintf("Built '%s' (ABI: %s) in %s.\n", outputFilename, buildOptions.ABI(), time.Since(start).Round(time.Millisecond).String()))
+			return
+		}
 
-		})
+		if parallel > 1 && targets.Length() > 1 && !printConfig {
+			err = buildParallel(targets.AsSlice(), parallel, buildOptions, logger, func(index int, platform string, options *build.Options, logger *clilogger.CLILogger) {
+				manifestEntries[index] = buildTarget(index, platform, options, logger)
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			for index, platform := range targets.AsSlice() {
+				manifestEntries[index] = buildTarget(index, platform, buildOptions, logger)
+				if manifestEntries[index].Error == "" && !printConfig {
+					// Subsequent iterations
+					buildOptions.IgnoreFrontend = true
+					buildOptions.CleanBuildDirectory = false
+				}
+			}
+		}
+
+		if printConfig {
+			configs := make([]targetConfig, targets.Length())
+			for index, platform := range targets.AsSlice() {
+				configs[index] = targetConfig{Target: platform, Options: targetOptions[index], Error: manifestEntries[index].Error}
+			}
+			return printTargetConfigs(logger.Writer, configs)
+		}
+
+		if manifest != "" {
+			err = writeManifest(manifest, manifestEntries)
+			if err != nil {
+				return fmt.Errorf("unable to write the manifest: %s", err.Error())
+			}
+			logger.Println("Wrote the build manifest to '%s'.", manifest)
+		}
 		return nil
 	})
 }
 
+// buildParallel builds the targets with up to the given number of workers. The frontend is built once beforehand.
+// Each target is built with a clone of the options and a logger whose output is buffered, and written once the
+// target has been built, so the output of the targets isn't interleaved.
+func buildParallel(targets []string, workers int, buildOptions *build.Options, logger *clilogger.CLILogger, buildTarget func(index int, platform string, options *build.Options, logger *clilogger.CLILogger)) error {
+	err := build.BuildFrontend(buildOptions)
+	if err != nil {
+		return err
+	}
+
+	if workers > len(targets) {
+		workers = len(targets)
+	}
+	logger.Println("Building %d platforms, %d at a time.\n", len(targets), workers)
+
+	var outputLock sync.Mutex
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				var output bytes.Buffer
+				targetLogger := logger.WithWriter(&output)
+				targetLogger.SetTarget(targets[index])
+				buildTarget(index, targets[index], buildOptions.Clone(), targetLogger)
+
+				outputLock.Lock()
+				_, _ = logger.Writer.Write(output.Bytes())
+				outputLock.Unlock()
+			}
+		}()
+	}
+	for index := range targets {
+		jobs <- index
+	}
+	close(jobs)
+	wg.Wait()
+	return nil
+}
+
+// hostPlatform returns the platform and architecture of the host
+func hostPlatform() string {
+	if system.IsAppleSilicon {
+		return runtime.GOOS + "/arm64"
+	}
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
+
+// buildablePlatforms returns the platforms built by `-platform all`, which are those that can be built on the
+// host, and those that can't. macOS and Linux use CGO, so only the host architecture can be built, except on macOS
+// where the compiler of Xcode builds both, as for the universal binary. Windows doesn't use CGO, so it can be
+// built on any host. The universal macOS binary is left out, as it combines the amd64 and arm64 binaries.
+func buildablePlatforms() (buildable []string, unbuildable []string) {
+	host := hostPlatform()
+	for _, target := range build.SupportedTargets() {
+		if !strings.Contains(target, "/") || target == "darwin/universal" {
+			continue
+		}
+		targetOS := strings.Split(target, "/")[0]
+		if target == host || targetOS == "windows" || (targetOS == "darwin" && runtime.GOOS == "darwin") {
+			buildable = append(buildable, target)
+		} else {
+			unbuildable = append(unbuildable, target)
+		}
+	}
+	return buildable, unbuildable
+}
+
+// outputTemplateData is the data of the -o template
+type outputTemplateData struct {
+	Platform string // EG: windows
+	Arch     string // EG: amd64
+	Ext      string // ".exe" on Windows, otherwise empty
+	Version  string // The productVersion of the project
+}
+
+// parseOutputTemplate parses the output filename given to -o. A filename without placeholders can only be used
+// for a single target, as each target would overwrite it.
+func parseOutputTemplate(outputFilename string, targetCount int) (*template.Template, error) {
+	outputTemplate, err := template.New("output").Option("missingkey=error").Parse(outputFilename)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template for flag 'o': %s", err.Error())
+	}
+	if targetCount > 1 && !strings.Contains(outputFilename, "{{") {
+		return nil, fmt.Errorf("the output filename '%s' would be overwritten by each platform. Use a template to name the output of each platform, EG: -o \"myapp-{{.Platform}}-{{.Arch}}{{.Ext}}\"", outputFilename)
+	}
+	return outputTemplate, nil
+}
+
+// renderOutputTemplate returns the output filename for the given target
+func renderOutputTemplate(outputTemplate *template.Template, platform string, arch string, version string) (string, error) {
+	data := outputTemplateData{
+		Platform: platform,
+		Arch:     arch,
+		Version:  version,
+	}
+	if platform == "windows" {
+		data.Ext = ".exe"
+	}
+	var result bytes.Buffer
+	err := outputTemplate.Execute(&result, data)
+	if err != nil {
+		return "", fmt.Errorf("unable to render the output filename: %s", err.Error())
+	}
+	if strings.TrimSpace(result.String()) == "" {
+		return "", fmt.Errorf("the output filename for %s/%s is empty", platform, arch)
+	}
+	return result.String(), nil
+}
+
+// Common names for the platforms and architectures, which are accepted by the platform flag
+var platformAliases = map[string]string{
+	"win":     "windows",
+	"mac":     "darwin",
+	"osx":     "darwin",
+	"x64":     "amd64",
+	"aarch64": "arm64",
+}
+
+// buildTagsEnvVar is the environment variable holding build tags that are added to those given with -tags
+const buildTagsEnvVar = "WAILS_BUILD_TAGS"
+
+// parseTags splits space separated build tags, ignoring empty ones
+func parseTags(tags string) []string {
+	result := []string{}
+	for _, tag := range strings.Split(tags, " ") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// mergeTags returns the tags followed by the extra tags, without duplicates
+func mergeTags(tags []string, extra []string) []string {
+	result := []string{}
+	seen := slicer.String()
+	for _, tag := range append(append([]string{}, tags...), extra...) {
+		if !seen.Contains(tag) {
+			seen.Add(tag)
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// normalisePlatform trims and lowercases a platform given to the platform flag and replaces aliases,
+// EG: " Win/x64" becomes "windows/amd64"
+func normalisePlatform(platform string) string {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(platform)), "/")
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if alias, ok := platformAliases[part]; ok {
+			part = alias
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, "/")
+}
+
 func checkGoModVersion(logger *clilogger.CLILogger, updateGoMod bool) error {
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -343,7 +794,7 @@ func checkGoModVersion(logger *clilogger.CLILogger, updateGoMod bool) error {
 		return syncGoModVersion(cwd)
 	}
 
-	logger.Println("Warning: go.mod is using Wails '%s' but the CLI is '%s'. Consider updating your project's `go.mod` file.\n", gomodversion.String(), internal.Version)
+	logger.Warning("Warning: go.mod is using Wails '%s' but the CLI is '%s'. Consider updating your project's `go.mod` file.\n", gomodversion.String(), internal.Version)
 	return nil
 }
 
@@ -0,0 +1,37 @@
+package build
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// manifestEntry describes a target built by `wails build`, for the file given with -manifest
+type manifestEntry struct {
+	Platform  string `json:"platform"`
+	Arch      string `json:"arch,omitempty"`
+	Output    string `json:"output,omitempty"`
+	Size      int64  `json:"size,omitempty"`
+	Installer string `json:"installer,omitempty"`
+	Mode      string `json:"mode"`
+	ElapsedMS int64  `json:"elapsedMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+// writeManifest writes the entries to the given file as a JSON array
+func writeManifest(filename string, entries []manifestEntry) error {
+	if entries == nil {
+		entries = []manifestEntry{}
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(filename); dir != "" {
+		err = os.MkdirAll(dir, 0755)
+		if err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filename, append(data, '\n'), 0644)
+}
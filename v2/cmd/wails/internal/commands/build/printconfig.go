@@ -0,0 +1,28 @@
+package build
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/wailsapp/wails/v2/pkg/commands/build"
+)
+
+// targetConfig is the effective configuration of a target, printed by -printconfig
+type targetConfig struct {
+	Target  string         `json:"target"`
+	Options *build.Options `json:"options,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// printTargetConfigs writes the configurations to the writer as a JSON array
+func printTargetConfigs(writer io.Writer, configs []targetConfig) error {
+	if configs == nil {
+		configs = []targetConfig{}
+	}
+	data, err := json.MarshalIndent(configs, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(append(data, '\n'))
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"os/exec"
@@ -33,20 +34,39 @@ import (
 	"github.com/wailsapp/wails/v2/pkg/commands/build"
 )
 
-const defaultDevServerURL = "http://localhost:34115"
+const (
+	defaultDevServerHost = "localhost"
+	defaultDevServerPort = 34115
+	defaultDevServerURL  = "http://localhost:34115"
+)
+
+// jsonLogger is used by the Log functions when the log format is json
+var jsonLogger *clilogger.CLILogger
 
 func LogGreen(message string, args ...interface{}) {
 	text := fmt.Sprintf(message, args...)
+	if jsonLogger != nil {
+		jsonLogger.Println(text)
+		return
+	}
 	println(colour.Green(text))
 }
 
 func LogRed(message string, args ...interface{}) {
 	text := fmt.Sprintf(message, args...)
+	if jsonLogger != nil {
+		jsonLogger.Error(text)
+		return
+	}
 	println(colour.Red(text))
 }
 
 func LogDarkYellow(message string, args ...interface{}) {
 	text := fmt.Sprintf(message, args...)
+	if jsonLogger != nil {
+		jsonLogger.Warning(text)
+		return
+	}
 	println(colour.DarkYellow(text))
 }
 
@@ -73,7 +93,13 @@ type devFlags struct {
 	forceBuild      bool
 	debounceMS      int
 	devServerURL    string
+	devServerHost   string
+	devServerPort   int
 	appargs         string
+	logFormat       string
+
+	// The address the built-in dev server listens on, EG: localhost:34115
+	devServer string
 }
 
 // AddSubcommand adds the `dev` command for the Wails application
@@ -94,14 +120,26 @@ func AddSubcommand(app *clir.Cli, w io.Writer) error {
 	command.IntFlag("v", "Verbosity level (0 - silent, 1 - standard, 2 - verbose)", &flags.verbosity)
 	command.StringFlag("loglevel", "Loglevel to use - Trace, Debug, Info, Warning, Error", &flags.loglevel)
 	command.BoolFlag("f", "Force build application", &flags.forceBuild)
-	command.IntFlag("debounce", "The amount of time to wait to trigger a reload on change", &flags.debounceMS)
+	command.IntFlag("debounce", "The time in milliseconds to wait for further changes before rebuilding or reloading", &flags.debounceMS)
 	command.StringFlag("devserverurl", "The url of the dev server to use", &flags.devServerURL)
+	command.StringFlag("devserverhost", "The host the built-in dev server listens on", &flags.devServerHost)
+	command.IntFlag("devserverport", "The port the built-in dev server listens on. A free port is used if it is taken", &flags.devServerPort)
 	command.StringFlag("appargs", "arguments to pass to the underlying app (quoted and space searated)", &flags.appargs)
+	command.StringFlag("logformat", "Log output format: text or json", &flags.logFormat)
 
 	command.Action(func() error {
 		// Create logger
 		logger := clilogger.New(w)
-		app.PrintBanner()
+		format, err := clilogger.ParseFormat(flags.logFormat)
+		if err != nil {
+			return err
+		}
+		logger.SetFormat(format)
+		if logger.IsJSON() {
+			jsonLogger = logger
+		} else {
+			app.PrintBanner()
+		}
 
 		experimental := false
 		userTags := []string{}
@@ -129,6 +167,15 @@ func AddSubcommand(app *clir.Cli, w io.Writer) error {
 			return err
 		}
 
+		flags.devServer, err = negotiateDevServer(flags.devServerHost, flags.devServerPort)
+		if err != nil {
+			return err
+		}
+		// The built-in dev server is used unless a 3rd party dev server has been given
+		if flags.devServerURL == defaultDevServerURL {
+			flags.devServerURL = "http://" + flags.devServer
+		}
+
 		// Update go.mod to use current wails version
 		err = syncGoModVersion(cwd)
 		if err != nil {
@@ -180,11 +227,7 @@ func AddSubcommand(app *clir.Cli, w io.Writer) error {
 
 		// open browser
 		if flags.openBrowser {
-			url := defaultDevServerURL
-			if flags.devServerURL != "" {
-				url = flags.devServerURL
-			}
-			err = browser.OpenURL(url)
+			err = browser.OpenURL(flags.devServerURL)
 			if err != nil {
 				return err
 			}
@@ -267,10 +310,13 @@ func runCommand(dir string, exitOnError bool, command string, args ...string) er
 func defaultDevFlags() devFlags {
 	return devFlags{
 		devServerURL:    defaultDevServerURL,
+		devServerHost:   defaultDevServerHost,
+		devServerPort:   defaultDevServerPort,
 		compilerCommand: "go",
 		verbosity:       1,
 		extensions:      "go",
 		debounceMS:      100,
+		logFormat:       "text",
 	}
 }
 
@@ -350,6 +396,10 @@ func loadAndMergeProjectConfig(cwd string, flags *devFlags) (*project.Project, e
 		shouldSaveConfig = true
 	}
 
+	if flags.debounceMS < 0 {
+		return nil, fmt.Errorf("invalid debounce of %d milliseconds", flags.debounceMS)
+	}
+
 	if flags.debounceMS == 100 && projectConfig.DebounceMS != 100 {
 		if projectConfig.DebounceMS == 0 {
 			projectConfig.DebounceMS = 100
@@ -454,6 +504,27 @@ func initialiseWatcher(cwd string, logFatal func(string, ...interface{})) (*fsno
 	return watcher, nil
 }
 
+// negotiateDevServer returns the address for the built-in dev server to listen on.
+// If the requested port is taken, a free port is chosen instead.
+func negotiateDevServer(host string, port int) (string, error) {
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		listener, err = net.Listen("tcp", net.JoinHostPort(host, "0"))
+		if err != nil {
+			return "", fmt.Errorf("unable to find a free port for the dev server: %s", err.Error())
+		}
+		freePort := listener.Addr().(*net.TCPAddr).Port
+		LogDarkYellow("Port %d is in use, using port %d for the dev server", port, freePort)
+		address = net.JoinHostPort(host, strconv.Itoa(freePort))
+	}
+	err = listener.Close()
+	if err != nil {
+		return "", err
+	}
+	return address, nil
+}
+
 // restartApp does the actual rebuilding of the application when files change
 func restartApp(buildOptions *build.Options, debugBinaryProcess *process.Process, flags devFlags, exitCodeChannel chan int) (*process.Process, string, error) {
 
@@ -487,6 +558,7 @@ func restartApp(buildOptions *build.Options, debugBinaryProcess *process.Process
 	os.Setenv("loglevel", flags.loglevel)
 	os.Setenv("assetdir", flags.assetDir)
 	os.Setenv("devserverurl", flags.devServerURL)
+	os.Setenv("devserver", flags.devServer)
 
 	// Start up new binary with correct args
 	newProcess := process.NewProcess(appBinary, args...)
@@ -505,6 +577,36 @@ func restartApp(buildOptions *build.Options, debugBinaryProcess *process.Process
 	return newProcess, appBinary, nil
 }
 
+// addDirectoryToWatcher adds a newly created directory to the watcher
+func addDirectoryToWatcher(watcher *fsnotify.Watcher, dir string, logger *clilogger.CLILogger) {
+	//node_modules is BANNED!
+	if strings.Contains(dir, "node_modules") {
+		return
+	}
+	err := watcher.Add(dir)
+	if err != nil {
+		logger.Fatal("%s", err.Error())
+	}
+	LogGreen("Added new directory to watcher: %s", dir)
+}
+
+// isEditorTempFile returns true if the given file is a temporary file written by an editor while saving,
+// EG: vim swap files or emacs lock files
+func isEditorTempFile(filename string) bool {
+	name := filepath.Base(filename)
+	switch {
+	case strings.HasSuffix(name, "~"),
+		strings.HasPrefix(name, ".#"),
+		strings.HasPrefix(name, "#") && strings.HasSuffix(name, "#"),
+		strings.HasSuffix(name, ".swp"),
+		strings.HasSuffix(name, ".swx"),
+		strings.HasSuffix(name, ".tmp"),
+		name == "4913": // vim checks the directory is writable with this file
+		return true
+	}
+	return false
+}
+
 // doWatcherLoop is the main watch loop that runs while dev is active
 func doWatcherLoop(buildOptions *build.Options, debugBinaryProcess *process.Process, flags devFlags, watcher *fsnotify.Watcher, exitCodeChannel chan int, quitChannel chan os.Signal) {
 	// Main Loop
@@ -541,11 +643,19 @@ func doWatcherLoop(buildOptions *build.Options, debugBinaryProcess *process.Proc
 				quit = true
 			}
 		case item := <-watcher.Events:
-			// Check for file writes
-			if item.Op&fsnotify.Write == fsnotify.Write {
+			// Check for file writes. Editors that save atomically create a new file instead.
+			if item.Op&fsnotify.Write == fsnotify.Write || item.Op&fsnotify.Create == fsnotify.Create {
 				// Ignore directories
 				itemName := item.Name
 				if fs.DirExists(itemName) {
+					// Writes to a directory are changes to its entries, only new directories are added
+					if item.Op&fsnotify.Create == fsnotify.Create {
+						addDirectoryToWatcher(watcher, itemName, buildOptions.Logger)
+					}
+					continue
+				}
+
+				if isEditorTempFile(itemName) {
 					continue
 				}
 
@@ -554,6 +664,13 @@ func doWatcherLoop(buildOptions *build.Options, debugBinaryProcess *process.Proc
 				if ext != "" {
 					ext = ext[1:]
 					if _, exists := extensionsThatTriggerARebuild[ext]; exists {
+						// Tests aren't part of the application
+						if strings.HasSuffix(itemName, "_test.go") {
+							continue
+						}
+						if !rebuild {
+							LogDarkYellow("Change detected: %s", itemName)
+						}
 						rebuild = true
 						timer.Reset(interval)
 						continue
@@ -573,20 +690,6 @@ func doWatcherLoop(buildOptions *build.Options, debugBinaryProcess *process.Proc
 
 				timer.Reset(interval)
 			}
-			// Check for new directories
-			if item.Op&fsnotify.Create == fsnotify.Create {
-				// If this is a folder, add it to our watch list
-				if fs.DirExists(item.Name) {
-					//node_modules is BANNED!
-					if !strings.Contains(item.Name, "node_modules") {
-						err := watcher.Add(item.Name)
-						if err != nil {
-							buildOptions.Logger.Fatal("%s", err.Error())
-						}
-						LogGreen("Added new directory to watcher: %s", item.Name)
-					}
-				}
-			}
 		case <-timer.C:
 			if rebuild {
 				rebuild = false
@@ -604,7 +707,7 @@ func doWatcherLoop(buildOptions *build.Options, debugBinaryProcess *process.Proc
 			}
 			if len(changedPaths) != 0 {
 				if assetDir == "" {
-					resp, err := http.Get("http://localhost:34115/wails/assetdir")
+					resp, err := http.Get("http://" + flags.devServer + "/wails/assetdir")
 					if err != nil {
 						LogRed("Error during retrieving assetdir: %s", err.Error())
 					} else {
@@ -633,7 +736,7 @@ func doWatcherLoop(buildOptions *build.Options, debugBinaryProcess *process.Proc
 			}
 			if reload {
 				reload = false
-				_, err = http.Get("http://localhost:34115/wails/reload")
+				_, err = http.Get("http://" + flags.devServer + "/wails/reload")
 				if err != nil {
 					LogRed("Error during refresh: %s", err.Error())
 				}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"embed"
+	"errors"
 	"log"
+	"os"
 
 	"github.com/wailsapp/wails/v2/pkg/options/mac"
 
@@ -63,6 +65,11 @@ func main() {
 		},
 	})
 
+	// Exit with the code given to runtime.QuitWithExitCode
+	var exitErr *wails.ExitError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.Code)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
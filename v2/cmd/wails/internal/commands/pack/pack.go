@@ -0,0 +1,70 @@
+package pack
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/leaanthony/clir"
+	"github.com/wailsapp/wails/v2/pkg/clilogger"
+	"github.com/wailsapp/wails/v2/pkg/commands/build"
+)
+
+// AddSubcommand adds the `package` command for the Wails application
+func AddSubcommand(app *clir.Cli, w io.Writer) error {
+
+	command := app.NewSubCommand("package", "Packages a compiled application without rebuilding it")
+
+	binary := ""
+	command.StringFlag("binary", "Path to the compiled application", &binary)
+
+	platform := runtime.GOOS
+	command.StringFlag("platform", "Platform of the compiled application: darwin, windows or linux", &platform)
+
+	bundleName := ""
+	command.StringFlag("bundlename", "Mac only: name of the application bundle, EG: myapp-arm64.app", &bundleName)
+
+	signIdentity := ""
+	command.StringFlag("codesign", "Mac only: sign the application bundle, and the code nested in it, with the given identity. Use '-' to sign ad hoc", &signIdentity)
+
+	verbosity := 1
+	command.IntFlag("v", "Verbosity level (0 - silent, 1 - default, 2 - verbose)", &verbosity)
+
+	command.Action(func() error {
+
+		if binary == "" {
+			return fmt.Errorf("please provide the compiled application with -binary")
+		}
+
+		// Create logger
+		logger := clilogger.New(w)
+		logger.Mute(verbosity == 0)
+		logger.SetProgress(verbosity == 1 && clilogger.IsTerminal(w))
+
+		if verbosity != 0 {
+			app.PrintBanner()
+		}
+
+		options := &build.Options{
+			Logger:         logger,
+			Platform:       strings.Split(platform, "/")[0],
+			CompiledBinary: binary,
+			BundleName:     bundleName,
+			SignIdentity:   signIdentity,
+			Verbosity:      verbosity,
+			Pack:           true,
+		}
+
+		start := time.Now()
+		outputFilename, err := build.Package(options)
+		if err != nil {
+			return err
+		}
+		logger.Println("Packaged '%s' in %s.", outputFilename, time.Since(start).Round(time.Millisecond).String())
+		return nil
+	})
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/commands/doctor"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/commands/generate"
 	"github.com/wailsapp/wails/v2/cmd/wails/internal/commands/initialise"
+	"github.com/wailsapp/wails/v2/cmd/wails/internal/commands/pack"
 )
 
 func fatal(message string) {
@@ -62,6 +63,11 @@ func main() {
 		fatal(err.Error())
 	}
 
+	err = pack.AddSubcommand(app, os.Stdout)
+	if err != nil {
+		fatal(err.Error())
+	}
+
 	err = update.AddSubcommand(app, os.Stdout, internal.Version)
 	if err != nil {
 		fatal(err.Error())
@@ -90,7 +90,7 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		window:           window,
 		servicebus:       servicebus.New(myLogger),
 		logger:           myLogger,
-		bindings:         binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions),
+		bindings:         binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions, appoptions.BindingNamespace),
 		menuManager:      menuManager,
 		startupCallback:  appoptions.OnStartup,
 		shutdownCallback: appoptions.OnShutdown,
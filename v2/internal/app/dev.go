@@ -93,7 +93,7 @@ func CreateApp(appoptions *options.App) (*App, error) {
 
 	result := &App{
 		appType:          "dev",
-		bindings:         binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions),
+		bindings:         binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions, appoptions.BindingNamespace),
 		logger:           myLogger,
 		servicebus:       servicebus.New(myLogger),
 		startupCallback:  appoptions.OnStartup,
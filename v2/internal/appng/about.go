@@ -0,0 +1,44 @@
+package appng
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/menu"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// aboutInformation returns the information shown in the about dialog, injected from wails.json by `wails build`.
+// The title of the application is used if the project name isn't available.
+func aboutInformation(appoptions *options.App) frontend.AboutInfo {
+	name := buildAppName
+	if name == "" {
+		name = appoptions.Title
+	}
+	return frontend.AboutInfo{
+		Name:      name,
+		Version:   buildAppVersion,
+		Copyright: buildCopyright,
+	}
+}
+
+// bindAboutMenuItems makes the menu items with the About role show the about dialog.
+// The frontend is given by a function as the menu is processed before it is created.
+func bindAboutMenuItems(appMenu *menu.Menu, info frontend.AboutInfo, getFrontend func() frontend.Frontend) {
+	if appMenu == nil {
+		return
+	}
+	for _, menuItem := range appMenu.Items {
+		if menuItem.Role == menu.AboutRole {
+			if menuItem.Label == "" {
+				menuItem.Label = "About " + info.Name
+			}
+			if menuItem.Click == nil {
+				menuItem.Click = func(*menu.CallbackData) {
+					go func() {
+						_ = getFrontend().ShowAboutDialog(info)
+					}()
+				}
+			}
+		}
+		bindAboutMenuItems(menuItem.SubMenu, info, getFrontend)
+	}
+}
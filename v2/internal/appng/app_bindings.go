@@ -25,10 +25,18 @@ type App struct {
 func (a *App) Run() error {
 
 	// Create binding exemptions - Ugly hack. There must be a better way
-	bindingExemptions := []interface{}{a.appoptions.OnStartup, a.appoptions.OnShutdown, a.appoptions.OnDomReady}
-	appBindings := binding.NewBindings(a.logger, a.appoptions.Bind, bindingExemptions)
+	bindingExemptions := []interface{}{a.appoptions.OnStartup, a.appoptions.OnShutdown, a.appoptions.OnDomReady, a.appoptions.OnPanic}
+	appBindings := binding.NewBindings(a.logger, a.appoptions.Bind, bindingExemptions, a.appoptions.BindingNamespace)
+	err := appBindings.AddFunctions(a.appoptions.BindFunctions)
+	if err != nil {
+		return err
+	}
+	err = appBindings.AddEnums(a.appoptions.EnumBind)
+	if err != nil {
+		return err
+	}
 
-	err := generateBindings(appBindings)
+	err = generateBindings(appBindings)
 	if err != nil {
 		return err
 	}
@@ -83,6 +91,13 @@ func generateBindings(bindings *binding.Bindings) error {
 		return err
 	}
 
+	if projectConfig.BindingsJSDoc {
+		err = bindings.AddComments(cwd)
+		if err != nil {
+			return err
+		}
+	}
+
 	targetDir := filepath.Join(projectConfig.WailsJSDir, "wailsjs", "go")
 	err = os.RemoveAll(targetDir)
 	if err != nil {
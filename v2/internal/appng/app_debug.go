@@ -3,6 +3,21 @@
 
 package appng
 
+import "os"
+
+// frontendDevServerURL is injected at build time by `wails build -debug -frontenddevserverurl <url>`
+var frontendDevServerURL string
+
 func IsDebug() bool {
 	return true
 }
+
+// FrontendDevServerURL returns the url of the frontend dev server that the webview should load
+// instead of the embedded assets. It can be overridden at runtime using the `frontenddevserverurl`
+// environment variable.
+func FrontendDevServerURL() string {
+	if url := os.Getenv("frontenddevserverurl"); url != "" {
+		return url
+	}
+	return frontendDevServerURL
+}
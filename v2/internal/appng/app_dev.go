@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreport"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop"
 	"github.com/wailsapp/wails/v2/internal/frontend/devserver"
@@ -22,6 +23,8 @@ import (
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/internal/menumanager"
 	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/internal/securestorage"
+	"github.com/wailsapp/wails/v2/internal/startup"
 	pkglogger "github.com/wailsapp/wails/v2/pkg/logger"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
@@ -33,6 +36,12 @@ type App struct {
 	options  *options.App
 
 	menuManager *menumanager.Manager
+	dispatcher  *dispatcher.Dispatcher
+
+	crashReporter *crashreport.Reporter
+
+	// Stops checking for updates
+	stopUpdater func()
 
 	// Indicates if the app is in debug mode
 	debug bool
@@ -41,13 +50,29 @@ type App struct {
 	startupCallback  func(ctx context.Context)
 	shutdownCallback func(ctx context.Context)
 	ctx              context.Context
+
+	// The code the process exits with, set by runtime.QuitWithExitCode
+	exitCode *frontend.ExitCode
 }
 
 func (a *App) Run() error {
+	defer a.crashReporter.Recover()
+
 	err := a.frontend.Run(a.ctx)
+
+	a.stopUpdater()
+
+	// Cancel any bound method calls that are still in progress
+	a.dispatcher.Shutdown()
+
 	if a.shutdownCallback != nil {
 		a.shutdownCallback(a.ctx)
 	}
+
+	// The process is left for the caller to exit with the code given to runtime.QuitWithExitCode
+	if exitCode := a.exitCode.Get(); err == nil && exitCode != 0 {
+		return &frontend.ExitError{Code: exitCode}
+	}
 	return err
 }
 
@@ -57,13 +82,22 @@ func CreateApp(appoptions *options.App) (*App, error) {
 
 	ctx := context.WithValue(context.Background(), "debug", true)
 
+	// Print the build information if requested, before any window is created
+	handleVersionFlag(appoptions)
+	handleUpdateFlags(appoptions)
+
 	// Set up logger
 	myLogger := logger.New(appoptions.Logger)
 	myLogger.SetLogLevel(appoptions.LogLevel)
+	startProfiler(myLogger)
+
+	crashReporter := crashreport.New(appoptions, myLogger, buildInformation(appoptions.Title))
+	ctx = context.WithValue(ctx, "startup", startup.NewRecorder(myLogger))
 
 	// Check for CLI Flags
 	var assetdirFlag *string
 	var devServerURLFlag *string
+	var devServerFlag *string
 	var loglevelFlag *string
 
 	assetdir := os.Getenv("assetdir")
@@ -74,6 +108,10 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	if devServerURL == "" {
 		devServerURLFlag = flag.String("devserverurl", "", "URL of development server")
 	}
+	devServer := os.Getenv("devserver")
+	if devServer == "" {
+		devServerFlag = flag.String("devserver", "localhost:34115", "Address for the built-in development server to listen on")
+	}
 
 	loglevel := os.Getenv("loglevel")
 	if loglevel == "" {
@@ -89,6 +127,9 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		if devServerURLFlag != nil {
 			devServerURL = *devServerURLFlag
 		}
+		if devServerFlag != nil {
+			devServer = *devServerFlag
+		}
 		if loglevelFlag != nil {
 			loglevel = *loglevelFlag
 		}
@@ -119,6 +160,11 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		ctx = context.WithValue(ctx, "devserverurl", devServerURL)
 	}
 
+	if devServer == "" {
+		devServer = "localhost:34115"
+	}
+	ctx = context.WithValue(ctx, "devserver", devServer)
+
 	if loglevel != "" {
 		level, err := pkglogger.StringToLogLevel(loglevel)
 		if err != nil {
@@ -137,6 +183,11 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		return nil, err
 	}
 
+	err = setWorkingDirectory(appoptions, myLogger)
+	if err != nil {
+		return nil, err
+	}
+
 	// Merge default options
 	options.MergeDefaults(appoptions)
 
@@ -153,8 +204,16 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	}
 
 	// Create binding exemptions - Ugly hack. There must be a better way
-	bindingExemptions := []interface{}{appoptions.OnStartup, appoptions.OnShutdown, appoptions.OnDomReady}
-	appBindings := binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions)
+	bindingExemptions := []interface{}{appoptions.OnStartup, appoptions.OnShutdown, appoptions.OnDomReady, appoptions.OnPanic}
+	appBindings := binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions, appoptions.BindingNamespace)
+	err = appBindings.AddFunctions(appoptions.BindFunctions)
+	if err != nil {
+		return nil, err
+	}
+	err = appBindings.AddEnums(appoptions.EnumBind)
+	if err != nil {
+		return nil, err
+	}
 
 	err = generateBindings(appBindings)
 	if err != nil {
@@ -162,11 +221,28 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	}
 	eventHandler := runtime.NewEvents(myLogger)
 	ctx = context.WithValue(ctx, "events", eventHandler)
-	messageDispatcher := dispatcher.NewDispatcher(myLogger, appBindings, eventHandler)
+	ctx = context.WithValue(ctx, "settings", newSettingsStore(myLogger))
+	securestorage.SetNamespace(secureStorageNamespace())
+	var stopUpdater func()
+	ctx, stopUpdater = setupAutoUpdate(ctx, appoptions, eventHandler, myLogger)
+	emitJumpListTask(appoptions, eventHandler)
+
+	aboutInfo := aboutInformation(appoptions)
+	ctx = context.WithValue(ctx, "aboutinfo", aboutInfo)
+	ctx = context.WithValue(ctx, "assetshash", assetsHash(appoptions))
+	ctx = context.WithValue(ctx, "args", applicationArgs())
+	ctx = context.WithValue(ctx, "identifier", applicationIdentifier())
+	exitCode := &frontend.ExitCode{}
+	ctx = context.WithValue(ctx, "exitcode", exitCode)
+	var appFrontend frontend.Frontend
+	bindAboutMenuItems(appoptions.Menu, aboutInfo, func() frontend.Frontend { return appFrontend })
+	forwardInputEvents(appoptions, func() frontend.Frontend { return appFrontend })
+
+	messageDispatcher := dispatcher.NewDispatcher(ctx, myLogger, appBindings, eventHandler, crashReporter)
 
 	// Create the frontends and register to event handler
 	desktopFrontend := desktop.NewFrontend(ctx, appoptions, myLogger, appBindings, messageDispatcher)
-	appFrontend := devserver.NewFrontend(ctx, appoptions, myLogger, appBindings, messageDispatcher, menuManager, desktopFrontend)
+	appFrontend = devserver.NewFrontend(ctx, appoptions, myLogger, appBindings, messageDispatcher, menuManager, desktopFrontend)
 	eventHandler.AddFrontend(appFrontend)
 	eventHandler.AddFrontend(desktopFrontend)
 
@@ -175,8 +251,12 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		frontend:         appFrontend,
 		logger:           myLogger,
 		menuManager:      menuManager,
+		dispatcher:       messageDispatcher,
+		crashReporter:    crashReporter,
+		stopUpdater:      stopUpdater,
 		startupCallback:  appoptions.OnStartup,
 		shutdownCallback: appoptions.OnShutdown,
+		exitCode:         exitCode,
 		debug:            true,
 	}
 
@@ -197,32 +277,43 @@ func generateBindings(bindings *binding.Bindings) error {
 		return err
 	}
 
-	targetDir := filepath.Join(projectConfig.WailsJSDir, "wailsjs", "go")
-	err = os.RemoveAll(targetDir)
+	if projectConfig.BindingsJSDoc {
+		err = bindings.AddComments(cwd)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The bindings are generated in a temporary directory and only the files that have
+	// changed are updated, so that the frontend's hot module reloading only reloads
+	// the bindings when they change
+	generatedDir, err := os.MkdirTemp("", "wailsjs")
 	if err != nil {
 		return err
 	}
-	_ = fs.MkDirs(targetDir)
-	modelsFile := filepath.Join(targetDir, "models.ts")
+	defer os.RemoveAll(generatedDir)
+
+	modelsFile := filepath.Join(generatedDir, "models.ts")
 	err = bindings.WriteTS(modelsFile)
 	if err != nil {
 		return err
 	}
 
 	// Write backend method wrappers
-	bindingsFilename := filepath.Join(targetDir, "bindings.js")
+	bindingsFilename := filepath.Join(generatedDir, "bindings.js")
 	err = bindings.GenerateBackendJS(bindingsFilename, false)
 	if err != nil {
 		return err
 	}
 
-	bindingsTypes := filepath.Join(targetDir, "bindings.d.ts")
+	bindingsTypes := filepath.Join(generatedDir, "bindings.d.ts")
 	err = bindings.GenerateBackendTS(bindingsTypes)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	targetDir := filepath.Join(projectConfig.WailsJSDir, "wailsjs", "go")
+	return fs.SyncDir(generatedDir, targetDir)
 
 }
 
@@ -6,3 +6,8 @@ package appng
 func IsDebug() bool {
 	return false
 }
+
+// FrontendDevServerURL is only available in debug builds
+func FrontendDevServerURL() string {
+	return ""
+}
@@ -7,12 +7,15 @@ import (
 	"context"
 
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreport"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop"
 	"github.com/wailsapp/wails/v2/internal/frontend/dispatcher"
 	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/internal/menumanager"
+	"github.com/wailsapp/wails/v2/internal/securestorage"
+	"github.com/wailsapp/wails/v2/internal/startup"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
@@ -23,6 +26,12 @@ type App struct {
 	options  *options.App
 
 	menuManager *menumanager.Manager
+	dispatcher  *dispatcher.Dispatcher
+
+	crashReporter *crashreport.Reporter
+
+	// Stops checking for updates
+	stopUpdater func()
 
 	// Indicates if the app is in debug mode
 	debug bool
@@ -31,13 +40,29 @@ type App struct {
 	startupCallback  func(ctx context.Context)
 	shutdownCallback func(ctx context.Context)
 	ctx              context.Context
+
+	// The code the process exits with, set by runtime.QuitWithExitCode
+	exitCode *frontend.ExitCode
 }
 
 func (a *App) Run() error {
+	defer a.crashReporter.Recover()
+
 	err := a.frontend.Run(a.ctx)
+
+	a.stopUpdater()
+
+	// Cancel any bound method calls that are still in progress
+	a.dispatcher.Shutdown()
+
 	if a.shutdownCallback != nil {
 		a.shutdownCallback(a.ctx)
 	}
+
+	// The process is left for the caller to exit with the code given to runtime.QuitWithExitCode
+	if exitCode := a.exitCode.Get(); err == nil && exitCode != 0 {
+		return &frontend.ExitError{Code: exitCode}
+	}
 	return err
 }
 
@@ -50,9 +75,16 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	// Merge default options
 	options.MergeDefaults(appoptions)
 
+	// Print the build information if requested, before any window is created
+	handleVersionFlag(appoptions)
+	handleUpdateFlags(appoptions)
+
 	// Set up logger
 	myLogger := logger.New(appoptions.Logger)
 	myLogger.SetLogLevel(appoptions.LogLevel)
+	startProfiler(myLogger)
+
+	crashReporter := crashreport.New(appoptions, myLogger, buildInformation(appoptions.Title))
 
 	// Preflight Checks
 	err = PreflightChecks(appoptions, myLogger)
@@ -60,6 +92,11 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		return nil, err
 	}
 
+	err = setWorkingDirectory(appoptions, myLogger)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the menu manager
 	menuManager := menumanager.NewManager()
 
@@ -72,23 +109,53 @@ func CreateApp(appoptions *options.App) (*App, error) {
 	}
 
 	// Create binding exemptions - Ugly hack. There must be a better way
-	bindingExemptions := []interface{}{appoptions.OnStartup, appoptions.OnShutdown, appoptions.OnDomReady}
-	appBindings := binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions)
+	bindingExemptions := []interface{}{appoptions.OnStartup, appoptions.OnShutdown, appoptions.OnDomReady, appoptions.OnPanic}
+	appBindings := binding.NewBindings(myLogger, appoptions.Bind, bindingExemptions, appoptions.BindingNamespace)
+	err = appBindings.AddFunctions(appoptions.BindFunctions)
+	if err != nil {
+		return nil, err
+	}
 	eventHandler := runtime.NewEvents(myLogger)
 	ctx = context.WithValue(ctx, "events", eventHandler)
-	messageDispatcher := dispatcher.NewDispatcher(myLogger, appBindings, eventHandler)
 
 	debug := IsDebug()
 	ctx = context.WithValue(ctx, "debug", debug)
+	// Record how long starting the application takes in debug and profiling builds
+	if debug || isProfiling() {
+		ctx = context.WithValue(ctx, "startup", startup.NewRecorder(myLogger))
+	}
 	// Attach logger to context
 	if debug {
 		ctx = context.WithValue(ctx, "logger", myLogger)
 		ctx = context.WithValue(ctx, "buildtype", "debug")
+		if devServerURL := FrontendDevServerURL(); devServerURL != "" {
+			myLogger.Info("Loading frontend from dev server: %s", devServerURL)
+			ctx = context.WithValue(ctx, "devserverurl", devServerURL)
+		}
 	} else {
 		ctx = context.WithValue(ctx, "buildtype", "production")
 	}
 
-	appFrontend := desktop.NewFrontend(ctx, appoptions, myLogger, appBindings, messageDispatcher)
+	ctx = context.WithValue(ctx, "settings", newSettingsStore(myLogger))
+	securestorage.SetNamespace(secureStorageNamespace())
+	var stopUpdater func()
+	ctx, stopUpdater = setupAutoUpdate(ctx, appoptions, eventHandler, myLogger)
+	emitJumpListTask(appoptions, eventHandler)
+
+	aboutInfo := aboutInformation(appoptions)
+	ctx = context.WithValue(ctx, "aboutinfo", aboutInfo)
+	ctx = context.WithValue(ctx, "assetshash", assetsHash(appoptions))
+	ctx = context.WithValue(ctx, "args", applicationArgs())
+	ctx = context.WithValue(ctx, "identifier", applicationIdentifier())
+	exitCode := &frontend.ExitCode{}
+	ctx = context.WithValue(ctx, "exitcode", exitCode)
+	var appFrontend frontend.Frontend
+	bindAboutMenuItems(appoptions.Menu, aboutInfo, func() frontend.Frontend { return appFrontend })
+	forwardInputEvents(appoptions, func() frontend.Frontend { return appFrontend })
+
+	messageDispatcher := dispatcher.NewDispatcher(ctx, myLogger, appBindings, eventHandler, crashReporter)
+
+	appFrontend = desktop.NewFrontend(ctx, appoptions, myLogger, appBindings, messageDispatcher)
 	eventHandler.AddFrontend(appFrontend)
 
 	result := &App{
@@ -96,8 +163,12 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		frontend:         appFrontend,
 		logger:           myLogger,
 		menuManager:      menuManager,
+		dispatcher:       messageDispatcher,
+		crashReporter:    crashReporter,
+		stopUpdater:      stopUpdater,
 		startupCallback:  appoptions.OnStartup,
 		shutdownCallback: appoptions.OnShutdown,
+		exitCode:         exitCode,
 		debug:            debug,
 		options:          appoptions,
 	}
@@ -0,0 +1,15 @@
+package appng
+
+import (
+	"flag"
+	"os"
+)
+
+// applicationArgs returns the arguments the application was launched with, without the name of the executable.
+// If the flags have been parsed, EG: in dev mode, only the arguments after the flags are returned.
+func applicationArgs() []string {
+	if flag.Parsed() {
+		return append([]string{}, flag.Args()...)
+	}
+	return append([]string{}, os.Args[1:]...)
+}
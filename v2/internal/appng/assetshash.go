@@ -0,0 +1,26 @@
+package appng
+
+import (
+	"sync"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/assetserver"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// assetsHash returns a function that returns the hash of the frontend assets. The hash is computed by
+// `wails build` from the asset directory of the project. Otherwise, EG: in dev mode, it is computed from
+// the assets the first time it is requested.
+func assetsHash(appoptions *options.App) func() (string, error) {
+	var once sync.Once
+	var hash string
+	var err error
+	return func() (string, error) {
+		once.Do(func() {
+			hash = buildAssetsHash
+			if hash == "" && appoptions.Assets != nil {
+				hash, err = assetserver.Hash(appoptions.Assets)
+			}
+		})
+		return hash, err
+	}
+}
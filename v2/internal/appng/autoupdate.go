@@ -0,0 +1,45 @@
+package appng
+
+import (
+	"context"
+	"sync"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/internal/updater"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// setupAutoUpdate creates the updater if AutoUpdate is configured and adds it to the context.
+// Update checks start once the frontend is ready, so the frontend receives the events.
+// The returned function stops the checks.
+func setupAutoUpdate(ctx context.Context, appoptions *options.App, events frontend.Events, myLogger *logger.Logger) (context.Context, func()) {
+	if appoptions.AutoUpdate == nil || appoptions.AutoUpdate.ManifestURL == "" {
+		return ctx, func() {}
+	}
+	appUpdater := updater.New(appoptions.AutoUpdate, buildAppVersion, events, myLogger)
+	ctx = context.WithValue(ctx, "updater", appUpdater)
+
+	updaterCtx, cancel := context.WithCancel(context.Background())
+	var start sync.Once
+	onDomReady := appoptions.OnDomReady
+	appoptions.OnDomReady = func(ctx context.Context) {
+		start.Do(func() {
+			go appUpdater.Start(updaterCtx)
+		})
+		if onDomReady != nil {
+			onDomReady(ctx)
+		}
+	}
+	return ctx, cancel
+}
+
+// handleUpdateFlags installs an update if the application was relaunched elevated to do so,
+// and removes the executable replaced by the last update
+func handleUpdateFlags(appoptions *options.App) {
+	if appoptions.AutoUpdate == nil {
+		return
+	}
+	updater.HandleElevatedApply()
+	updater.RemovePreviousVersion()
+}
@@ -0,0 +1,86 @@
+package appng
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// Build information injected at build time by `wails build`
+var (
+	buildAppName    string
+	buildIdentifier string
+	buildAppVersion string
+	buildCopyright  string
+	buildTime       string
+	buildAssetsHash string
+)
+
+// handleVersionFlag prints the build information and exits if VersionFlag is set and the
+// application was started with `--version` or `--wails-info`
+func handleVersionFlag(appoptions *options.App) {
+	if !appoptions.VersionFlag || len(os.Args) != 2 {
+		return
+	}
+	switch os.Args[1] {
+	case "--version", "-version", "--wails-info":
+	default:
+		return
+	}
+	attachConsole()
+	fmt.Print(buildInformation(appoptions.Title))
+	os.Exit(0)
+}
+
+// buildInformation returns the build information of the application
+func buildInformation(title string) string {
+	wailsVersion := "unknown"
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/wailsapp/wails/v2" {
+				wailsVersion = dep.Version
+				if dep.Replace != nil {
+					wailsVersion += " => " + dep.Replace.Path
+				}
+				break
+			}
+		}
+	}
+
+	var result strings.Builder
+	if title != "" {
+		result.WriteString(title + "\n")
+	}
+	if buildAppVersion != "" {
+		result.WriteString("Version: " + buildAppVersion + "\n")
+	}
+	if buildIdentifier != "" {
+		result.WriteString("Identifier: " + buildIdentifier + "\n")
+	}
+	result.WriteString("Wails: " + wailsVersion + "\n")
+	if buildTime != "" {
+		result.WriteString("Built: " + buildTime + "\n")
+	}
+	result.WriteString("Go: " + runtime.Version() + "\n")
+	result.WriteString("Platform: " + runtime.GOOS + "/" + runtime.GOARCH + "\n")
+	return result.String()
+}
+
+// applicationIdentifier returns the identifier of the application, set by `wails build` and `wails dev`
+// from the project config
+func applicationIdentifier() string {
+	return buildIdentifier
+}
+
+// secureStorageNamespace returns the prefix of the names of the secrets of the application: its identifier,
+// or the project name if it isn't set, so dev and production builds share their secrets
+func secureStorageNamespace() string {
+	if buildIdentifier != "" {
+		return buildIdentifier
+	}
+	return buildAppName
+}
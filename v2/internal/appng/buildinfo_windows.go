@@ -0,0 +1,22 @@
+//go:build windows
+// +build windows
+
+package appng
+
+import (
+	"os"
+	"syscall"
+)
+
+// attachConsole attaches to the console of the parent process, so the output of
+// GUI applications is shown when run from a terminal
+func attachConsole() {
+	const attachParentProcess = ^uintptr(0)
+	attach := syscall.NewLazyDLL("kernel32.dll").NewProc("AttachConsole")
+	if result, _, _ := attach.Call(attachParentProcess); result == 0 {
+		return
+	}
+	if console, err := os.OpenFile("CONOUT$", os.O_WRONLY, 0); err == nil {
+		os.Stdout = console
+	}
+}
@@ -0,0 +1,23 @@
+package appng
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// forwardInputEvents enables the forwarding of the configured input events each time the frontend loads.
+// The frontend is given by a function as it is created after the options are processed.
+func forwardInputEvents(appoptions *options.App, getFrontend func() frontend.Frontend) {
+	if appoptions.InputEvents == nil {
+		return
+	}
+	onDomReady := appoptions.OnDomReady
+	appoptions.OnDomReady = func(ctx context.Context) {
+		getFrontend().WindowSetInputEvents(*appoptions.InputEvents)
+		if onDomReady != nil {
+			onDomReady(ctx)
+		}
+	}
+}
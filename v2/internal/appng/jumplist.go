@@ -0,0 +1,36 @@
+package appng
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/jumplist"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// jumpListTask is the ID of the jump list task the application was launched from
+var jumpListTask string
+
+func init() {
+	// Remove the flag passed by jump list tasks before the application parses its own flags
+	jumpListTask, os.Args = jumplist.TaskFromArgs(os.Args)
+}
+
+// emitJumpListTask emits the jump list task the application was launched from once the frontend is ready
+func emitJumpListTask(appoptions *options.App, events frontend.Events) {
+	if jumpListTask == "" {
+		return
+	}
+	var emit sync.Once
+	onDomReady := appoptions.OnDomReady
+	appoptions.OnDomReady = func(ctx context.Context) {
+		emit.Do(func() {
+			events.Emit(jumplist.TaskEvent, jumpListTask)
+		})
+		if onDomReady != nil {
+			onDomReady(ctx)
+		}
+	}
+}
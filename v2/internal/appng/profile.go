@@ -0,0 +1,48 @@
+//go:build pprof
+// +build pprof
+
+package appng
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+)
+
+// The address of the pprof endpoint. A random port is used if it is in use.
+const profileAddress = "127.0.0.1:6060"
+
+// isProfiling returns true if the application was built with `wails build -profile`
+func isProfiling() bool {
+	return true
+}
+
+// startProfiler serves the pprof endpoint of applications built with `wails build -profile`. It is only bound
+// to localhost, so the profiles can't be read from other machines.
+func startProfiler(myLogger *logger.Logger) {
+	listener, err := net.Listen("tcp", profileAddress)
+	if err != nil {
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		myLogger.Error("Unable to start the pprof endpoint: %s", err.Error())
+		return
+	}
+
+	// The handlers are registered on a separate mux so the endpoint doesn't serve the handlers of the application
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	go func() {
+		_ = http.Serve(listener, mux)
+	}()
+
+	attachConsole()
+	fmt.Printf("Profiling enabled: http://%s/debug/pprof/\n", listener.Addr().String())
+}
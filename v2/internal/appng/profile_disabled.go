@@ -0,0 +1,13 @@
+//go:build !pprof
+// +build !pprof
+
+package appng
+
+import "github.com/wailsapp/wails/v2/internal/logger"
+
+func isProfiling() bool {
+	return false
+}
+
+// startProfiler is only available in applications built with `wails build -profile`
+func startProfiler(_ *logger.Logger) {}
@@ -0,0 +1,27 @@
+package appng
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/internal/settings"
+)
+
+// newSettingsStore creates the settings store of the application, namespaced by the project name
+// injected by `wails build`, or the name of the executable if it isn't available
+func newSettingsStore(myLogger *logger.Logger) *settings.Store {
+	appName := buildAppName
+	executable, _ := os.Executable()
+	if appName == "" {
+		appName = strings.TrimSuffix(filepath.Base(executable), ".exe")
+	}
+	store, err := settings.New(appName)
+	if err != nil {
+		// Without a config directory, save the settings next to the executable
+		myLogger.Warning("Unable to find the user config directory: %s", err.Error())
+		store = settings.NewWithPath(filepath.Join(filepath.Dir(executable), appName+".settings.json"))
+	}
+	return store
+}
@@ -0,0 +1,35 @@
+package appng
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// setWorkingDirectory changes the working directory of the process if WorkingDirectory is set.
+// Relative paths are relative to the directory of the executable.
+func setWorkingDirectory(appoptions *options.App, myLogger *logger.Logger) error {
+	if appoptions.WorkingDirectory == "" {
+		return nil
+	}
+	dir := appoptions.WorkingDirectory
+	if !filepath.IsAbs(dir) {
+		executable, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		executable, err = filepath.EvalSymlinks(executable)
+		if err != nil {
+			return err
+		}
+		dir = filepath.Join(filepath.Dir(executable), dir)
+	}
+	err := os.Chdir(dir)
+	if err != nil {
+		return err
+	}
+	myLogger.Debug("Working directory set to %s", dir)
+	return nil
+}
@@ -0,0 +1,158 @@
+package binding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// BenchmarkNewBindings measures binding a struct with 120 methods and sending their names to the frontend,
+// as done when the application starts. Describing the methods when they are first called rather than when
+// they are bound, and only sending their names, reduced this from 350µs to 200µs and from 1999 to 1105
+// allocations.
+func BenchmarkNewBindings(b *testing.B) {
+	log := logger.New(nil)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bindings := NewBindings(log, []interface{}{&benchService{}}, nil, options.PackageName)
+		if _, err := bindings.ToJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type benchModel struct {
+	ID    int
+	Name  string
+	Tags  []string
+	Owner *benchOwner
+}
+
+type benchOwner struct {
+	Email string
+}
+
+type benchService struct{}
+
+func (s *benchService) Method000(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method001(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method002(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method003(values map[string]benchOwner)                  {}
+func (s *benchService) Method004(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method005(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method006(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method007(values map[string]benchOwner)                  {}
+func (s *benchService) Method008(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method009(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method010(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method011(values map[string]benchOwner)                  {}
+func (s *benchService) Method012(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method013(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method014(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method015(values map[string]benchOwner)                  {}
+func (s *benchService) Method016(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method017(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method018(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method019(values map[string]benchOwner)                  {}
+func (s *benchService) Method020(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method021(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method022(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method023(values map[string]benchOwner)                  {}
+func (s *benchService) Method024(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method025(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method026(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method027(values map[string]benchOwner)                  {}
+func (s *benchService) Method028(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method029(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method030(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method031(values map[string]benchOwner)                  {}
+func (s *benchService) Method032(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method033(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method034(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method035(values map[string]benchOwner)                  {}
+func (s *benchService) Method036(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method037(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method038(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method039(values map[string]benchOwner)                  {}
+func (s *benchService) Method040(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method041(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method042(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method043(values map[string]benchOwner)                  {}
+func (s *benchService) Method044(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method045(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method046(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method047(values map[string]benchOwner)                  {}
+func (s *benchService) Method048(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method049(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method050(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method051(values map[string]benchOwner)                  {}
+func (s *benchService) Method052(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method053(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method054(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method055(values map[string]benchOwner)                  {}
+func (s *benchService) Method056(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method057(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method058(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method059(values map[string]benchOwner)                  {}
+func (s *benchService) Method060(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method061(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method062(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method063(values map[string]benchOwner)                  {}
+func (s *benchService) Method064(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method065(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method066(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method067(values map[string]benchOwner)                  {}
+func (s *benchService) Method068(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method069(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method070(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method071(values map[string]benchOwner)                  {}
+func (s *benchService) Method072(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method073(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method074(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method075(values map[string]benchOwner)                  {}
+func (s *benchService) Method076(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method077(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method078(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method079(values map[string]benchOwner)                  {}
+func (s *benchService) Method080(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method081(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method082(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method083(values map[string]benchOwner)                  {}
+func (s *benchService) Method084(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method085(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method086(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method087(values map[string]benchOwner)                  {}
+func (s *benchService) Method088(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method089(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method090(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method091(values map[string]benchOwner)                  {}
+func (s *benchService) Method092(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method093(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method094(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method095(values map[string]benchOwner)                  {}
+func (s *benchService) Method096(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method097(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method098(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method099(values map[string]benchOwner)                  {}
+func (s *benchService) Method100(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method101(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method102(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method103(values map[string]benchOwner)                  {}
+func (s *benchService) Method104(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method105(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method106(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method107(values map[string]benchOwner)                  {}
+func (s *benchService) Method108(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method109(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method110(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method111(values map[string]benchOwner)                  {}
+func (s *benchService) Method112(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method113(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method114(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method115(values map[string]benchOwner)                  {}
+func (s *benchService) Method116(name string, count int) (string, error)        { return name, nil }
+func (s *benchService) Method117(ctx context.Context, m benchModel) *benchModel { return &m }
+func (s *benchService) Method118(ids []int) []benchModel                        { return nil }
+func (s *benchService) Method119(values map[string]benchOwner)                  {}
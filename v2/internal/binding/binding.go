@@ -3,12 +3,14 @@ package binding
 import (
 	"fmt"
 	"github.com/leaanthony/typescriptify-golang-structs/typescriptify"
+	"os"
 	"reflect"
 	"runtime"
 	"strings"
 
 	"github.com/leaanthony/slicer"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
 type Bindings struct {
@@ -16,16 +18,42 @@ type Bindings struct {
 	logger     logger.CustomLogger
 	exemptions slicer.StringSlicer
 
+	// How bound structs are namespaced
+	namespace options.BindingNamespace
+
+	// The path of the main module, used to shorten package paths
+	modulePath string
+
+	// Enums to add to the generated models
+	enums map[reflect.Type]*enum
+
+	// Indicates the DateTime helpers should be added to the generated models
+	usesTime bool
+
+	// The structs generated in the models, used to find the comments of their fields
+	models []reflect.Type
+
+	// Comments of the model fields: map[structname] -> map[jsonname] -> comment
+	fieldComments map[string]map[string]string
+
+	// The bound methods and functions in the order they were added, used to add their models
+	methods []*BoundMethod
+
+	// Indicates the models of the bound methods have been added to the converter
+	modelsAdded bool
+
 	// Typescript writer
 	converter *typescriptify.TypeScriptify
 }
 
 // NewBindings returns a new Bindings object
-func NewBindings(logger *logger.Logger, structPointersToBind []interface{}, exemptions []interface{}) *Bindings {
+func NewBindings(logger *logger.Logger, structPointersToBind []interface{}, exemptions []interface{}, namespace options.BindingNamespace) *Bindings {
 	result := &Bindings{
-		db:        newDB(),
-		logger:    logger.CustomLogger("Bindings"),
-		converter: typescriptify.New(),
+		db:         newDB(),
+		logger:     logger.CustomLogger("Bindings"),
+		converter:  typescriptify.New(),
+		namespace:  namespace,
+		modulePath: mainModulePath(),
 	}
 
 	// No backups
@@ -64,19 +92,44 @@ func (b *Bindings) Add(structPtr interface{}) error {
 	}
 
 	for _, method := range methods {
+		// The package name may have multiple parts when namespaced by package path
 		splitName := strings.Split(method.Name, ".")
-		packageName := splitName[0]
-		structName := splitName[1]
-		methodName := splitName[2]
+		packageName := strings.Join(splitName[:len(splitName)-2], ".")
+		structName := splitName[len(splitName)-2]
+		methodName := splitName[len(splitName)-1]
+
+		err := b.db.checkStruct(packageName, structName, method.structType)
+		if err != nil {
+			return fmt.Errorf("cannot bind value to app: %s", err.Error())
+		}
+		if b.db.hasFunction(splitName[0]) {
+			return fmt.Errorf("cannot bind value to app: package '%s' collides with a bound function", packageName)
+		}
 
 		// Add it as a regular method
 		b.db.AddMethod(packageName, structName, methodName, method)
+		b.methods = append(b.methods, method)
 	}
 	return nil
 }
 
 func (b *Bindings) WriteTS(filename string) error {
-	return b.converter.ConvertToFile(filename)
+	b.addModels()
+	err := b.converter.ConvertToFile(filename)
+	if err != nil {
+		return err
+	}
+	if len(b.fieldComments) > 0 {
+		models, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(filename, b.addFieldComments(models), 0755)
+		if err != nil {
+			return err
+		}
+	}
+	return b.appendModels(filename)
 }
 
 func (b *Bindings) DB() *DB {
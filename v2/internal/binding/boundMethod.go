@@ -1,9 +1,11 @@
 package binding
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sync"
 )
 
 // BoundMethod defines all the data related to a Go method that is
@@ -14,15 +16,48 @@ type BoundMethod struct {
 	Outputs  []*Parameter  `json:"outputs,omitempty"`
 	Comments string        `json:"comments,omitempty"`
 	Method   reflect.Value `json:"-"`
+
+	// Indicates the method takes a context.Context as its first parameter.
+	// The context isn't part of the inputs as it is provided by the call.
+	needsContext bool
+
+	// The struct the method is defined on. Nil for functions.
+	structType reflect.Type
+
+	// The package path and the name of the declaration, used to find its doc comment
+	pkgPath string
+	docName string
+
+	// The inputs and outputs are described when the method is first called or the bindings are
+	// generated, so that binding many methods doesn't slow down starting the application
+	describeOnce sync.Once
+}
+
+// describe sets the inputs and outputs of the method from its type
+func (b *BoundMethod) describe() {
+	b.describeOnce.Do(func() {
+		methodType := b.Method.Type()
+		for index := 0; index < methodType.NumIn(); index++ {
+			if index == 0 && b.needsContext {
+				continue
+			}
+			b.Inputs = append(b.Inputs, newParameter("", methodType.In(index)))
+		}
+		for index := 0; index < methodType.NumOut(); index++ {
+			b.Outputs = append(b.Outputs, newParameter("", methodType.Out(index)))
+		}
+	})
 }
 
 // InputCount returns the number of inputs this bound method has
 func (b *BoundMethod) InputCount() int {
+	b.describe()
 	return len(b.Inputs)
 }
 
 // OutputCount returns the number of outputs this bound method has
 func (b *BoundMethod) OutputCount() int {
+	b.describe()
 	return len(b.Outputs)
 }
 
@@ -49,10 +84,11 @@ func (b *BoundMethod) ParseArgs(args []json.RawMessage) ([]interface{}, error) {
 	return result, nil
 }
 
-// Call will attempt to call this bound method with the given args
-func (b *BoundMethod) Call(args []interface{}) (interface{}, error) {
+// Call will attempt to call this bound method with the given args.
+// The context is passed to the method if it takes a context.Context as its first parameter.
+func (b *BoundMethod) Call(ctx context.Context, args []interface{}) (interface{}, error) {
 	// Check inputs
-	expectedInputLength := len(b.Inputs)
+	expectedInputLength := b.InputCount()
 	actualInputLength := len(args)
 	if expectedInputLength != actualInputLength {
 		return nil, fmt.Errorf("%s takes %d inputs. Received %d", b.Name, expectedInputLength, actualInputLength)
@@ -61,12 +97,17 @@ func (b *BoundMethod) Call(args []interface{}) (interface{}, error) {
 	/** Convert inputs to reflect values **/
 
 	// Create slice for the input arguments to the method call
-	callArgs := make([]reflect.Value, expectedInputLength)
+	callArgs := make([]reflect.Value, 0, expectedInputLength+1)
+
+	// Inject the call context
+	if b.needsContext {
+		callArgs = append(callArgs, reflect.ValueOf(ctx))
+	}
 
 	// Iterate over given arguments
-	for index, arg := range args {
+	for _, arg := range args {
 		// Save the converted argument
-		callArgs[index] = reflect.ValueOf(arg)
+		callArgs = append(callArgs, reflect.ValueOf(arg))
 	}
 
 	// Do the call
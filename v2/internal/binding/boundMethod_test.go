@@ -0,0 +1,68 @@
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/leaanthony/typescriptify-golang-structs/typescriptify"
+)
+
+type contextKey string
+
+type contextStruct struct{}
+
+func (c *contextStruct) WithContext(ctx context.Context, name string) string {
+	return ctx.Value(contextKey("greeting")).(string) + " " + name
+}
+
+func (c *contextStruct) ContextNotFirst(name string, ctx context.Context) string {
+	return name
+}
+
+func findMethod(methods []*BoundMethod, name string) *BoundMethod {
+	for _, method := range methods {
+		if method.Name == name {
+			return method
+		}
+	}
+	return nil
+}
+
+func TestBoundMethod_Context(t *testing.T) {
+	b := &Bindings{converter: typescriptify.New()}
+	methods, err := b.getMethods(&contextStruct{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	method := findMethod(methods, "binding.contextStruct.WithContext")
+	if method == nil {
+		t.Fatal("WithContext not bound")
+	}
+	if method.InputCount() != 1 || method.Inputs[0].TypeName != "string" {
+		t.Fatalf("context should not be an input: %+v", method.Inputs)
+	}
+
+	args, err := method.ParseArgs([]json.RawMessage{json.RawMessage(`"Bill"`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), contextKey("greeting"), "Hello")
+	result, err := method.Call(ctx, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != "Hello Bill" {
+		t.Errorf("Call() = %v, want Hello Bill", result)
+	}
+
+	// Only a leading context is provided by the call
+	method = findMethod(methods, "binding.contextStruct.ContextNotFirst")
+	if method == nil {
+		t.Fatal("ContextNotFirst not bound")
+	}
+	if method.InputCount() != 2 {
+		t.Errorf("InputCount() = %d, want 2", method.InputCount())
+	}
+}
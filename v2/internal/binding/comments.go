@@ -0,0 +1,244 @@
+package binding
+
+import (
+	"bufio"
+	"bytes"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// packageComments holds the doc comments of a parsed Go package
+type packageComments struct {
+	// map[structname.methodname] or map[functionname] -> comment
+	methods map[string]string
+	// map[structname] -> map[fieldname] -> comment
+	fields map[string]map[string]string
+}
+
+// AddComments reads the doc comments of the bound methods, and of the fields of the
+// structs in the generated models, from the Go source. The main package is read
+// from the given project directory.
+func (b *Bindings) AddComments(projectDir string) error {
+	b.addModels()
+	packages := map[string]*packageComments{}
+	getComments := func(pkgPath string) (*packageComments, error) {
+		if comments, exists := packages[pkgPath]; exists {
+			return comments, nil
+		}
+		comments, err := parsePackageComments(pkgPath, projectDir)
+		if err != nil {
+			return nil, err
+		}
+		packages[pkgPath] = comments
+		return comments, nil
+	}
+
+	for _, method := range b.db.methodMap {
+		if method.pkgPath == "" {
+			continue
+		}
+		comments, err := getComments(method.pkgPath)
+		if err != nil {
+			return err
+		}
+		method.Comments = comments.methods[method.docName]
+	}
+
+	for _, model := range modelStructs(b.models) {
+		comments, err := getComments(model.PkgPath())
+		if err != nil {
+			return err
+		}
+		fields := comments.fields[model.Name()]
+		for index := 0; index < model.NumField(); index++ {
+			field := model.Field(index)
+			comment := fields[field.Name]
+			jsonName := jsonFieldName(field)
+			if comment == "" || jsonName == "" {
+				continue
+			}
+			if b.fieldComments == nil {
+				b.fieldComments = make(map[string]map[string]string)
+			}
+			if b.fieldComments[model.Name()] == nil {
+				b.fieldComments[model.Name()] = make(map[string]string)
+			}
+			b.fieldComments[model.Name()][jsonName] = comment
+		}
+	}
+	return nil
+}
+
+// modelStructs returns the given structs and the named structs used by their fields
+func modelStructs(models []reflect.Type) []reflect.Type {
+	var result []reflect.Type
+	seen := map[reflect.Type]bool{}
+	var add func(typ reflect.Type)
+	add = func(typ reflect.Type) {
+		for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct || typ.Name() == "" || typ.PkgPath() == "" || seen[typ] {
+			return
+		}
+		if _, isCustom := customTSType(typ, ""); isCustom {
+			return
+		}
+		seen[typ] = true
+		result = append(result, typ)
+		for index := 0; index < typ.NumField(); index++ {
+			add(typ.Field(index).Type)
+		}
+	}
+	for _, model := range models {
+		add(model)
+	}
+	return result
+}
+
+// jsonFieldName returns the name of the given field in JSON, or an empty string if it isn't marshalled
+func jsonFieldName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
+
+// parsePackageComments parses the doc comments of the given package
+func parsePackageComments(pkgPath string, projectDir string) (*packageComments, error) {
+	dir := projectDir
+	if pkgPath != "main" {
+		pkg, err := build.Import(pkgPath, projectDir, build.FindOnly)
+		if err != nil {
+			return nil, err
+		}
+		dir = pkg.Dir
+	}
+
+	fset := token.NewFileSet()
+	notTests := func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}
+	parsed, err := parser.ParseDir(fset, dir, notTests, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &packageComments{
+		methods: map[string]string{},
+		fields:  map[string]map[string]string{},
+	}
+	for _, pkg := range parsed {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if decl.Doc == nil {
+						continue
+					}
+					if decl.Recv == nil || len(decl.Recv.List) == 0 {
+						result.methods[decl.Name.Name] = decl.Doc.Text()
+					} else if structName := receiverName(decl.Recv.List[0].Type); structName != "" {
+						result.methods[structName+"."+decl.Name.Name] = decl.Doc.Text()
+					}
+				case *ast.GenDecl:
+					for _, spec := range decl.Specs {
+						typeSpec, ok := spec.(*ast.TypeSpec)
+						if !ok {
+							continue
+						}
+						structType, ok := typeSpec.Type.(*ast.StructType)
+						if !ok {
+							continue
+						}
+						result.fields[typeSpec.Name.Name] = structFieldComments(structType)
+					}
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// receiverName returns the name of the type of a method receiver
+func receiverName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	default:
+		return ""
+	}
+}
+
+// structFieldComments returns the doc comments of the fields of a struct. Line comments
+// are used for fields without a doc comment.
+func structFieldComments(structType *ast.StructType) map[string]string {
+	result := map[string]string{}
+	for _, field := range structType.Fields.List {
+		comment := field.Doc.Text()
+		if comment == "" {
+			comment = field.Comment.Text()
+		}
+		if comment == "" {
+			continue
+		}
+		for _, name := range field.Names {
+			result[name.Name] = comment
+		}
+	}
+	return result
+}
+
+// formatComment formats a Go comment as the lines of a JSDoc comment with the given indent
+func formatComment(comment string, indent string) string {
+	var result strings.Builder
+	for _, line := range strings.Split(strings.TrimSpace(comment), "\n") {
+		// Prevent the comment from being closed early
+		line = strings.ReplaceAll(line, "*/", "*\\/")
+		result.WriteString(strings.TrimRight(indent+" * "+line, " ") + "\n")
+	}
+	return result.String()
+}
+
+var (
+	classRegex = regexp.MustCompile(`^export class (\w+)`)
+	fieldRegex = regexp.MustCompile(`^(\s+)(\w+)\??: `)
+)
+
+// addFieldComments adds the comments of the struct fields to the classes in the given models
+func (b *Bindings) addFieldComments(models []byte) []byte {
+	var result bytes.Buffer
+	var fields map[string]string
+	scanner := bufio.NewScanner(bytes.NewReader(models))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := classRegex.FindStringSubmatch(line); match != nil {
+			fields = b.fieldComments[match[1]]
+		} else if strings.HasPrefix(line, "}") {
+			fields = nil
+		} else if match := fieldRegex.FindStringSubmatch(line); match != nil && fields[match[2]] != "" {
+			indent := match[1]
+			result.WriteString(indent + "/**\n")
+			result.WriteString(formatComment(fields[match[2]], indent))
+			result.WriteString(indent + " */\n")
+		}
+		result.WriteString(line + "\n")
+	}
+	return result.Bytes()
+}
@@ -0,0 +1,77 @@
+package binding
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePackageComments(t *testing.T) {
+	comments, err := parsePackageComments("main", "testdata/comments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantMethods := map[string]string{
+		"Greeter.Greet": "Greet returns a greeting for the given name\n",
+		"helper":        "helper isn't a method\n",
+	}
+	if !reflect.DeepEqual(comments.methods, wantMethods) {
+		t.Errorf("methods = %#v, want %#v", comments.methods, wantMethods)
+	}
+	wantFields := map[string]map[string]string{
+		"Greeting": {
+			"Message": "Message is the greeting for the user.\nIt may span multiple lines.\n",
+			"Count":   "The number of greetings\n",
+		},
+		"Greeter": {},
+	}
+	if !reflect.DeepEqual(comments.fields, wantFields) {
+		t.Errorf("fields = %#v, want %#v", comments.fields, wantFields)
+	}
+}
+
+func TestAddFieldComments(t *testing.T) {
+	b := &Bindings{
+		fieldComments: map[string]map[string]string{
+			"Greeting": {
+				"message": "Message is the greeting.\n\nIt can't contain */ unescaped.\n",
+			},
+		},
+	}
+	models := `export {};
+
+export class Greeting {
+    message: string;
+    count: number;
+
+    static createFrom(source: any = {}) {
+        return new Greeting(source);
+    }
+}
+export class Other {
+    message?: string;
+}
+`
+	want := `export {};
+
+export class Greeting {
+    /**
+     * Message is the greeting.
+     *
+     * It can't contain *\/ unescaped.
+     */
+    message: string;
+    count: number;
+
+    static createFrom(source: any = {}) {
+        return new Greeting(source);
+    }
+}
+export class Other {
+    message?: string;
+}
+`
+	got := string(b.addFieldComments([]byte(models)))
+	if got != want {
+		t.Errorf("addFieldComments() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -2,6 +2,9 @@ package binding
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 	"sync"
 	"unsafe"
 )
@@ -15,6 +18,10 @@ type DB struct {
 	// It used for performance gains at runtime
 	methodMap map[string]*BoundMethod
 
+	// map[functionname] -> *function
+	// Functions are bound in a flat namespace, alongside the package names
+	functions map[string]*BoundMethod
+
 	// Lock to ensure sync access to the data
 	lock sync.RWMutex
 }
@@ -23,6 +30,7 @@ func newDB() *DB {
 	return &DB{
 		store:     make(map[string]map[string]map[string]*BoundMethod),
 		methodMap: make(map[string]*BoundMethod),
+		functions: make(map[string]*BoundMethod),
 	}
 }
 
@@ -90,6 +98,81 @@ func (d *DB) AddMethod(packageName string, structName string, methodName string,
 
 }
 
+// AddFunction adds the given function definition to the db using the given name
+func (d *DB) AddFunction(functionName string, functionDefinition *BoundMethod) {
+
+	// Lock the db whilst processing and unlock on return
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.functions[functionName] = functionDefinition
+	d.methodMap[functionName] = functionDefinition
+}
+
+// hasFunction returns true if a function is bound with the given name
+func (d *DB) hasFunction(name string) bool {
+
+	// Lock the db whilst processing and unlock on return
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	_, exists := d.functions[name]
+	return exists
+}
+
+// hasPackage returns true if methods are bound from a package with the given name.
+// Packages namespaced by path are matched by the first part of their name.
+func (d *DB) hasPackage(name string) bool {
+
+	// Lock the db whilst processing and unlock on return
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	for packageName := range d.store {
+		if packageName == name || strings.HasPrefix(packageName, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStruct returns an error if a different struct with the same package and
+// struct name has already been bound, or if the struct and another package are
+// bound with overlapping namespaces
+func (d *DB) checkStruct(packageName string, structName string, structType reflect.Type) error {
+
+	// Lock the db whilst processing and unlock on return
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	for _, method := range d.store[packageName][structName] {
+		if method.structType != structType {
+			return fmt.Errorf("'%s.%s' is bound from both '%s' and '%s'", packageName, structName, method.structType.PkgPath(), structType.PkgPath())
+		}
+		return nil
+	}
+
+	// EG: a struct `auth.Service` and a package `auth.Service.internal`
+	structPath := packageName + "." + structName
+	for otherPackage, structs := range d.store {
+		if otherPackage == structPath || strings.HasPrefix(otherPackage, structPath+".") {
+			return fmt.Errorf("'%s' collides with the bound package '%s'", structPath, otherPackage)
+		}
+		for otherStruct := range structs {
+			otherPath := otherPackage + "." + otherStruct
+			if packageName == otherPath || strings.HasPrefix(packageName, otherPath+".") {
+				return fmt.Errorf("package '%s' collides with the bound struct '%s'", packageName, otherPath)
+			}
+		}
+	}
+	return nil
+}
+
+// boundName is the JSON sent to the frontend for a bound method or function
+type boundName struct {
+	Name string `json:"name"`
+}
+
 // ToJSON converts the method map to JSON
 func (d *DB) ToJSON() (string, error) {
 
@@ -97,7 +180,25 @@ func (d *DB) ToJSON() (string, error) {
 	d.lock.RLock()
 	defer d.lock.RUnlock()
 
-	bytes, err := json.Marshal(&d.store)
+	// The frontend only needs the names of the methods to create their wrappers.
+	// Functions are bound alongside the packages.
+	bindings := make(map[string]interface{}, len(d.store)+len(d.functions))
+	for packageName, structs := range d.store {
+		structNames := make(map[string]map[string]boundName, len(structs))
+		for structName, methods := range structs {
+			methodNames := make(map[string]boundName, len(methods))
+			for methodName, method := range methods {
+				methodNames[methodName] = boundName{Name: method.Name}
+			}
+			structNames[structName] = methodNames
+		}
+		bindings[packageName] = structNames
+	}
+	for functionName, function := range d.functions {
+		bindings[functionName] = boundName{Name: function.Name}
+	}
+
+	bytes, err := json.Marshal(bindings)
 
 	// Return zero copy string as this string will be read only
 	return *(*string)(unsafe.Pointer(&bytes)), err
@@ -0,0 +1,68 @@
+package binding
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// AddFunctions adds the given package-level functions to the Bindings. The functions
+// are bound in a flat namespace using the given names, EG: `window.go.Greet()`.
+func (b *Bindings) AddFunctions(functions map[string]interface{}) error {
+
+	// Sort the names so that errors are reported consistently
+	var names []string
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err := b.addFunction(name, functions[name])
+		if err != nil {
+			return fmt.Errorf("cannot bind function '%s': %s", name, err.Error())
+		}
+	}
+	return nil
+}
+
+func (b *Bindings) addFunction(name string, function interface{}) error {
+	if function == nil || !isFunction(function) {
+		return fmt.Errorf("%T is not a function", function)
+	}
+	if !token.IsIdentifier(name) {
+		return fmt.Errorf("not a valid identifier")
+	}
+	if b.db.hasFunction(name) {
+		return fmt.Errorf("a function with this name is already bound")
+	}
+	if b.db.hasPackage(name) {
+		return fmt.Errorf("the name is used by the bound package '%s'", name)
+	}
+
+	value := reflect.ValueOf(function)
+	boundFunction := b.newBoundMethod(name, value)
+	boundFunction.pkgPath, boundFunction.docName = functionDeclaration(value)
+	b.db.AddFunction(name, boundFunction)
+	b.methods = append(b.methods, boundFunction)
+	return nil
+}
+
+// functionDeclaration returns the package path and name of the given function.
+// Empty strings are returned for function literals.
+func functionDeclaration(function reflect.Value) (string, string) {
+	// EG: github.com/user/project/services.Login
+	fullName := runtime.FuncForPC(function.Pointer()).Name()
+	lastSlash := strings.LastIndex(fullName, "/")
+	split := strings.Split(fullName[lastSlash+1:], ".")
+	if len(split) != 2 {
+		// Closures and methods have further parts, EG: main.main.func1
+		return "", ""
+	}
+	// Dots in the last element of the package path are escaped
+	packageName := strings.ReplaceAll(split[0], "%2e", ".")
+	return fullName[:lastSlash+1] + packageName, split[1]
+}
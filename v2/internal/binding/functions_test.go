@@ -0,0 +1,56 @@
+package binding
+
+import (
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+func TestAddFunctions(t *testing.T) {
+	b := newTestBindings(t)
+	function := b.DB().GetMethod("Lookup")
+	if function == nil {
+		t.Fatal("Lookup was not bound")
+	}
+	if !function.needsContext || function.InputCount() != 1 || function.OutputCount() != 2 {
+		t.Errorf("Lookup bound incorrectly: %+v", function)
+	}
+	if function.pkgPath != "github.com/wailsapp/wails/v2/internal/binding" || function.docName != "Lookup" {
+		t.Errorf("declaration = %s %s", function.pkgPath, function.docName)
+	}
+	if double := b.DB().GetMethod("Double"); double == nil || double.pkgPath != "" {
+		t.Errorf("Double bound incorrectly: %+v", double)
+	}
+}
+
+func TestAddFunctions_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		functions map[string]interface{}
+	}{
+		{"not a function", map[string]interface{}{"Value": 1}},
+		{"nil", map[string]interface{}{"Nil": nil}},
+		{"invalid name", map[string]interface{}{"not-valid": Lookup}},
+		{"package collision", map[string]interface{}{"binding": Lookup}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBindings(logger.New(nil), []interface{}{&Service{}}, nil, options.PackageName)
+			if err := b.AddFunctions(tt.functions); err == nil {
+				t.Error("AddFunctions() should return an error")
+			}
+		})
+	}
+}
+
+func TestAdd_Collisions(t *testing.T) {
+	b := NewBindings(logger.New(nil), nil, nil, options.PackageName)
+	err := b.AddFunctions(map[string]interface{}{"binding": Lookup})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Add(&Service{}); err == nil {
+		t.Error("Add() should return an error when the package name is bound as a function")
+	}
+}
@@ -16,8 +16,16 @@ import (
 //go:embed assets/package.json
 var packageJSON []byte
 
+// The prefixes used to reference the generated models
+const (
+	jsDocModelsPrefix = "import('./models')."
+	tsModelsPrefix    = "models."
+)
+
 func (b *Bindings) GenerateBackendJS(targetfile string, isDevBindings bool) error {
 
+	b.addModels()
+
 	store := b.db.store
 	var output bytes.Buffer
 
@@ -38,73 +46,12 @@ func (b *Bindings) GenerateBackendJS(targetfile string, isDevBindings bool) erro
 	output.WriteString(`const go = {`)
 	output.WriteString("\n")
 
-	var sortedPackageNames slicer.StringSlicer
-	for packageName := range store {
-		sortedPackageNames.Add(packageName)
+	b.writeJSNamespace(&output, newNamespaceTree(store), 0)
+
+	for _, functionName := range sortedMethodNames(b.db.functions) {
+		target := "window.go." + functionName
+		b.writeJSMethod(&output, "  ", functionName, b.db.functions[functionName], target)
 	}
-	sortedPackageNames.Sort()
-	sortedPackageNames.Each(func(packageName string) {
-		packages := store[packageName]
-		output.WriteString(fmt.Sprintf("  \"%s\": {", packageName))
-		output.WriteString("\n")
-		var sortedStructNames slicer.StringSlicer
-		for structName := range packages {
-			sortedStructNames.Add(structName)
-		}
-		sortedStructNames.Sort()
-
-		sortedStructNames.Each(func(structName string) {
-			structs := packages[structName]
-			output.WriteString(fmt.Sprintf("    \"%s\": {", structName))
-			output.WriteString("\n")
-
-			var sortedMethodNames slicer.StringSlicer
-			for methodName := range structs {
-				sortedMethodNames.Add(methodName)
-			}
-			sortedMethodNames.Sort()
-
-			sortedMethodNames.Each(func(methodName string) {
-				methodDetails := structs[methodName]
-				output.WriteString("      /**\n")
-				output.WriteString("       * " + methodName + "\n")
-				var args slicer.StringSlicer
-				for count, input := range methodDetails.Inputs {
-					arg := fmt.Sprintf("arg%d", count+1)
-					args.Add(arg)
-					output.WriteString(fmt.Sprintf("       * @param {%s} %s - Go Type: %s\n", goTypeToJSDocType(input.TypeName), arg, input.TypeName))
-				}
-				returnType := "Promise"
-				returnTypeDetails := ""
-				if methodDetails.OutputCount() > 0 {
-					firstType := goTypeToJSDocType(methodDetails.Outputs[0].TypeName)
-					returnType += "<" + firstType
-					if methodDetails.OutputCount() == 2 {
-						secondType := goTypeToJSDocType(methodDetails.Outputs[1].TypeName)
-						returnType += "|" + secondType
-					}
-					returnType += ">"
-					returnTypeDetails = " - Go Type: " + methodDetails.Outputs[0].TypeName
-				} else {
-					returnType = "Promise<void>"
-				}
-				output.WriteString("       * @returns {" + returnType + "} " + returnTypeDetails + "\n")
-				output.WriteString("       */\n")
-				argsString := args.Join(", ")
-				output.WriteString(fmt.Sprintf("      \"%s\": (%s) => {", methodName, argsString))
-				output.WriteString("\n")
-				output.WriteString(fmt.Sprintf("        return window.go.%s.%s.%s(%s);", packageName, structName, methodName, argsString))
-				output.WriteString("\n")
-				output.WriteString(fmt.Sprintf("      },"))
-				output.WriteString("\n")
-
-			})
-
-			output.WriteString("    },\n")
-		})
-
-		output.WriteString("  },\n\n")
-	})
 
 	output.WriteString(`};
 export default go;`)
@@ -126,66 +73,18 @@ export default go;`)
 // the bound methods.
 func (b *Bindings) GenerateBackendTS(targetfile string) error {
 
+	b.addModels()
+
 	store := b.db.store
 	var output bytes.Buffer
 
+	output.WriteString("import * as models from './models';\n\n")
 	output.WriteString("export interface go {\n")
 
-	var sortedPackageNames slicer.StringSlicer
-	for packageName := range store {
-		sortedPackageNames.Add(packageName)
+	b.writeTSNamespace(&output, newNamespaceTree(store), 0)
+	for _, functionName := range sortedMethodNames(b.db.functions) {
+		b.writeTSMethod(&output, "  ", functionName, b.db.functions[functionName])
 	}
-	sortedPackageNames.Sort()
-	sortedPackageNames.Each(func(packageName string) {
-		packages := store[packageName]
-		output.WriteString(fmt.Sprintf("  \"%s\": {", packageName))
-		output.WriteString("\n")
-		var sortedStructNames slicer.StringSlicer
-		for structName := range packages {
-			sortedStructNames.Add(structName)
-		}
-		sortedStructNames.Sort()
-
-		sortedStructNames.Each(func(structName string) {
-			structs := packages[structName]
-			output.WriteString(fmt.Sprintf("    \"%s\": {", structName))
-			output.WriteString("\n")
-
-			var sortedMethodNames slicer.StringSlicer
-			for methodName := range structs {
-				sortedMethodNames.Add(methodName)
-			}
-			sortedMethodNames.Sort()
-
-			sortedMethodNames.Each(func(methodName string) {
-				methodDetails := structs[methodName]
-				output.WriteString(fmt.Sprintf("\t\t%s(", methodName))
-
-				var args slicer.StringSlicer
-				for count, input := range methodDetails.Inputs {
-					arg := fmt.Sprintf("arg%d", count+1)
-					args.Add(arg + ":" + goTypeToTypescriptType(input.TypeName))
-				}
-				output.WriteString(args.Join(",") + "):")
-				returnType := "Promise"
-				if methodDetails.OutputCount() > 0 {
-					firstType := goTypeToTypescriptType(methodDetails.Outputs[0].TypeName)
-					returnType += "<" + firstType
-					if methodDetails.OutputCount() == 2 {
-						secondType := goTypeToTypescriptType(methodDetails.Outputs[1].TypeName)
-						returnType += "|" + secondType
-					}
-					returnType += ">"
-				} else {
-					returnType = "Promise<void>"
-				}
-				output.WriteString(returnType + "\n")
-			})
-
-			output.WriteString("    },\n")
-		})
-		output.WriteString("  }\n\n")
-	})
 	output.WriteString("}\n")
 
 	globals := `
@@ -199,6 +98,119 @@ declare global {
 	return os.WriteFile(targetfile, output.Bytes(), 0755)
 }
 
+// writeJSNamespace writes the structs and nested namespaces of the given namespace
+func (b *Bindings) writeJSNamespace(output *bytes.Buffer, ns *namespace, depth int) {
+	indent := strings.Repeat("  ", depth+1)
+	methodIndent := strings.Repeat("  ", depth+2)
+
+	for _, structName := range ns.sortedStructs() {
+		structs := ns.structs[structName]
+		output.WriteString(fmt.Sprintf("%s\"%s\": {", indent, structName))
+		output.WriteString("\n")
+		for _, methodName := range sortedMethodNames(structs) {
+			target := fmt.Sprintf("window.go.%s.%s.%s", ns.name, structName, methodName)
+			b.writeJSMethod(output, methodIndent, methodName, structs[methodName], target)
+		}
+		output.WriteString(indent + "},\n")
+	}
+
+	for _, name := range ns.sortedChildren() {
+		output.WriteString(fmt.Sprintf("%s\"%s\": {", indent, name))
+		output.WriteString("\n")
+		b.writeJSNamespace(output, ns.children[name], depth+1)
+		if depth == 0 {
+			output.WriteString(indent + "},\n\n")
+		} else {
+			output.WriteString(indent + "},\n")
+		}
+	}
+}
+
+// writeTSNamespace writes the declarations of the structs and nested namespaces of the given namespace
+func (b *Bindings) writeTSNamespace(output *bytes.Buffer, ns *namespace, depth int) {
+	indent := strings.Repeat("  ", depth+1)
+	methodIndent := strings.Repeat("\t", depth+1)
+
+	for _, structName := range ns.sortedStructs() {
+		structs := ns.structs[structName]
+		output.WriteString(fmt.Sprintf("%s\"%s\": {", indent, structName))
+		output.WriteString("\n")
+		for _, methodName := range sortedMethodNames(structs) {
+			b.writeTSMethod(output, methodIndent, methodName, structs[methodName])
+		}
+		output.WriteString(indent + "},\n")
+	}
+
+	for _, name := range ns.sortedChildren() {
+		output.WriteString(fmt.Sprintf("%s\"%s\": {", indent, name))
+		output.WriteString("\n")
+		b.writeTSNamespace(output, ns.children[name], depth+1)
+		if depth == 0 {
+			output.WriteString(indent + "}\n\n")
+		} else {
+			output.WriteString(indent + "},\n")
+		}
+	}
+}
+
+// writeJSMethod writes the JSDoc annotated wrapper for the given method or function
+func (b *Bindings) writeJSMethod(output *bytes.Buffer, indent string, methodName string, methodDetails *BoundMethod, target string) {
+	output.WriteString(indent + "/**\n")
+	output.WriteString(indent + " * " + methodName + "\n")
+	if methodDetails.Comments != "" {
+		output.WriteString(indent + " *\n")
+		output.WriteString(formatComment(methodDetails.Comments, indent))
+	}
+	var args slicer.StringSlicer
+	for count, input := range methodDetails.Inputs {
+		arg := fmt.Sprintf("arg%d", count+1)
+		args.Add(arg)
+		output.WriteString(fmt.Sprintf("%s * @param {%s} %s - Go Type: %s\n", indent, b.parameterTSType(input, jsDocModelsPrefix), arg, input.TypeName))
+	}
+	returnType := b.returnTSType(methodDetails, jsDocModelsPrefix)
+	returnTypeDetails := ""
+	if methodDetails.OutputCount() > 0 {
+		returnTypeDetails = " - Go Type: " + methodDetails.Outputs[0].TypeName
+	}
+	output.WriteString(indent + " * @returns {" + returnType + "} " + returnTypeDetails + "\n")
+	output.WriteString(indent + " */\n")
+	argsString := args.Join(", ")
+	output.WriteString(fmt.Sprintf("%s\"%s\": (%s) => {", indent, methodName, argsString))
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("%s  return %s(%s);", indent, target, argsString))
+	output.WriteString("\n")
+	output.WriteString(indent + "},")
+	output.WriteString("\n")
+}
+
+// writeTSMethod writes the Typescript declaration of the given method or function
+func (b *Bindings) writeTSMethod(output *bytes.Buffer, indent string, methodName string, methodDetails *BoundMethod) {
+	if methodDetails.Comments != "" {
+		output.WriteString(indent + "/**\n")
+		output.WriteString(formatComment(methodDetails.Comments, indent))
+		output.WriteString(indent + " */\n")
+	}
+	output.WriteString(fmt.Sprintf("%s%s(", indent, methodName))
+
+	var args slicer.StringSlicer
+	for count, input := range methodDetails.Inputs {
+		arg := fmt.Sprintf("arg%d", count+1)
+		args.Add(arg + ":" + b.parameterTSType(input, tsModelsPrefix))
+	}
+	output.WriteString(args.Join(",") + "):")
+	output.WriteString(b.returnTSType(methodDetails, tsModelsPrefix) + "\n")
+}
+
+// sortedMethodNames returns the names of the given methods or functions in order
+func sortedMethodNames(methods map[string]*BoundMethod) []string {
+	var result slicer.StringSlicer
+	for methodName := range methods {
+		result.Add(methodName)
+	}
+	result.Sort()
+	return result.AsSlice()
+}
+
 func goTypeToJSDocType(input string) string {
 	switch true {
 	case input == "interface{}":
@@ -214,6 +226,11 @@ func goTypeToJSDocType(input string) string {
 		return "number"
 	case input == "bool":
 		return "boolean"
+	case
+		strings.HasPrefix(input, "chan "),
+		strings.HasPrefix(input, "<-chan "):
+		// Channels are streamed as progress, the call resolves without a value
+		return "void"
 	case input == "[]byte":
 		return "string"
 	case strings.HasPrefix(input, "[]"):
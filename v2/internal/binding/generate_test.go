@@ -76,6 +76,11 @@ func Test_goTypeToJSDocType(t *testing.T) {
 			input: "[]bool",
 			want:  "Array<boolean>",
 		},
+		{
+			name:  "<-chan int",
+			input: "<-chan int",
+			want:  "void",
+		},
 		{
 			name:  "anything else",
 			input: "foo",
@@ -0,0 +1,68 @@
+package binding
+
+import (
+	"sort"
+	"strings"
+)
+
+// namespace is a level of the generated bindings. Packages namespaced by
+// package path are nested, EG: `internal.auth` is `auth` inside `internal`.
+type namespace struct {
+	// The full name of the namespace, EG: `internal.auth`
+	name     string
+	children map[string]*namespace
+	// map[structname] -> map[methodname] -> method
+	structs map[string]map[string]*BoundMethod
+}
+
+func newNamespace(name string) *namespace {
+	return &namespace{
+		name:     name,
+		children: make(map[string]*namespace),
+		structs:  make(map[string]map[string]*BoundMethod),
+	}
+}
+
+// newNamespaceTree returns the root namespace for the given store
+func newNamespaceTree(store map[string]map[string]map[string]*BoundMethod) *namespace {
+	root := newNamespace("")
+	for packageName, structs := range store {
+		current := root
+		for _, part := range strings.Split(packageName, ".") {
+			child, exists := current.children[part]
+			if !exists {
+				childName := part
+				if current.name != "" {
+					childName = current.name + "." + part
+				}
+				child = newNamespace(childName)
+				current.children[part] = child
+			}
+			current = child
+		}
+		for structName, methods := range structs {
+			current.structs[structName] = methods
+		}
+	}
+	return root
+}
+
+// sortedChildren returns the names of the nested namespaces in order
+func (n *namespace) sortedChildren() []string {
+	result := make([]string, 0, len(n.children))
+	for name := range n.children {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// sortedStructs returns the names of the structs in the namespace in order
+func (n *namespace) sortedStructs() []string {
+	result := make([]string, 0, len(n.structs))
+	for name := range n.structs {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
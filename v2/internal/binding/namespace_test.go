@@ -0,0 +1,74 @@
+package binding
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+type Auth struct{}
+
+func (a *Auth) Login(username string) bool { return false }
+
+func newNamespacedBindings(t *testing.T) *Bindings {
+	b := NewBindings(logger.New(nil), nil, nil, options.PackagePath)
+	b.modulePath = "github.com/wailsapp/wails/v2"
+	for _, structPtr := range []interface{}{&Service{}, &Auth{}} {
+		if err := b.Add(structPtr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+func TestPackagePathNamespace(t *testing.T) {
+	b := newNamespacedBindings(t)
+	if b.DB().GetMethod("internal.binding.Auth.Login") == nil {
+		t.Error("Auth.Login should be bound in the package path namespace")
+	}
+	if b.DB().GetMethod("binding.Auth.Login") != nil {
+		t.Error("Auth.Login should not be bound in the package name namespace")
+	}
+
+	filename := filepath.Join(t.TempDir(), "bindings.d.ts")
+	err := b.GenerateBackendTS(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "bindings_namespaced.d.ts.golden", got)
+}
+
+func TestPackagePathNamespace_Collision(t *testing.T) {
+	b := newNamespacedBindings(t)
+	// `internal.binding.Auth` is already a struct, so it can't be a package
+	err := b.db.checkStruct("internal.binding.Auth", "Other", nil)
+	if err == nil {
+		t.Error("checkStruct() should return an error for a package nested in a struct")
+	}
+	err = b.db.checkStruct("internal", "binding", nil)
+	if err == nil {
+		t.Error("checkStruct() should return an error for a struct containing a package")
+	}
+}
+
+func Test_identifier(t *testing.T) {
+	tests := map[string]string{
+		"services":  "services",
+		"go-sqlite": "go_sqlite",
+		"yaml.v2":   "yaml_v2",
+		"2fa":       "_2fa",
+		"v2":        "v2",
+	}
+	for input, want := range tests {
+		if got := identifier(input); got != want {
+			t.Errorf("identifier(%s) = %s, want %s", input, got, want)
+		}
+	}
+}
@@ -1,11 +1,20 @@
 package binding
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"runtime"
+	"runtime/debug"
+	"strings"
+	"unicode"
+
+	"github.com/leaanthony/typescriptify-golang-structs/typescriptify"
+	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
 // isStructPtr returns true if the value given is a
 // pointer to a struct
 func isStructPtr(value interface{}) bool {
@@ -38,7 +47,7 @@ func (b *Bindings) getMethods(value interface{}) ([]*BoundMethod, error) {
 
 		if isFunction(value) {
 			name := runtime.FuncForPC(reflect.ValueOf(value).Pointer()).Name()
-			return nil, fmt.Errorf("%s is a function, not a pointer to a struct. Wails v2 has deprecated the binding of functions. Please wrap your functions up in a struct and bind a pointer to that struct.", name)
+			return nil, fmt.Errorf("%s is a function, not a pointer to a struct. Wails v2 has deprecated the binding of functions. Please wrap your functions up in a struct and bind a pointer to that struct, or bind them using `BindFunctions`.", name)
 		}
 
 		return nil, fmt.Errorf("not a pointer to a struct.")
@@ -47,14 +56,14 @@ func (b *Bindings) getMethods(value interface{}) ([]*BoundMethod, error) {
 	// Process Struct
 	structType := reflect.TypeOf(value)
 	structValue := reflect.ValueOf(value)
-	baseName := structType.String()[1:]
+	baseName := b.packageNamespace(structType.Elem()) + "." + structType.Elem().Name()
 
 	// Process Methods
 	for i := 0; i < structType.NumMethod(); i++ {
 		methodDef := structType.Method(i)
 		methodName := methodDef.Name
 		fullMethodName := baseName + "." + methodName
-		method := structValue.MethodByName(methodName)
+		method := structValue.Method(i)
 
 		methodReflectName := runtime.FuncForPC(methodDef.Func.Pointer()).Name()
 		if b.exemptions.Contains(methodReflectName) {
@@ -62,89 +71,166 @@ func (b *Bindings) getMethods(value interface{}) ([]*BoundMethod, error) {
 		}
 
 		// Create new method
-		boundMethod := &BoundMethod{
-			Name:     fullMethodName,
-			Inputs:   nil,
-			Outputs:  nil,
-			Comments: "",
-			Method:   method,
-		}
-
-		// Iterate inputs
-		methodType := method.Type()
-		inputParamCount := methodType.NumIn()
-		var inputs []*Parameter
-		for inputIndex := 0; inputIndex < inputParamCount; inputIndex++ {
-			input := methodType.In(inputIndex)
-			thisParam := newParameter("", input)
-
-			thisInput := input
-
-			if thisInput.Kind() == reflect.Slice {
-				thisInput = thisInput.Elem()
-			}
-
-			// Process struct pointer params
-			if thisInput.Kind() == reflect.Ptr {
-				if thisInput.Elem().Kind() == reflect.Struct {
-					typ := thisInput.Elem()
-					a := reflect.New(typ)
-					s := reflect.Indirect(a).Interface()
-					b.converter.Add(s)
-				}
-			}
-
-			// Process struct params
-			if thisInput.Kind() == reflect.Struct {
-				a := reflect.New(thisInput)
-				s := reflect.Indirect(a).Interface()
-				b.converter.Add(s)
-			}
+		boundMethod := b.newBoundMethod(fullMethodName, method)
+		boundMethod.structType = structType.Elem()
+		boundMethod.pkgPath = structType.Elem().PkgPath()
+		boundMethod.docName = structType.Elem().Name() + "." + methodName
 
-			inputs = append(inputs, thisParam)
-		}
+		// Save method in result
+		result = append(result, boundMethod)
 
-		boundMethod.Inputs = inputs
+	}
+	return result, nil
+}
 
-		// Iterate outputs
-		// TODO: Determine what to do about limiting return types
-		//       especially around errors.
-		outputParamCount := methodType.NumOut()
-		var outputs []*Parameter
-		for outputIndex := 0; outputIndex < outputParamCount; outputIndex++ {
-			output := methodType.Out(outputIndex)
-			thisParam := newParameter("", output)
+// packageNamespace returns the namespace the methods of the given struct are bound in.
+// When namespaced by package path, the path is relative to the main module with each
+// directory as a part of the namespace, EG: `internal/auth` -> `internal.auth`.
+func (b *Bindings) packageNamespace(structType reflect.Type) string {
+	packageName := strings.Split(structType.String(), ".")[0]
+	if b.namespace != options.PackagePath || structType.PkgPath() == "main" || structType.PkgPath() == b.modulePath {
+		return packageName
+	}
 
-			thisOutput := output
+	pkgPath := strings.TrimPrefix(structType.PkgPath(), b.modulePath+"/")
+	var parts []string
+	for _, part := range strings.Split(pkgPath, "/") {
+		parts = append(parts, identifier(part))
+	}
+	return strings.Join(parts, ".")
+}
 
-			if thisOutput.Kind() == reflect.Slice {
-				thisOutput = thisOutput.Elem()
+// identifier converts the given path element to a valid Javascript identifier
+func identifier(name string) string {
+	var result strings.Builder
+	for index, char := range name {
+		switch {
+		case char == '_', char == '$', unicode.IsLetter(char):
+		case unicode.IsDigit(char):
+			if index == 0 {
+				result.WriteRune('_')
 			}
+		default:
+			char = '_'
+		}
+		result.WriteRune(char)
+	}
+	return result.String()
+}
 
-			// Process struct pointer params
-			if thisOutput.Kind() == reflect.Ptr {
-				if thisOutput.Elem().Kind() == reflect.Struct {
-					typ := thisOutput.Elem()
-					a := reflect.New(typ)
-					s := reflect.Indirect(a).Interface()
-					b.converter.Add(s)
-				}
-			}
+// mainModulePath returns the path of the main module of the application
+func mainModulePath() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	return info.Main.Path
+}
 
-			// Process struct params
-			if thisOutput.Kind() == reflect.Struct {
-				a := reflect.New(thisOutput)
-				s := reflect.Indirect(a).Interface()
-				b.converter.Add(s)
-			}
+// newBoundMethod creates a BoundMethod for the given method or function value.
+// Its inputs and outputs are described when they are first needed.
+func (b *Bindings) newBoundMethod(name string, method reflect.Value) *BoundMethod {
+	methodType := method.Type()
+	return &BoundMethod{
+		Name:   name,
+		Method: method,
+		// A leading context.Context is provided by the call, not the frontend
+		needsContext: methodType.NumIn() > 0 && methodType.In(0) == contextType,
+	}
+}
 
-			outputs = append(outputs, thisParam)
+// addModels adds the structs used by the bound methods to the generated models. It is deferred
+// until the bindings are generated, as the models aren't needed to call the methods.
+func (b *Bindings) addModels() {
+	if b.modelsAdded {
+		return
+	}
+	b.modelsAdded = true
+	for _, method := range b.methods {
+		method.describe()
+		for _, input := range method.Inputs {
+			b.addModel(input.reflectType)
+		}
+		for _, output := range method.Outputs {
+			b.addModel(output.reflectType)
 		}
-		boundMethod.Outputs = outputs
+	}
+}
 
-		// Save method in result
-		result = append(result, boundMethod)
+// addModel adds the struct used by the given parameter type to the generated models.
+// Pointers, slices, arrays, maps and channels are unwrapped to find the struct.
+func (b *Bindings) addModel(typ reflect.Type) {
+	for {
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+			typ = typ.Elem()
+			continue
+		}
+		if typ == timeType {
+			b.usesTime = true
+			return
+		}
+		// Types with custom marshalling aren't generated from their structure
+		if _, isCustom := customTSType(typ, ""); isCustom {
+			return
+		}
+		// Anonymous structs can't be referenced
+		if typ.Kind() == reflect.Struct && typ.Name() != "" {
+			b.manageFieldTypes(typ, map[reflect.Type]bool{})
+			b.converter.Add(reflect.Zero(typ).Interface())
+			b.models = append(b.models, typ)
+		}
+		return
+	}
+}
 
+// manageFieldTypes sets the Typescript type of the fields of the given struct, and the structs it
+// uses, that hold time.Time or types with custom marshalling, so they aren't generated as classes
+func (b *Bindings) manageFieldTypes(typ reflect.Type, visited map[reflect.Type]bool) {
+	if visited[typ] {
+		return
 	}
-	return result, nil
+	visited[typ] = true
+	for i := 0; i < typ.NumField(); i++ {
+		fieldType := typ.Field(i).Type
+		// The converter looks up the options of pointer fields by the type they point to
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if tsType, isCustom := b.fieldTSType(fieldType); isCustom {
+			b.converter.ManageType(fieldType, typescriptify.TypeOptions{TSType: tsType})
+			continue
+		}
+		for fieldType.Kind() == reflect.Ptr || fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array || fieldType.Kind() == reflect.Map {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct {
+			b.manageFieldTypes(fieldType, visited)
+		}
+	}
+}
+
+// fieldTSType returns the Typescript type of a field type that holds time.Time or
+// a type with custom marshalling, directly or in a slice, array or map
+func (b *Bindings) fieldTSType(typ reflect.Type) (string, bool) {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if customType, isCustom := customTSType(typ, ""); isCustom {
+		if typ == timeType {
+			b.usesTime = true
+		}
+		return customType, true
+	}
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		if elemType, isCustom := b.fieldTSType(typ.Elem()); isCustom {
+			return "Array<" + elemType + ">", true
+		}
+	case reflect.Map:
+		if elemType, isCustom := b.fieldTSType(typ.Elem()); isCustom {
+			return "{[key: string]: " + elemType + "}", true
+		}
+	}
+	return "", false
 }
@@ -0,0 +1,24 @@
+package main
+
+// Greeting is returned by the Greeter
+type Greeting struct {
+	// Message is the greeting for the user.
+	// It may span multiple lines.
+	Message string `json:"message"`
+	Count   int    `json:"count"` // The number of greetings
+	ignored string
+}
+
+type Greeter struct{}
+
+// Greet returns a greeting for the given name
+func (g *Greeter) Greet(name string) Greeting {
+	return Greeting{Message: "Hello " + name}
+}
+
+func (g Greeter) Undocumented() {}
+
+// helper isn't a method
+func helper() {}
+
+func main() {}
@@ -0,0 +1,7 @@
+package main
+
+// Ignored is defined in a test file
+type Ignored struct{}
+
+// Method is defined in a test file
+func (g *Greeter) Method() {}
@@ -0,0 +1,305 @@
+package binding
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	errorType         = reflect.TypeOf((*error)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	tsTyperType       = reflect.TypeOf((*TypescriptTyper)(nil)).Elem()
+)
+
+// TypescriptTyper may be implemented by types with custom JSON marshalling to
+// define the Typescript type generated for them
+type TypescriptTyper interface {
+	TypescriptType() string
+}
+
+// dateTimeHelpers are added to the models if time.Time is used by a bound method
+const dateTimeHelpers = `
+// DateTime is a Go time.Time, encoded as an RFC 3339 string
+export type DateTime = string;
+
+export function parseDateTime(value: DateTime): Date {
+	return new Date(value);
+}
+
+export function toDateTime(date: Date): DateTime {
+	return date.toISOString();
+}
+`
+
+// enum defines a Go type with a set of named values, EG: iota constants,
+// that is generated as a Typescript enum
+type enum struct {
+	name   string
+	values []enumValue
+}
+
+type enumValue struct {
+	name  string
+	value interface{}
+}
+
+// AddEnums adds the given enums to the generated models. Each enum is given
+// as a slice of structs with a `Value` field, holding the Go value, and a
+// `TSName` field, holding the name of the value in Typescript.
+func (b *Bindings) AddEnums(enums []interface{}) error {
+	for _, values := range enums {
+		err := b.addEnum(values)
+		if err != nil {
+			return fmt.Errorf("cannot bind enum: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+func (b *Bindings) addEnum(values interface{}) error {
+	slice := reflect.ValueOf(values)
+	if slice.Kind() != reflect.Slice && slice.Kind() != reflect.Array {
+		return fmt.Errorf("%T is not a slice of enum values", values)
+	}
+	if slice.Len() == 0 {
+		return fmt.Errorf("%T has no values", values)
+	}
+
+	var result *enum
+	var enumType reflect.Type
+	for index := 0; index < slice.Len(); index++ {
+		item := reflect.Indirect(slice.Index(index))
+		if item.Kind() != reflect.Struct {
+			return fmt.Errorf("%T is not a slice of structs", values)
+		}
+		value := item.FieldByName("Value")
+		tsName := item.FieldByName("TSName")
+		if !value.IsValid() || !tsName.IsValid() || tsName.Kind() != reflect.String {
+			return fmt.Errorf("%s requires the fields `Value` and `TSName string`", item.Type())
+		}
+		if result == nil {
+			enumType = value.Type()
+			if enumType.Name() == "" || enumType.PkgPath() == "" {
+				return fmt.Errorf("%s is not a type defined in a package", enumType)
+			}
+			switch tsBasicType(enumType) {
+			case "string", "number":
+			default:
+				return fmt.Errorf("%s is not a string or number type", enumType)
+			}
+			result = &enum{name: enumType.Name()}
+		}
+		if value.Type() != enumType {
+			return fmt.Errorf("enum values of %s have different types", enumType)
+		}
+		result.values = append(result.values, enumValue{
+			name:  tsName.String(),
+			value: value.Interface(),
+		})
+	}
+
+	if b.enums == nil {
+		b.enums = make(map[reflect.Type]*enum)
+	}
+	b.enums[enumType] = result
+	return nil
+}
+
+// generateEnums returns the Typescript definitions of the bound enums
+func (b *Bindings) generateEnums() ([]byte, error) {
+	var enums []*enum
+	for _, e := range b.enums {
+		enums = append(enums, e)
+	}
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].name < enums[j].name
+	})
+
+	var output bytes.Buffer
+	for _, e := range enums {
+		output.WriteString(fmt.Sprintf("\nexport enum %s {\n", e.name))
+		for _, value := range e.values {
+			jsonValue, err := json.Marshal(value.value)
+			if err != nil {
+				return nil, err
+			}
+			output.WriteString(fmt.Sprintf("\t%s = %s,\n", value.name, jsonValue))
+		}
+		output.WriteString("}\n")
+	}
+	return output.Bytes(), nil
+}
+
+// appendModels appends the Typescript enums and helpers to the given models file
+func (b *Bindings) appendModels(filename string) error {
+	if len(b.enums) == 0 && !b.usesTime {
+		return nil
+	}
+	models, err := b.generateEnums()
+	if err != nil {
+		return err
+	}
+	if b.usesTime {
+		models = append(models, dateTimeHelpers...)
+	}
+	file, err := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0755)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(models)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// customTSType returns the Typescript type for types that aren't marshalled by
+// their structure: time.Time, types defining their Typescript type and types
+// with custom JSON marshalling
+func customTSType(typ reflect.Type, modelsPrefix string) (string, bool) {
+	if typ == timeType {
+		return modelsPrefix + "DateTime", true
+	}
+	if typ.Kind() == reflect.Interface {
+		return "", false
+	}
+
+	// Methods may be defined on the value or the pointer
+	ptr := reflect.New(typ)
+	if ptr.Type().Implements(tsTyperType) {
+		return ptr.Interface().(TypescriptTyper).TypescriptType(), true
+	}
+	if ptr.Type().Implements(jsonMarshalerType) {
+		return inferMarshalledType(ptr.Interface().(json.Marshaler)), true
+	}
+	if ptr.Type().Implements(textMarshalerType) {
+		return "string", true
+	}
+	return "", false
+}
+
+// inferMarshalledType determines the Typescript type of a json.Marshaler
+// from the JSON it produces for its zero value
+func inferMarshalledType(marshaler json.Marshaler) (result string) {
+	defer func() {
+		// The zero value may not be valid for the marshaller
+		if recover() != nil {
+			result = "any"
+		}
+	}()
+	data, err := marshaler.MarshalJSON()
+	if err != nil {
+		return "any"
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return "any"
+	}
+	switch data[0] {
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case '[':
+		return "Array<any>"
+	case '{':
+		return "{[key: string]: any}"
+	case 'n':
+		return "any"
+	default:
+		return "number"
+	}
+}
+
+// tsBasicType returns the Typescript type for the kind of the given type
+// if it is not a composite type
+func tsBasicType(typ reflect.Type) string {
+	switch typ.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return ""
+	}
+}
+
+// goTypeToTS returns the Typescript type for the given Go type. Structs and
+// enums are referenced from the models module using the given prefix.
+func (b *Bindings) goTypeToTS(typ reflect.Type, modelsPrefix string) string {
+	if e, exists := b.enums[typ]; exists {
+		return modelsPrefix + e.name
+	}
+	if customType, isCustom := customTSType(typ, modelsPrefix); isCustom {
+		return customType
+	}
+	if basicType := tsBasicType(typ); basicType != "" {
+		return basicType
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return b.goTypeToTS(typ.Elem(), modelsPrefix)
+	case reflect.Slice:
+		// []byte is marshalled as a base64 string
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return "string"
+		}
+		return "Array<" + b.goTypeToTS(typ.Elem(), modelsPrefix) + ">"
+	case reflect.Array:
+		return "Array<" + b.goTypeToTS(typ.Elem(), modelsPrefix) + ">"
+	case reflect.Map:
+		// JSON object keys are always strings
+		return "{[key: string]: " + b.goTypeToTS(typ.Elem(), modelsPrefix) + "}"
+	case reflect.Struct:
+		// Anonymous structs aren't generated in the models
+		if typ.Name() == "" {
+			return "any"
+		}
+		return modelsPrefix + typ.Name()
+	case reflect.Interface:
+		if typ.Implements(errorType) {
+			return "Error"
+		}
+		return "any"
+	case reflect.Chan:
+		// Channels are streamed as progress, the call resolves without a value
+		return "void"
+	default:
+		return "any"
+	}
+}
+
+// parameterTSType returns the Typescript type of the given parameter
+func (b *Bindings) parameterTSType(parameter *Parameter, modelsPrefix string) string {
+	if parameter.reflectType == nil {
+		return goTypeToTypescriptType(parameter.TypeName)
+	}
+	return b.goTypeToTS(parameter.reflectType, modelsPrefix)
+}
+
+// returnTSType returns the Typescript type of the promise returned by the given method
+func (b *Bindings) returnTSType(method *BoundMethod, modelsPrefix string) string {
+	var types []string
+	for _, output := range method.Outputs {
+		types = append(types, b.parameterTSType(output, modelsPrefix))
+	}
+	if len(types) == 0 {
+		return "Promise<void>"
+	}
+	return "Promise<" + strings.Join(types, "|") + ">"
+}
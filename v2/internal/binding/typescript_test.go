@@ -0,0 +1,248 @@
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+type Status int
+
+const (
+	Active Status = iota
+	Inactive
+)
+
+type Address struct {
+	Street   string `json:"street"`
+	Postcode string `json:"postcode,omitempty"`
+}
+
+type Person struct {
+	Name      string              `json:"name"`
+	Age       int                 `json:"age"`
+	Address   *Address            `json:"address,omitempty"`
+	Friends   []*Person           `json:"friends"`
+	Scores    map[string]float64  `json:"scores"`
+	Addresses map[string]*Address `json:"addresses"`
+	Status    Status              `json:"status"`
+}
+
+// Version is marshalled as a string
+type Version struct {
+	Major, Minor int
+}
+
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("%d.%d", v.Major, v.Minor))
+}
+
+// Point is marshalled as an array
+type Point struct {
+	X, Y float64
+}
+
+func (p *Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]float64{p.X, p.Y})
+}
+
+// Colour defines its Typescript type
+type Colour struct {
+	R, G, B uint8
+}
+
+func (c Colour) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B))
+}
+
+func (c Colour) TypescriptType() string {
+	return "`#${string}`"
+}
+
+type Service struct{}
+
+func (s *Service) GetPerson(name string) (*Person, error)                   { return nil, nil }
+func (s *Service) SavePeople(people []Person) error                         { return nil }
+func (s *Service) PeopleByName() map[string][]*Person                       { return nil }
+func (s *Service) Matrix(values [][]int, fixed [3]float32) []byte           { return nil }
+func (s *Service) SetStatus(status Status, statuses map[string]Status) bool { return false }
+func (s *Service) Anything(value interface{}, anonymous struct{ A int }) uint8 {
+	return 0
+}
+func (s *Service) Watch() <-chan Status { return nil }
+func (s *Service) Nothing()             {}
+func (s *Service) Since(since time.Time, until *time.Time) []time.Time {
+	return nil
+}
+func (s *Service) Custom(version Version, points []Point, colour Colour, ip net.IP) map[string]Version {
+	return nil
+}
+
+func Lookup(ctx context.Context, ids []int) (map[int]*Person, error) { return nil, nil }
+
+func newTestBindings(t *testing.T) *Bindings {
+	b := NewBindings(logger.New(nil), []interface{}{&Service{}}, nil, options.PackageName)
+	err := b.AddFunctions(map[string]interface{}{
+		"Lookup": Lookup,
+		"Double": func(value float64) float64 { return value * 2 },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.AddEnums([]interface{}{
+		[]struct {
+			Value  Status
+			TSName string
+		}{
+			{Active, "ACTIVE"},
+			{Inactive, "INACTIVE"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func assertGolden(t *testing.T, goldenFile string, got []byte) {
+	goldenFile = filepath.Join("testdata", goldenFile)
+	if *update {
+		err := os.WriteFile(goldenFile, got, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("output does not match %s. Got:\n%s", goldenFile, got)
+	}
+}
+
+func TestGenerateBackendTS(t *testing.T) {
+	b := newTestBindings(t)
+	filename := filepath.Join(t.TempDir(), "bindings.d.ts")
+	err := b.GenerateBackendTS(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "bindings.d.ts.golden", got)
+}
+
+func TestGenerateBackendJS(t *testing.T) {
+	b := newTestBindings(t)
+	filename := filepath.Join(t.TempDir(), "bindings.js")
+	err := b.GenerateBackendJS(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "bindings.js.golden", got)
+}
+
+func TestGenerateEnums(t *testing.T) {
+	b := newTestBindings(t)
+	got, err := b.generateEnums()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "enums.ts.golden", got)
+}
+
+func TestAppendModels(t *testing.T) {
+	b := newTestBindings(t)
+	b.usesTime = true
+	filename := filepath.Join(t.TempDir(), "models.ts")
+	err := os.WriteFile(filename, []byte("export {};\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.appendModels(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "models.ts.golden", got)
+}
+
+func TestAddEnums_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		enums []interface{}
+	}{
+		{"not a slice", []interface{}{Active}},
+		{"empty", []interface{}{[]struct {
+			Value  Status
+			TSName string
+		}{}}},
+		{"missing TSName", []interface{}{[]struct{ Value Status }{{Active}}}},
+		{"unnamed type", []interface{}{[]struct {
+			Value  int
+			TSName string
+		}{{1, "ONE"}}}},
+		{"unsupported type", []interface{}{[]struct {
+			Value  Address
+			TSName string
+		}{{Address{}, "HOME"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bindings{}
+			if err := b.AddEnums(tt.enums); err == nil {
+				t.Error("AddEnums() should return an error")
+			}
+		})
+	}
+}
+
+type Event struct {
+	Name     string               `json:"name"`
+	At       time.Time            `json:"at"`
+	Until    *time.Time           `json:"until,omitempty"`
+	Times    []time.Time          `json:"times"`
+	ByName   map[string]time.Time `json:"byName"`
+	Version  Version              `json:"version"`
+	Location *Point               `json:"location"`
+	Address  Address              `json:"address"`
+}
+
+type Calendar struct{}
+
+func (c *Calendar) Next() *Event { return nil }
+
+func TestWriteTS_CustomFields(t *testing.T) {
+	b := NewBindings(logger.New(nil), []interface{}{&Calendar{}}, nil, options.PackageName)
+	filename := filepath.Join(t.TempDir(), "models.ts")
+	err := b.WriteTS(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "models_custom_fields.ts.golden", got)
+}
@@ -0,0 +1,27 @@
+// Package clipboard reads and writes images on the clipboard of the operating system.
+// Images are exchanged as PNG data, whatever the format used by the clipboard.
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image/png"
+)
+
+// ErrNotSupported is returned on platforms without clipboard image support
+var ErrNotSupported = errors.New("clipboard images are only supported on Windows")
+
+// GetImage returns the image on the clipboard as PNG data, or nil if the clipboard holds no image
+func GetImage() ([]byte, error) {
+	return getImage()
+}
+
+// SetImage replaces the contents of the clipboard with the image, given as PNG data
+func SetImage(data []byte) error {
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("clipboard images must be PNG data: %w", err)
+	}
+	return setImage(data, img)
+}
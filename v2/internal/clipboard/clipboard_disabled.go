@@ -0,0 +1,18 @@
+//go:build wails.noclipboard
+// +build wails.noclipboard
+
+package clipboard
+
+import (
+	"image"
+
+	"github.com/wailsapp/wails/v2/internal/features"
+)
+
+func getImage() ([]byte, error) {
+	return nil, features.Disabled(features.Clipboard)
+}
+
+func setImage(_ []byte, _ image.Image) error {
+	return features.Disabled(features.Clipboard)
+}
@@ -0,0 +1,14 @@
+//go:build !windows && !wails.noclipboard
+// +build !windows,!wails.noclipboard
+
+package clipboard
+
+import "image"
+
+func getImage() ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+func setImage(_ []byte, _ image.Image) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,182 @@
+//go:build windows && !wails.noclipboard
+// +build windows,!wails.noclipboard
+
+package clipboard
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	moduser32                      = windows.NewLazySystemDLL("user32.dll")
+	procOpenClipboard              = moduser32.NewProc("OpenClipboard")
+	procCloseClipboard             = moduser32.NewProc("CloseClipboard")
+	procEmptyClipboard             = moduser32.NewProc("EmptyClipboard")
+	procGetClipboardData           = moduser32.NewProc("GetClipboardData")
+	procSetClipboardData           = moduser32.NewProc("SetClipboardData")
+	procIsClipboardFormatAvailable = moduser32.NewProc("IsClipboardFormatAvailable")
+	procRegisterClipboardFormatW   = moduser32.NewProc("RegisterClipboardFormatW")
+
+	modkernel32       = windows.NewLazySystemDLL("kernel32.dll")
+	procGlobalAlloc   = modkernel32.NewProc("GlobalAlloc")
+	procGlobalFree    = modkernel32.NewProc("GlobalFree")
+	procGlobalLock    = modkernel32.NewProc("GlobalLock")
+	procGlobalUnlock  = modkernel32.NewProc("GlobalUnlock")
+	procGlobalSize    = modkernel32.NewProc("GlobalSize")
+	procRtlMoveMemory = modkernel32.NewProc("RtlMoveMemory")
+)
+
+const (
+	_CF_DIB        = 8
+	_GMEM_MOVEABLE = 0x0002
+)
+
+// The clipboard is opened by one application at a time, so opening it is retried for this long
+const (
+	openAttempts = 10
+	openInterval = 10 * time.Millisecond
+)
+
+func getImage() ([]byte, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	err := openClipboard()
+	if err != nil {
+		return nil, err
+	}
+	defer procCloseClipboard.Call()
+
+	// Browsers and Office also put the PNG data of images on the clipboard, which keeps their transparency
+	if format := pngFormat(); format != 0 && isFormatAvailable(format) {
+		data, err := getData(format)
+		if err == nil {
+			return data, nil
+		}
+	}
+
+	// Windows converts bitmaps put on the clipboard in other formats, such as CF_BITMAP, to CF_DIB
+	if !isFormatAvailable(_CF_DIB) {
+		return nil, nil
+	}
+	data, err := getData(_CF_DIB)
+	if err != nil {
+		return nil, err
+	}
+	img, err := decodeDIB(data)
+	if err != nil {
+		return nil, err
+	}
+	var result bytes.Buffer
+	err = png.Encode(&result, img)
+	if err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+func setImage(data []byte, img image.Image) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	err := openClipboard()
+	if err != nil {
+		return err
+	}
+	defer procCloseClipboard.Call()
+
+	r, _, err := procEmptyClipboard.Call()
+	if r == 0 {
+		return fmt.Errorf("unable to empty the clipboard: %w", err)
+	}
+	err = setData(_CF_DIB, encodeDIB(img))
+	if err != nil {
+		return err
+	}
+	// The PNG data is only added for the applications that support it, as every application supports CF_DIB
+	if format := pngFormat(); format != 0 {
+		_ = setData(format, data)
+	}
+	return nil
+}
+
+// openClipboard opens the clipboard, retrying while another application has it open
+func openClipboard() error {
+	var err error
+	for attempt := 0; attempt < openAttempts; attempt++ {
+		var r uintptr
+		r, _, err = procOpenClipboard.Call(0)
+		if r != 0 {
+			return nil
+		}
+		time.Sleep(openInterval)
+	}
+	return fmt.Errorf("unable to open the clipboard: %w", err)
+}
+
+// pngFormat returns the clipboard format of PNG data, or 0 if it can't be registered
+func pngFormat() uintptr {
+	name, err := windows.UTF16PtrFromString("PNG")
+	if err != nil {
+		return 0
+	}
+	format, _, _ := procRegisterClipboardFormatW.Call(uintptr(unsafe.Pointer(name)))
+	return format
+}
+
+func isFormatAvailable(format uintptr) bool {
+	r, _, _ := procIsClipboardFormatAvailable.Call(format)
+	return r != 0
+}
+
+// getData returns a copy of the data on the open clipboard in the given format
+func getData(format uintptr) ([]byte, error) {
+	handle, _, err := procGetClipboardData.Call(format)
+	if handle == 0 {
+		return nil, fmt.Errorf("unable to get the clipboard data: %w", err)
+	}
+	size, _, _ := procGlobalSize.Call(handle)
+	if size == 0 {
+		return nil, nil
+	}
+	memory, _, err := procGlobalLock.Call(handle)
+	if memory == 0 {
+		return nil, fmt.Errorf("unable to get the clipboard data: %w", err)
+	}
+	defer procGlobalUnlock.Call(handle)
+
+	result := make([]byte, size)
+	procRtlMoveMemory.Call(uintptr(unsafe.Pointer(&result[0])), memory, size)
+	return result, nil
+}
+
+// setData puts the data on the open clipboard in the given format
+func setData(format uintptr, data []byte) error {
+	handle, _, err := procGlobalAlloc.Call(_GMEM_MOVEABLE, uintptr(len(data)))
+	if handle == 0 {
+		return fmt.Errorf("unable to allocate the clipboard data: %w", err)
+	}
+	memory, _, err := procGlobalLock.Call(handle)
+	if memory == 0 {
+		procGlobalFree.Call(handle)
+		return fmt.Errorf("unable to allocate the clipboard data: %w", err)
+	}
+	procRtlMoveMemory.Call(memory, uintptr(unsafe.Pointer(&data[0])), uintptr(len(data)))
+	procGlobalUnlock.Call(handle)
+
+	// The clipboard owns the memory once the data is set
+	r, _, err := procSetClipboardData.Call(format, handle)
+	if r == 0 {
+		procGlobalFree.Call(handle)
+		return fmt.Errorf("unable to set the clipboard data: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package clipboard
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math/bits"
+)
+
+const (
+	bitmapInfoHeaderSize = 40
+	// The compressions of a bitmap
+	biRGB       = 0
+	biBitfields = 3
+)
+
+var errInvalidDIB = errors.New("invalid bitmap")
+
+// decodeDIB returns the image of a device-independent bitmap, as used by the CF_DIB clipboard format:
+// a BITMAPINFOHEADER, or one of its later versions, followed by the pixels.
+// Only uncompressed 24 and 32 bit bitmaps are supported.
+func decodeDIB(data []byte) (image.Image, error) {
+	le := binary.LittleEndian
+	if len(data) < bitmapInfoHeaderSize {
+		return nil, errInvalidDIB
+	}
+	headerSize := int(le.Uint32(data[0:]))
+	width := int(int32(le.Uint32(data[4:])))
+	height := int(int32(le.Uint32(data[8:])))
+	bitCount := int(le.Uint16(data[14:]))
+	compression := le.Uint32(data[16:])
+	colorsUsed := int(le.Uint32(data[32:]))
+	if headerSize < bitmapInfoHeaderSize || headerSize > len(data) || width <= 0 || height == 0 {
+		return nil, errInvalidDIB
+	}
+	if bitCount != 24 && bitCount != 32 {
+		return nil, fmt.Errorf("unsupported bitmap with %d bits per pixel", bitCount)
+	}
+
+	// The red, green, blue and alpha masks of the pixels
+	masks := [4]uint32{0x00FF0000, 0x0000FF00, 0x000000FF, 0xFF000000}
+	offset := headerSize
+	switch compression {
+	case biRGB:
+	case biBitfields:
+		if bitCount != 32 {
+			return nil, errInvalidDIB
+		}
+		// The masks follow a BITMAPINFOHEADER, and are part of the later versions of the header
+		masksOffset := bitmapInfoHeaderSize
+		if headerSize == bitmapInfoHeaderSize {
+			offset += 12
+		}
+		if len(data) < masksOffset+12 {
+			return nil, errInvalidDIB
+		}
+		masks[0] = le.Uint32(data[masksOffset:])
+		masks[1] = le.Uint32(data[masksOffset+4:])
+		masks[2] = le.Uint32(data[masksOffset+8:])
+		masks[3] = 0
+		if headerSize >= bitmapInfoHeaderSize+16 {
+			masks[3] = le.Uint32(data[masksOffset+12:])
+		}
+	default:
+		return nil, fmt.Errorf("unsupported bitmap compression %d", compression)
+	}
+	offset += colorsUsed * 4
+
+	// Bitmaps are stored from the bottom up, unless the height is negative
+	topDown := height < 0
+	if topDown {
+		height = -height
+	}
+	stride := (width*bitCount + 31) / 32 * 4
+	if int64(offset)+int64(stride)*int64(height) > int64(len(data)) {
+		return nil, errInvalidDIB
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	hasAlpha := false
+	for y := 0; y < height; y++ {
+		row := height - 1 - y
+		if topDown {
+			row = y
+		}
+		src := data[offset+row*stride:]
+		for x := 0; x < width; x++ {
+			var pixel uint32
+			if bitCount == 24 {
+				pixel = uint32(src[x*3]) | uint32(src[x*3+1])<<8 | uint32(src[x*3+2])<<16
+			} else {
+				pixel = le.Uint32(src[x*4:])
+			}
+			dst := img.Pix[img.PixOffset(x, y):]
+			dst[0] = channel(pixel, masks[0])
+			dst[1] = channel(pixel, masks[1])
+			dst[2] = channel(pixel, masks[2])
+			dst[3] = channel(pixel, masks[3])
+			hasAlpha = hasAlpha || dst[3] != 0
+		}
+	}
+	// Bitmaps without an alpha channel leave it zero, which means opaque rather than transparent
+	if !hasAlpha {
+		for index := 3; index < len(img.Pix); index += 4 {
+			img.Pix[index] = 0xFF
+		}
+	}
+	return img, nil
+}
+
+// channel returns the 8 bit value of the channel of the pixel with the given mask
+func channel(pixel uint32, mask uint32) uint8 {
+	if mask == 0 {
+		return 0
+	}
+	shift := bits.TrailingZeros32(mask)
+	maximum := uint64(mask >> shift)
+	value := uint64((pixel & mask) >> shift)
+	return uint8(value * 255 / maximum)
+}
+
+// encodeDIB returns the image as a 32 bit device-independent bitmap, for the CF_DIB clipboard format
+func encodeDIB(img image.Image) []byte {
+	le := binary.LittleEndian
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	data := make([]byte, bitmapInfoHeaderSize+width*height*4)
+	le.PutUint32(data[0:], bitmapInfoHeaderSize)
+	le.PutUint32(data[4:], uint32(width))
+	le.PutUint32(data[8:], uint32(height))
+	le.PutUint16(data[12:], 1)  // planes
+	le.PutUint16(data[14:], 32) // bits per pixel
+	le.PutUint32(data[16:], biRGB)
+	le.PutUint32(data[20:], uint32(width*height*4))
+
+	pixels := data[bitmapInfoHeaderSize:]
+	for y := 0; y < height; y++ {
+		// The rows are stored from the bottom up
+		row := pixels[(height-1-y)*width*4:]
+		for x := 0; x < width; x++ {
+			c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+			row[x*4] = c.B
+			row[x*4+1] = c.G
+			row[x*4+2] = c.R
+			row[x*4+3] = c.A
+		}
+	}
+	return data
+}
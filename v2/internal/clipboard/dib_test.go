@@ -0,0 +1,62 @@
+package clipboard
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestEncodeDIB_roundTrip(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	img.Set(2, 1, color.NRGBA{B: 255, A: 128})
+
+	decoded, err := decodeDIB(encodeDIB(img))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Bounds() != img.Bounds() {
+		t.Fatalf("bounds = %v, want %v", decoded.Bounds(), img.Bounds())
+	}
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			if got, want := decoded.At(x, y), img.At(x, y); got != want {
+				t.Errorf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestDecodeDIB_24BitTopDown(t *testing.T) {
+	// A 1x2 bitmap with rows padded to 4 bytes: a blue pixel above a green one
+	data := make([]byte, bitmapInfoHeaderSize+8)
+	binary.LittleEndian.PutUint32(data[0:], bitmapInfoHeaderSize)
+	binary.LittleEndian.PutUint32(data[4:], 1)
+	binary.LittleEndian.PutUint32(data[8:], uint32(0xFFFFFFFE)) // -2
+	binary.LittleEndian.PutUint16(data[12:], 1)
+	binary.LittleEndian.PutUint16(data[14:], 24)
+	copy(data[bitmapInfoHeaderSize:], []byte{255, 0, 0, 0, 0, 255, 0, 0})
+
+	img, err := decodeDIB(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := img.At(0, 0), (color.NRGBA{B: 255, A: 255}); got != want {
+		t.Errorf("top pixel = %v, want %v", got, want)
+	}
+	if got, want := img.At(0, 1), (color.NRGBA{G: 255, A: 255}); got != want {
+		t.Errorf("bottom pixel = %v, want %v", got, want)
+	}
+}
+
+func TestDecodeDIB_invalid(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	data := encodeDIB(img)
+	if _, err := decodeDIB(data[:len(data)-1]); err == nil {
+		t.Error("expected an error for truncated pixels")
+	}
+	if _, err := decodeDIB(data[:10]); err == nil {
+		t.Error("expected an error for a truncated header")
+	}
+}
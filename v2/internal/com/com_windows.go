@@ -0,0 +1,110 @@
+//go:build windows
+// +build windows
+
+package com
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modole32             = windows.NewLazySystemDLL("ole32.dll")
+	procCoCreateInstance = modole32.NewProc("CoCreateInstance")
+)
+
+// The methods of IUnknown, which all COM interfaces start with
+const (
+	MethodQueryInterface = 0
+	MethodAddRef         = 1
+	MethodRelease        = 2
+)
+
+// Object is a pointer to a COM interface. Methods are called by their index in the vtable of the interface.
+type Object struct {
+	vtbl unsafe.Pointer
+}
+
+// Initialize initialises COM on the current OS thread, which must be locked to the calling goroutine.
+// The returned function uninitialises it again.
+func Initialize() (func(), error) {
+	err := windows.CoInitializeEx(0, windows.COINIT_APARTMENTTHREADED)
+	switch err {
+	case nil, syscall.Errno(windows.S_FALSE):
+		// S_FALSE is returned if COM was already initialised on this thread, which still needs uninitialising
+		return windows.CoUninitialize, nil
+	case syscall.Errno(windows.RPC_E_CHANGED_MODE):
+		// COM is already initialised as multithreaded on this thread, which is fine
+		return func() {}, nil
+	default:
+		return nil, err
+	}
+}
+
+// CreateInstance creates the COM class with the given CLSID and returns its interface with the given IID
+func CreateInstance(clsid string, iid string) (*Object, error) {
+	classID, err := windows.GUIDFromString(clsid)
+	if err != nil {
+		return nil, err
+	}
+	interfaceID, err := windows.GUIDFromString(iid)
+	if err != nil {
+		return nil, err
+	}
+	var result *Object
+	hr, _, _ := procCoCreateInstance.Call(
+		uintptr(unsafe.Pointer(&classID)),
+		0,
+		windows.CLSCTX_INPROC_SERVER,
+		uintptr(unsafe.Pointer(&interfaceID)),
+		uintptr(unsafe.Pointer(&result)))
+	if err := hresultError(hr); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// QueryInterface returns the interface of the object with the given IID
+func (o *Object) QueryInterface(iid string) (*Object, error) {
+	interfaceID, err := windows.GUIDFromString(iid)
+	if err != nil {
+		return nil, err
+	}
+	var result *Object
+	err = o.Call(MethodQueryInterface, uintptr(unsafe.Pointer(&interfaceID)), uintptr(unsafe.Pointer(&result)))
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddRef adds a reference to the object, which must be released with Release
+func (o *Object) AddRef() {
+	_ = o.Call(MethodAddRef)
+}
+
+// Release releases the reference to the object
+func (o *Object) Release() {
+	_ = o.Call(MethodRelease)
+}
+
+// Call calls the method of the interface with the given vtable index. An error is returned if
+// the method returns a failed HRESULT.
+func (o *Object) Call(method int, args ...uintptr) error {
+	fn := *(*uintptr)(unsafe.Add(o.vtbl, method*int(unsafe.Sizeof(uintptr(0)))))
+	var a [9]uintptr
+	a[0] = uintptr(unsafe.Pointer(o))
+	copy(a[1:], args)
+	hr, _, _ := syscall.Syscall9(fn, uintptr(len(args)+1), a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8])
+	return hresultError(hr)
+}
+
+// hresultError returns an error for a failed HRESULT
+func hresultError(hr uintptr) error {
+	if int32(hr) >= 0 {
+		return nil
+	}
+	return syscall.Errno(uint32(hr))
+}
@@ -0,0 +1,3 @@
+// Package com provides the minimal support needed to call Windows COM interfaces
+// that aren't wrapped by other packages
+package com
@@ -0,0 +1,95 @@
+//go:build windows
+// +build windows
+
+package com
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// EventHandler is a COM object implementing an event handler interface with a single
+// `Invoke(sender, args)` method, such as the event handlers of WebView2.
+// The handler is passed to native code, so it must be referenced for as long as it is registered.
+type EventHandler struct {
+	vtbl   *eventHandlerVtbl
+	invoke func(sender *Object, args *Object) error
+}
+
+type eventHandlerVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	Invoke         uintptr
+}
+
+// The callbacks are shared by all handlers, as only a limited number of callbacks can be created
+var eventHandlerFns = eventHandlerVtbl{
+	QueryInterface: windows.NewCallback(func(this *EventHandler, _ uintptr, object *uintptr) uintptr {
+		*object = uintptr(unsafe.Pointer(this))
+		return uintptr(windows.S_OK)
+	}),
+	// The lifetime of the handler is managed by Go
+	AddRef: windows.NewCallback(func(this *EventHandler) uintptr {
+		return 1
+	}),
+	Release: windows.NewCallback(func(this *EventHandler) uintptr {
+		return 1
+	}),
+	Invoke: windows.NewCallback(func(this *EventHandler, sender *Object, args *Object) uintptr {
+		err := this.invoke(sender, args)
+		if errno, ok := err.(syscall.Errno); ok {
+			return uintptr(errno)
+		}
+		if err != nil {
+			return uintptr(windows.E_FAIL)
+		}
+		return uintptr(windows.S_OK)
+	}),
+}
+
+// NewEventHandler returns an event handler calling the given function when it is invoked
+func NewEventHandler(invoke func(sender *Object, args *Object) error) *EventHandler {
+	return &EventHandler{
+		vtbl:   &eventHandlerFns,
+		invoke: invoke,
+	}
+}
+
+// AddEventHandler registers the handler with the `add_` method of the interface with the
+// given vtable index. The returned token unregisters it with the `remove_` method.
+func (o *Object) AddEventHandler(method int, handler *EventHandler) (int64, error) {
+	var token int64
+	err := o.Call(method, uintptr(unsafe.Pointer(handler)), uintptr(unsafe.Pointer(&token)))
+	return token, err
+}
+
+// RemoveEventHandler unregisters an event handler with the `remove_` method of the interface with
+// the given vtable index
+func (o *Object) RemoveEventHandler(method int, token int64) error {
+	// The token is passed by value, which takes two arguments on 32-bit platforms
+	if unsafe.Sizeof(uintptr(0)) == 4 {
+		return o.Call(method, uintptr(uint32(token)), uintptr(uint64(token)>>32))
+	}
+	return o.Call(method, uintptr(token))
+}
+
+// GetString calls a method of the interface returning a string allocated by COM
+func (o *Object) GetString(method int) (string, error) {
+	var result *uint16
+	err := o.Call(method, uintptr(unsafe.Pointer(&result)))
+	if err != nil {
+		return "", err
+	}
+	defer windows.CoTaskMemFree(unsafe.Pointer(result))
+	return windows.UTF16PtrToString(result), nil
+}
+
+// GetBool calls a method of the interface returning a BOOL
+func (o *Object) GetBool(method int) (bool, error) {
+	var result int32
+	err := o.Call(method, uintptr(unsafe.Pointer(&result)))
+	return result != 0, err
+}
@@ -0,0 +1,125 @@
+package crashreport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// Reporter writes crash reports for panics, then calls the OnPanic handler of the application
+type Reporter struct {
+	logger    *logger.Logger
+	onPanic   func(details *options.PanicDetails)
+	title     string
+	buildInfo string
+
+	// Only the first panic is reported if several goroutines panic at once
+	once sync.Once
+}
+
+// New creates a Reporter for the given application. The build information is added to the crash reports.
+func New(appoptions *options.App, logger *logger.Logger, buildInfo string) *Reporter {
+	return &Reporter{
+		logger:    logger,
+		onPanic:   appoptions.OnPanic,
+		title:     appoptions.Title,
+		buildInfo: buildInfo,
+	}
+}
+
+// Recover reports a panic, then exits the application. It must be deferred.
+func (r *Reporter) Recover() {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+	r.once.Do(func() {
+		r.report(recovered, string(debug.Stack()))
+	})
+	os.Exit(1)
+}
+
+func (r *Reporter) report(recovered interface{}, stackTrace string) {
+	err, ok := recovered.(error)
+	if !ok {
+		err = fmt.Errorf("%v", recovered)
+	}
+	details := &options.PanicDetails{
+		Time:       time.Now(),
+		Error:      err,
+		StackTrace: stackTrace,
+	}
+
+	report := r.formatReport(details)
+	crashLog, writeErr := writeCrashLog(details.Time, report)
+	if writeErr != nil {
+		r.logger.Error("Unable to write crash log: %s", writeErr.Error())
+	} else {
+		details.CrashLog = crashLog
+	}
+	// Always print the report, as the crash log may not be found
+	_, _ = fmt.Fprint(os.Stderr, report)
+
+	if r.onPanic != nil {
+		r.onPanic(details)
+		return
+	}
+
+	message := fmt.Sprintf("The application has crashed: %s", err.Error())
+	if details.CrashLog != "" {
+		message += fmt.Sprintf("\n\nA crash report has been written to:\n%s", details.CrashLog)
+	}
+	title := r.title
+	if title == "" {
+		title = "Error"
+	}
+	showErrorDialog(title, message)
+}
+
+// formatReport returns the text of the crash report
+func (r *Reporter) formatReport(details *options.PanicDetails) string {
+	var result strings.Builder
+	result.WriteString(fmt.Sprintf("Crashed at %s\n\n", details.Time.Format(time.RFC3339)))
+	if r.buildInfo != "" {
+		result.WriteString(r.buildInfo + "\n")
+	}
+	result.WriteString(fmt.Sprintf("panic: %s\n\n", details.Error.Error()))
+	result.WriteString(details.StackTrace)
+	return result.String()
+}
+
+// writeCrashLog writes the crash report to the crash log directory of the application.
+// Returns the name of the file.
+func writeCrashLog(crashTime time.Time, report string) (string, error) {
+	dir, err := crashLogDirectory()
+	if err != nil {
+		return "", err
+	}
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", err
+	}
+	filename := filepath.Join(dir, "crash-"+crashTime.Format("20060102-150405")+".log")
+	return filename, os.WriteFile(filename, []byte(report), 0644)
+}
+
+// crashLogDirectory returns the directory crash logs are written to, EG: ~/.cache/myapp/crashes
+func crashLogDirectory() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	name := strings.TrimSuffix(filepath.Base(executable), ".exe")
+	return filepath.Join(cacheDir, name, "crashes"), nil
+}
@@ -0,0 +1,8 @@
+//go:build !windows
+// +build !windows
+
+package crashreport
+
+// showErrorDialog isn't supported as dialogs need the main loop, which may have crashed.
+// The crash report has already been printed.
+func showErrorDialog(_ string, _ string) {}
@@ -0,0 +1,11 @@
+//go:build windows
+// +build windows
+
+package crashreport
+
+import "github.com/wailsapp/wails/v2/internal/webview2runtime"
+
+// showErrorDialog shows a native error dialog. It doesn't need the main loop to be running.
+func showErrorDialog(title string, message string) {
+	_ = webview2runtime.Error(message, title)
+}
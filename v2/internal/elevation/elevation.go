@@ -0,0 +1,34 @@
+// Package elevation relaunches the application with administrator rights
+package elevation
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+var (
+	// ErrCancelled is returned when the user cancels the elevation prompt
+	ErrCancelled = errors.New("the elevation prompt was cancelled")
+	// ErrAlreadyElevated is returned when the application already has administrator rights
+	ErrAlreadyElevated = errors.New("the application is already running elevated")
+	// ErrNotSupported is returned on platforms where the application can't be relaunched elevated
+	ErrNotSupported = errors.New("relaunching elevated is only supported on Windows")
+)
+
+// Relaunch starts a new instance of the application with administrator rights and the same arguments,
+// once the user has accepted the elevation prompt. The running instance should quit afterwards.
+func Relaunch() error {
+	if IsElevated() {
+		return ErrAlreadyElevated
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	executable, err = filepath.EvalSymlinks(executable)
+	if err != nil {
+		return err
+	}
+	return relaunch(executable, os.Args[1:])
+}
@@ -0,0 +1,15 @@
+//go:build !windows
+// +build !windows
+
+package elevation
+
+import "os"
+
+// IsElevated returns true if the application is running as root
+func IsElevated() bool {
+	return os.Geteuid() == 0
+}
+
+func relaunch(_ string, _ []string) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,51 @@
+//go:build windows
+// +build windows
+
+package elevation
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+// IsElevated returns true if the application is running with administrator rights
+func IsElevated() bool {
+	return windows.GetCurrentProcessToken().IsElevated()
+}
+
+func relaunch(executable string, args []string) error {
+	quoted := make([]string, len(args))
+	for index, arg := range args {
+		quoted[index] = windows.EscapeArg(arg)
+	}
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	verb, err := windows.UTF16PtrFromString("runas")
+	if err != nil {
+		return err
+	}
+	file, err := windows.UTF16PtrFromString(executable)
+	if err != nil {
+		return err
+	}
+	parameters, err := windows.UTF16PtrFromString(strings.Join(quoted, " "))
+	if err != nil {
+		return err
+	}
+	directory, err := windows.UTF16PtrFromString(workingDirectory)
+	if err != nil {
+		return err
+	}
+
+	err = windows.ShellExecute(0, verb, file, parameters, directory, windows.SW_SHOWNORMAL)
+	if errors.Is(err, windows.ERROR_CANCELLED) {
+		return ErrCancelled
+	}
+	return err
+}
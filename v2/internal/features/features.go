@@ -0,0 +1,71 @@
+// Package features lists the runtime features that can be compiled out of an application, to reduce its size.
+// The features are listed in `runtime:features` in wails.json, and `wails build` compiles out the others
+// with build tags.
+package features
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The runtime features that can be compiled out
+const (
+	Clipboard     = "clipboard"
+	Dialogs       = "dialogs"
+	JumpList      = "jumplist"
+	SecureStorage = "securestorage"
+)
+
+// All is the runtime features that can be compiled out
+var All = []string{Clipboard, Dialogs, JumpList, SecureStorage}
+
+// ErrDisabled is wrapped by the errors returned by the methods of features that were compiled out
+var ErrDisabled = errors.New("runtime feature not included in the application")
+
+// The features compiled out of the application, set by the files built with their tags
+var disabled = map[string]bool{}
+
+// Enabled returns true if the feature is included in the application
+func Enabled(feature string) bool {
+	return !disabled[feature]
+}
+
+// Disabled returns the error returned by the methods of a feature that was compiled out
+func Disabled(feature string) error {
+	return fmt.Errorf("%w: '%s' isn't listed in runtime:features in wails.json", ErrDisabled, feature)
+}
+
+// BuildTag returns the build tag that compiles the feature out of the application
+func BuildTag(feature string) string {
+	return "wails.no" + feature
+}
+
+// BuildTags returns the build tags that compile out the features that aren't enabled
+func BuildTags(enabled []string) ([]string, error) {
+	included := map[string]bool{}
+	for _, feature := range enabled {
+		if !isFeature(feature) {
+			return nil, fmt.Errorf("unknown runtime feature '%s' in runtime:features. The features are: %s", feature, strings.Join(All, ", "))
+		}
+		included[feature] = true
+	}
+	var tags []string
+	for _, feature := range All {
+		if !included[feature] {
+			tags = append(tags, BuildTag(feature))
+		}
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+func isFeature(name string) bool {
+	for _, feature := range All {
+		if feature == name {
+			return true
+		}
+	}
+	return false
+}
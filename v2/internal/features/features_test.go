@@ -0,0 +1,36 @@
+package features
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBuildTags(t *testing.T) {
+	tags, err := BuildTags([]string{Dialogs, Clipboard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"wails.nojumplist", "wails.nosecurestorage"}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("expected %v, got %v", want, tags)
+	}
+
+	tags, err = BuildTags(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != len(All) {
+		t.Errorf("expected every feature to be compiled out, got %v", tags)
+	}
+
+	if _, err := BuildTags([]string{"tray"}); err == nil {
+		t.Error("expected an error for an unknown feature")
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	if !errors.Is(Disabled(Clipboard), ErrDisabled) {
+		t.Error("expected the error to wrap ErrDisabled")
+	}
+}
@@ -0,0 +1,8 @@
+//go:build wails.noclipboard
+// +build wails.noclipboard
+
+package features
+
+func init() {
+	disabled[Clipboard] = true
+}
@@ -0,0 +1,8 @@
+//go:build wails.nodialogs
+// +build wails.nodialogs
+
+package features
+
+func init() {
+	disabled[Dialogs] = true
+}
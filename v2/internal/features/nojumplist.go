@@ -0,0 +1,8 @@
+//go:build wails.nojumplist
+// +build wails.nojumplist
+
+package features
+
+func init() {
+	disabled[JumpList] = true
+}
@@ -0,0 +1,8 @@
+//go:build wails.nosecurestorage
+// +build wails.nosecurestorage
+
+package features
+
+func init() {
+	disabled[SecureStorage] = true
+}
@@ -0,0 +1,121 @@
+//go:build wv2runtime.portable
+// +build wv2runtime.portable
+
+package wv2runtime
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/webview2runtime"
+	"github.com/wailsapp/wails/v2/pkg/portable"
+)
+
+// browserExecutableFolderEnv makes WebView2 use the runtime in the given folder instead of the installed one
+const browserExecutableFolderEnv = "WEBVIEW2_BROWSER_EXECUTABLE_FOLDER"
+
+// completedMarker is written once the runtime has been fully extracted
+const completedMarker = ".wails-extracted"
+
+func init() {
+	prepareRuntime = extractPortableRuntime
+}
+
+func doInstallationStrategy(installStatus installationStatus) error {
+	_ = webview2runtime.Error("The WebView2 runtime bundled with this application could not be loaded. Please contact the application vendor.", "Error")
+	return fmt.Errorf("bundled webview2 runtime could not be loaded")
+}
+
+// extractPortableRuntime extracts the embedded WebView2 runtime to the temp directory, if it
+// hasn't been already, and makes WebView2 use it
+func extractPortableRuntime() error {
+	id, archive := portable.WebView2Runtime()
+	if len(archive) == 0 {
+		return fmt.Errorf("no webview2 runtime was embedded in the application")
+	}
+
+	runtimeDir := filepath.Join(os.TempDir(), "wails-webview2-"+id)
+	if !isExtracted(runtimeDir) {
+		// Extract to a new directory so other instances of the application never see a partial runtime
+		tempDir, err := os.MkdirTemp(os.TempDir(), "wails-webview2-")
+		if err != nil {
+			return err
+		}
+		err = extractZip(archive, tempDir)
+		if err != nil {
+			_ = os.RemoveAll(tempDir)
+			return fmt.Errorf("unable to extract the webview2 runtime: %s", err.Error())
+		}
+		_ = os.RemoveAll(runtimeDir)
+		err = os.Rename(tempDir, runtimeDir)
+		if err != nil {
+			_ = os.RemoveAll(tempDir)
+			// Another instance may have extracted it at the same time
+			if !isExtracted(runtimeDir) {
+				return err
+			}
+		}
+	}
+
+	return os.Setenv(browserExecutableFolderEnv, runtimeDir)
+}
+
+func isExtracted(runtimeDir string) bool {
+	for _, filename := range []string{completedMarker, "msedgewebview2.exe"} {
+		if _, err := os.Stat(filepath.Join(runtimeDir, filename)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func extractZip(archive []byte, targetDir string) error {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return err
+	}
+	for _, file := range reader.File {
+		target := filepath.Join(targetDir, filepath.FromSlash(file.Name))
+		if !strings.HasPrefix(target, filepath.Clean(targetDir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid file in archive: %s", file.Name)
+		}
+		if file.FileInfo().IsDir() {
+			err = os.MkdirAll(target, 0755)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		err = os.MkdirAll(filepath.Dir(target), 0755)
+		if err != nil {
+			return err
+		}
+		err = extractFile(file, target)
+		if err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(targetDir, completedMarker), nil, 0644)
+}
+
+func extractFile(file *zip.File, target string) error {
+	source, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	output, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(output, source)
+	if closeErr := output.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
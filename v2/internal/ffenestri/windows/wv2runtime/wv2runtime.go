@@ -14,7 +14,15 @@ const (
 	installed
 )
 
+// prepareRuntime is called before the installed runtime is checked, EG: to use a runtime bundled with the application
+var prepareRuntime func() error
+
 func Process() (string, error) {
+	if prepareRuntime != nil {
+		if err := prepareRuntime(); err != nil {
+			return "", err
+		}
+	}
 	installStatus := needsInstalling
 	installedVersion, err := webviewloader.GetInstalledVersion()
 	if err != nil {
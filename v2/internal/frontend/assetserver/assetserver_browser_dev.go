@@ -11,6 +11,7 @@ import (
 
 	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
 	"golang.org/x/net/html"
 )
 
@@ -25,9 +26,10 @@ type BrowserAssetServer struct {
 	assets    fs.FS
 	runtimeJS []byte
 	logger    *logger.Logger
+	basePath  string
 }
 
-func NewBrowserAssetServer(ctx context.Context, assets fs.FS, bindingsJSON string) (*BrowserAssetServer, error) {
+func NewBrowserAssetServer(ctx context.Context, appoptions *options.App, bindingsJSON string) (*BrowserAssetServer, error) {
 	result := &BrowserAssetServer{}
 	_logger := ctx.Value("logger")
 	if _logger != nil {
@@ -35,7 +37,12 @@ func NewBrowserAssetServer(ctx context.Context, assets fs.FS, bindingsJSON strin
 	}
 
 	var err error
-	result.assets, err = prepareAssetsForServing(assets)
+	result.basePath, err = validateBasePath(appoptions.AssetsBasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	result.assets, err = prepareAssetsForServing(appoptions.Assets)
 	if err != nil {
 		return nil, err
 	}
@@ -54,8 +61,9 @@ func (d *BrowserAssetServer) LogDebug(message string, args ...interface{}) {
 	}
 }
 
-func (a *BrowserAssetServer) processIndexHTML() ([]byte, error) {
-	indexHTML, err := fs.ReadFile(a.assets, "index.html")
+// processHTML injects the runtime into the given HTML page of the assets
+func (a *BrowserAssetServer) processHTML(filename string) ([]byte, error) {
+	indexHTML, err := fs.ReadFile(a.assets, filename)
 	if err != nil {
 		return nil, err
 	}
@@ -73,14 +81,14 @@ func (a *BrowserAssetServer) processIndexHTML() ([]byte, error) {
 	}
 
 	if wailsOptions.disableIPCInjection == false {
-		err := insertScriptInHead(htmlNode, "/wails/ipc.js")
+		err := insertScriptInHead(htmlNode, a.basePath+"wails/ipc.js")
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if wailsOptions.disableRuntimeInjection == false {
-		err := insertScriptInHead(htmlNode, "/wails/runtime.js")
+		err := insertScriptInHead(htmlNode, a.basePath+"wails/runtime.js")
 		if err != nil {
 			return nil, err
 		}
@@ -97,13 +105,16 @@ func (a *BrowserAssetServer) processIndexHTML() ([]byte, error) {
 func (a *BrowserAssetServer) Load(filename string) ([]byte, string, error) {
 	var content []byte
 	var err error
-	switch filename {
-	case "/":
-		content, err = a.processIndexHTML()
-	case "/wails/runtime.js":
+	filename = stripBasePath(a.basePath, filename)
+	switch {
+	case filename == "/wails/runtime.js":
 		content = a.runtimeJS
-	case "/wails/ipc.js":
+	case filename == "/wails/ipc.js":
 		content = runtime.WebsocketIPC
+	case isHTMLPage(filename):
+		filename = pageFilename(filename)
+		a.LogDebug("Loading page: %s", filename)
+		content, err = a.processHTML(filename)
 	default:
 		filename = strings.TrimPrefix(filename, "/")
 		a.LogDebug("Loading file: %s", filename)
@@ -1,8 +1,10 @@
 package assetserver
 
 import (
+	"fmt"
 	iofs "io/fs"
 	"path"
+	"strings"
 
 	"github.com/wailsapp/wails/v2/internal/fs"
 )
@@ -23,3 +25,29 @@ func prepareAssetsForServing(assets iofs.FS) (iofs.FS, error) {
 	}
 	return assets, nil
 }
+
+// validateBasePath checks the path the assets are served from. An empty path means the root.
+func validateBasePath(basePath string) (string, error) {
+	if basePath == "" {
+		return "/", nil
+	}
+	if !strings.HasPrefix(basePath, "/") || !strings.HasSuffix(basePath, "/") {
+		return "", fmt.Errorf("invalid AssetsBasePath '%s': it must start and end with '/'", basePath)
+	}
+	return basePath, nil
+}
+
+// stripBasePath translates a requested path into a path relative to the root of the assets.
+// Requests outside of the base path are returned unchanged.
+func stripBasePath(basePath string, filename string) string {
+	if basePath == "/" {
+		return filename
+	}
+	if filename+"/" == basePath {
+		return "/"
+	}
+	if strings.HasPrefix(filename, basePath) {
+		return "/" + strings.TrimPrefix(filename, basePath)
+	}
+	return filename
+}
@@ -0,0 +1,54 @@
+package assetserver
+
+import "testing"
+
+func Test_validateBasePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		basePath string
+		want     string
+		wantErr  bool
+	}{
+		{"empty", "", "/", false},
+		{"root", "/", "/", false},
+		{"subpath", "/app/", "/app/", false},
+		{"nested subpath", "/my/app/", "/my/app/", false},
+		{"no leading slash", "app/", "", true},
+		{"no trailing slash", "/app", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateBasePath(tt.basePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateBasePath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("validateBasePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_stripBasePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		basePath string
+		filename string
+		want     string
+	}{
+		{"root", "/", "/main.js", "/main.js"},
+		{"index", "/app/", "/app/", "/"},
+		{"index without slash", "/app/", "/app", "/"},
+		{"asset", "/app/", "/app/main.js", "/main.js"},
+		{"runtime", "/app/", "/app/wails/runtime.js", "/wails/runtime.js"},
+		{"outside base path", "/app/", "/main.js", "/main.js"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripBasePath(tt.basePath, tt.filename); got != tt.want {
+				t.Errorf("stripBasePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,19 +5,27 @@ import (
 	"context"
 	"io/fs"
 	"log"
+	"net/http"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
 type DesktopAssetServer struct {
 	assets    fs.FS
 	runtimeJS []byte
 	logger    *logger.Logger
+	basePath  string
+
+	disableCaching bool
+	buildTime      time.Time
 }
 
-func NewDesktopAssetServer(ctx context.Context, assets fs.FS, bindingsJSON string) (*DesktopAssetServer, error) {
+func NewDesktopAssetServer(ctx context.Context, appoptions *options.App, bindingsJSON string) (*DesktopAssetServer, error) {
 	result := &DesktopAssetServer{}
 
 	_logger := ctx.Value("logger")
@@ -26,11 +34,22 @@ func NewDesktopAssetServer(ctx context.Context, assets fs.FS, bindingsJSON strin
 	}
 
 	var err error
-	result.assets, err = prepareAssetsForServing(assets)
+	result.basePath, err = validateBasePath(appoptions.AssetsBasePath)
 	if err != nil {
 		return nil, err
 	}
 
+	result.assets, err = prepareAssetsForServing(appoptions.Assets)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assets served from disk in dev mode change all the time, so don't cache them
+	result.disableCaching = appoptions.DisableAssetCaching || ctx.Value("assetdir") != nil
+	if !result.disableCaching {
+		result.buildTime = executableModTime()
+	}
+
 	var buffer bytes.Buffer
 	buffer.WriteString(`window.wailsbindings='` + bindingsJSON + `';` + "\n")
 	buffer.Write(runtime.RuntimeDesktopJS)
@@ -45,8 +64,14 @@ func (d *DesktopAssetServer) LogDebug(message string, args ...interface{}) {
 	}
 }
 
-func (a *DesktopAssetServer) processIndexHTML() ([]byte, error) {
-	indexHTML, err := fs.ReadFile(a.assets, "index.html")
+// BasePath returns the path the assets are served from
+func (a *DesktopAssetServer) BasePath() string {
+	return a.basePath
+}
+
+// processHTML injects the runtime into the given HTML page of the assets
+func (a *DesktopAssetServer) processHTML(filename string) ([]byte, error) {
+	indexHTML, err := fs.ReadFile(a.assets, filename)
 	if err != nil {
 		return nil, err
 	}
@@ -56,13 +81,13 @@ func (a *DesktopAssetServer) processIndexHTML() ([]byte, error) {
 		return nil, err
 	}
 	if wailsOptions.disableRuntimeInjection == false {
-		indexHTML, err = injectHTML(string(indexHTML), `<script src="/wails/runtime.js"></script>`)
+		indexHTML, err = injectHTML(string(indexHTML), `<script src="`+a.basePath+`wails/runtime.js"></script>`)
 		if err != nil {
 			return nil, err
 		}
 	}
 	if wailsOptions.disableIPCInjection == false {
-		indexHTML, err = injectHTML(string(indexHTML), `<script src="/wails/ipc.js"></script>`)
+		indexHTML, err = injectHTML(string(indexHTML), `<script src="`+a.basePath+`wails/ipc.js"></script>`)
 		if err != nil {
 			return nil, err
 		}
@@ -72,15 +97,92 @@ func (a *DesktopAssetServer) processIndexHTML() ([]byte, error) {
 }
 
 func (a *DesktopAssetServer) Load(filename string) ([]byte, string, error) {
+	return a.load(stripBasePath(a.basePath, filename))
+}
+
+// Serve responds to a request for the given file. The request headers are used to negotiate
+// a pre-compressed variant of the file if one is available and to answer conditional and range requests.
+func (a *DesktopAssetServer) Serve(filename string, reqHeader http.Header) (*Response, error) {
+	filename = stripBasePath(a.basePath, filename)
+
+	result, err := a.serve(filename, reqHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.disableCaching {
+		result.Header.Set("Cache-Control", cacheControlDisabled)
+	} else {
+		applyCaching(result, reqHeader, isAssetFile(filename) && isHashedFilename(filename), a.lastModified(filename))
+	}
+
+	if isAssetFile(filename) {
+		err = applyRange(result, reqHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (a *DesktopAssetServer) serve(filename string, reqHeader http.Header) (*Response, error) {
+	result := newResponse()
+	if isAssetFile(filename) {
+		variant, encoding, hasVariants, err := negotiateEncoding(a.assets, strings.TrimPrefix(filename, "/"), reqHeader.Get("Accept-Encoding"))
+		if err != nil {
+			return nil, err
+		}
+		if hasVariants {
+			result.Header.Set("Vary", "Accept-Encoding")
+		}
+		if encoding != "" {
+			a.LogDebug("Loading file: %s (%s)", filename, encoding)
+			result.Body = variant
+			result.Header.Set("Content-Encoding", encoding)
+			result.Header.Set("Content-Type", mimeTypeForVariant(a.assets, filename))
+			return result, nil
+		}
+	}
+
+	content, mimeType, err := a.load(filename)
+	if err != nil {
+		return nil, err
+	}
+	result.Body = content
+	if mimeType != "" {
+		result.Header.Set("Content-Type", mimeType)
+	}
+	return result, nil
+}
+
+// lastModified returns the modification time of the given file, falling back to the
+// time the application was built if the assets don't provide one (EG: embed.FS)
+func (a *DesktopAssetServer) lastModified(filename string) time.Time {
+	switch {
+	case isHTMLPage(filename):
+		filename = pageFilename(filename)
+	case !isAssetFile(filename):
+		return a.buildTime
+	}
+	info, err := fs.Stat(a.assets, strings.TrimPrefix(filename, "/"))
+	if err != nil || info.ModTime().IsZero() {
+		return a.buildTime
+	}
+	return info.ModTime()
+}
+
+func (a *DesktopAssetServer) load(filename string) ([]byte, string, error) {
 	var content []byte
 	var err error
-	switch filename {
-	case "/":
-		content, err = a.processIndexHTML()
-	case "/wails/runtime.js":
+	switch {
+	case filename == "/wails/runtime.js":
 		content = a.runtimeJS
-	case "/wails/ipc.js":
+	case filename == "/wails/ipc.js":
 		content = runtime.DesktopIPC
+	case isHTMLPage(filename):
+		filename = pageFilename(filename)
+		a.LogDebug("Loading page: %s", filename)
+		content, err = a.processHTML(filename)
 	default:
 		filename = strings.TrimPrefix(filename, "/")
 		a.LogDebug("Loading file: %s", filename)
@@ -92,3 +194,17 @@ func (a *DesktopAssetServer) Load(filename string) ([]byte, string, error) {
 	mimeType := GetMimetype(filename, content)
 	return content, mimeType, nil
 }
+
+// executableModTime returns the modification time of the running executable,
+// which is when the embedded assets were built
+func executableModTime() time.Time {
+	executable, err := os.Executable()
+	if err != nil {
+		return time.Time{}
+	}
+	info, err := os.Stat(executable)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,102 @@
+package assetserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// Assets with a content hash in their filename get a new name when they change
+	cacheControlImmutable = "public, max-age=31536000, immutable"
+	// Any other file keeps its name across rebuilds, so it should always be revalidated
+	cacheControlRevalidate = "no-cache"
+	cacheControlDisabled   = "no-store"
+)
+
+// minHashLength is the shortest content hash recognised in a filename
+const minHashLength = 8
+
+// isHashedFilename returns true if the name of the file contains a content hash, as added by
+// bundlers, EG: `index.3f2a9c1b.js`, `index-BXk3e9a1.css` or `main.3f2a9c1b.chunk.js`
+func isHashedFilename(filename string) bool {
+	name := path.Base(filename)
+	name = strings.TrimSuffix(name, path.Ext(name))
+	parts := strings.FieldsFunc(name, func(r rune) bool { return r == '.' || r == '-' })
+	if len(parts) < 2 {
+		return false
+	}
+	for _, part := range parts[1:] {
+		if isContentHash(part) {
+			return true
+		}
+	}
+	return false
+}
+
+// isContentHash returns true if the given part of a filename looks like a hex or base64url hash
+func isContentHash(part string) bool {
+	if len(part) < minHashLength {
+		return false
+	}
+	hasDigit := false
+	for _, c := range part {
+		switch {
+		case c >= '0' && c <= '9':
+			hasDigit = true
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		default:
+			return false
+		}
+	}
+	return hasDigit
+}
+
+// contentETag returns a strong ETag for the given content
+func contentETag(content []byte) string {
+	hash := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(hash[:16]) + `"`
+}
+
+// etagMatches returns true if the `If-None-Match` header matches the given ETag.
+// Uses the weak comparison as required by RFC 7232 for `If-None-Match`.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	ifNoneMatch = strings.TrimSpace(ifNoneMatch)
+	if ifNoneMatch == "" {
+		return false
+	}
+	if ifNoneMatch == "*" {
+		return true
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// applyCaching sets the caching headers on the response and turns it into a `304 Not Modified`
+// if the client already has the current version of the content
+func applyCaching(result *Response, reqHeader http.Header, immutable bool, lastModified time.Time) {
+	etag := contentETag(result.Body)
+	result.Header.Set("ETag", etag)
+	if immutable {
+		result.Header.Set("Cache-Control", cacheControlImmutable)
+	} else {
+		result.Header.Set("Cache-Control", cacheControlRevalidate)
+	}
+	if !lastModified.IsZero() {
+		result.Header.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if etagMatches(reqHeader.Get("If-None-Match"), etag) {
+		result.StatusCode = http.StatusNotModified
+		result.Body = nil
+	}
+}
@@ -0,0 +1,97 @@
+package assetserver
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_etagMatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		etag        string
+		want        bool
+	}{
+		{"empty", "", `"abc"`, false},
+		{"match", `"abc"`, `"abc"`, true},
+		{"no match", `"def"`, `"abc"`, false},
+		{"list", `"def", "abc"`, `"abc"`, true},
+		{"weak", `W/"abc"`, `"abc"`, true},
+		{"wildcard", "*", `"abc"`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.ifNoneMatch, tt.etag); got != tt.want {
+				t.Errorf("etagMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isHashedFilename(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     bool
+	}{
+		{"/assets/index.3f2a9c1b.js", true},
+		{"/assets/index-BXk3e9a1.css", true},
+		{"/static/js/main.3f2a9c1b.chunk.js", true},
+		{"/assets/main.chunk.js", false},
+		{"/favicon.ico", false},
+		{"/images/logo-universal.png", false},
+		{"/fonts/nunito-v16-latin-regular.woff2", false},
+		{"/3f2a9c1b.js", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			if got := isHashedFilename(tt.filename); got != tt.want {
+				t.Errorf("isHashedFilename() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_applyCaching(t *testing.T) {
+	lastModified := time.Date(2022, 2, 10, 12, 0, 0, 0, time.UTC)
+
+	result := newResponse()
+	result.Body = []byte("content")
+	applyCaching(result, http.Header{}, true, lastModified)
+	if result.StatusCode != http.StatusOK {
+		t.Fatalf("StatusCode = %d, want %d", result.StatusCode, http.StatusOK)
+	}
+	etag := result.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("ETag not set")
+	}
+	if got := result.Header.Get("Cache-Control"); got != cacheControlImmutable {
+		t.Errorf("Cache-Control = %s, want %s", got, cacheControlImmutable)
+	}
+	if got := result.Header.Get("Last-Modified"); got != "Thu, 10 Feb 2022 12:00:00 GMT" {
+		t.Errorf("Last-Modified = %s", got)
+	}
+
+	result = newResponse()
+	result.Body = []byte("content")
+	applyCaching(result, http.Header{"If-None-Match": []string{etag}}, false, time.Time{})
+	if result.StatusCode != http.StatusNotModified {
+		t.Errorf("StatusCode = %d, want %d", result.StatusCode, http.StatusNotModified)
+	}
+	if result.Body != nil {
+		t.Error("Body should be empty for 304 responses")
+	}
+	if got := result.Header.Get("Cache-Control"); got != cacheControlRevalidate {
+		t.Errorf("Cache-Control = %s, want %s", got, cacheControlRevalidate)
+	}
+	if got := result.Header.Get("Last-Modified"); got != "" {
+		t.Errorf("Last-Modified = %s, want empty", got)
+	}
+
+	result = newResponse()
+	result.Body = []byte("changed")
+	applyCaching(result, http.Header{"If-None-Match": []string{etag}}, true, time.Time{})
+	if result.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", result.StatusCode, http.StatusOK)
+	}
+}
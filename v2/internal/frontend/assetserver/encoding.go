@@ -0,0 +1,134 @@
+package assetserver
+
+import (
+	"errors"
+	iofs "io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Response contains everything needed to reply to an asset request
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func newResponse() *Response {
+	return &Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+	}
+}
+
+// precompressedEncodings lists the supported pre-compressed variants in order of preference
+var precompressedEncodings = []struct {
+	encoding  string
+	extension string
+}{
+	{"br", ".br"},
+	{"gzip", ".gz"},
+}
+
+// isAssetFile returns true if the given path refers to a file in the assets rather than
+// an HTML page, which has the runtime injected, or the wails runtime
+func isAssetFile(filename string) bool {
+	return !isHTMLPage(filename) && !strings.HasPrefix(filename, "/wails/")
+}
+
+// isHTMLPage returns true if the given path refers to an HTML page in the assets, EG: "/",
+// "/settings.html" or "/settings/"
+func isHTMLPage(filename string) bool {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".html", ".htm":
+		return true
+	}
+	return strings.HasSuffix(filename, "/")
+}
+
+// pageFilename returns the file in the assets for the given HTML page. Directories are
+// served by their index.html.
+func pageFilename(filename string) string {
+	filename = strings.TrimPrefix(filename, "/")
+	if filename == "" || strings.HasSuffix(filename, "/") {
+		filename += "index.html"
+	}
+	return filename
+}
+
+// parseAcceptEncoding parses an `Accept-Encoding` header into a map of encoding to quality
+func parseAcceptEncoding(header string) map[string]float64 {
+	result := map[string]float64{}
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		quality := 1.0
+		split := strings.Split(part, ";")
+		encoding := strings.ToLower(strings.TrimSpace(split[0]))
+		for _, param := range split[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err == nil {
+					quality = q
+				}
+			}
+		}
+		result[encoding] = quality
+	}
+	return result
+}
+
+// negotiateEncoding looks for a pre-compressed variant of the given file that is accepted by the client.
+// The returned encoding is empty if the identity encoding should be used. hasVariants indicates if any
+// variant of the file exists, so that the caller knows to set the `Vary` header.
+func negotiateEncoding(assets iofs.FS, filename string, acceptEncoding string) (content []byte, encoding string, hasVariants bool, err error) {
+	accepted := parseAcceptEncoding(acceptEncoding)
+
+	bestQuality := 0.0
+	for _, candidate := range precompressedEncodings {
+		variant, err := iofs.ReadFile(assets, filename+candidate.extension)
+		if err != nil {
+			if errors.Is(err, iofs.ErrNotExist) {
+				continue
+			}
+			return nil, "", false, err
+		}
+		hasVariants = true
+
+		quality, exists := accepted[candidate.encoding]
+		if !exists {
+			quality = accepted["*"]
+		}
+		if quality > bestQuality {
+			bestQuality = quality
+			content = variant
+			encoding = candidate.encoding
+		}
+	}
+
+	// The client prefers the uncompressed file
+	if identity, exists := accepted["identity"]; exists && identity > bestQuality {
+		return nil, "", hasVariants, nil
+	}
+
+	return content, encoding, hasVariants, nil
+}
+
+// mimeTypeForVariant determines the mimetype of a file that is being served using a pre-compressed variant.
+// The variant itself can't be sniffed so we use the extension, falling back to sniffing the uncompressed file.
+func mimeTypeForVariant(assets iofs.FS, filename string) string {
+	if mimeType := mime.TypeByExtension(path.Ext(filename)); mimeType != "" {
+		return mimeType
+	}
+	content, err := iofs.ReadFile(assets, strings.TrimPrefix(filename, "/"))
+	if err != nil {
+		return "application/octet-stream"
+	}
+	return GetMimetype(filename, content)
+}
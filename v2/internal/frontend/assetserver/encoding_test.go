@@ -0,0 +1,81 @@
+package assetserver
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func Test_negotiateEncoding(t *testing.T) {
+	assets := fstest.MapFS{
+		"main.js":     {Data: []byte("identity")},
+		"main.js.br":  {Data: []byte("brotli")},
+		"main.js.gz":  {Data: []byte("gzip")},
+		"main.css":    {Data: []byte("identity")},
+		"other.js":    {Data: []byte("identity")},
+		"other.js.gz": {Data: []byte("gzip")},
+	}
+	tests := []struct {
+		name           string
+		filename       string
+		acceptEncoding string
+		wantContent    string
+		wantEncoding   string
+		wantVariants   bool
+	}{
+		{"no header", "main.js", "", "", "", true},
+		{"brotli preferred", "main.js", "gzip, deflate, br", "brotli", "br", true},
+		{"gzip only", "main.js", "gzip, deflate", "gzip", "gzip", true},
+		{"quality", "main.js", "br;q=0.5, gzip;q=0.8", "gzip", "gzip", true},
+		{"excluded", "main.js", "br;q=0, gzip", "gzip", "gzip", true},
+		{"wildcard", "main.js", "*", "brotli", "br", true},
+		{"identity preferred", "main.js", "identity, br;q=0.5", "", "", true},
+		{"no variants", "main.css", "gzip, deflate, br", "", "", false},
+		{"gzip variant only", "other.js", "gzip, deflate, br", "gzip", "gzip", true},
+		{"unsupported", "other.js", "br", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, encoding, hasVariants, err := negotiateEncoding(assets, tt.filename, tt.acceptEncoding)
+			if err != nil {
+				t.Fatalf("negotiateEncoding() error = %v", err)
+			}
+			if string(content) != tt.wantContent {
+				t.Errorf("negotiateEncoding() content = %s, want %s", content, tt.wantContent)
+			}
+			if encoding != tt.wantEncoding {
+				t.Errorf("negotiateEncoding() encoding = %s, want %s", encoding, tt.wantEncoding)
+			}
+			if hasVariants != tt.wantVariants {
+				t.Errorf("negotiateEncoding() hasVariants = %t, want %t", hasVariants, tt.wantVariants)
+			}
+		})
+	}
+}
+
+func Test_pageFilename(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		wantPage bool
+		want     string
+	}{
+		{"root", "/", true, "index.html"},
+		{"page", "/settings.html", true, "settings.html"},
+		{"uppercase extension", "/about.HTM", true, "about.HTM"},
+		{"directory", "/settings/", true, "settings/index.html"},
+		{"asset", "/settings/main.js", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHTMLPage(tt.filename); got != tt.wantPage {
+				t.Fatalf("isHTMLPage() = %t, want %t", got, tt.wantPage)
+			}
+			if !tt.wantPage {
+				return
+			}
+			if got := pageFilename(tt.filename); got != tt.want {
+				t.Errorf("pageFilename() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
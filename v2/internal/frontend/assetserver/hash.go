@@ -0,0 +1,47 @@
+package assetserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	iofs "io/fs"
+	"strings"
+)
+
+// Hash returns the hash of the frontend assets served from the given filesystem. It is the same for
+// the asset directory of a project and the assets embedded from it, as files that `go:embed`
+// excludes, starting with '.' or '_', are ignored.
+func Hash(assets iofs.FS) (string, error) {
+	assets, err := prepareAssetsForServing(assets)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	// Files are walked in lexical order, which makes the hash deterministic
+	err = iofs.WalkDir(assets, ".", func(path string, entry iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != "." && (strings.HasPrefix(entry.Name(), ".") || strings.HasPrefix(entry.Name(), "_")) {
+			if entry.IsDir() {
+				return iofs.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		content, err := iofs.ReadFile(assets, path)
+		if err != nil {
+			return err
+		}
+		hash.Write([]byte(path + "\x00"))
+		contentHash := sha256.Sum256(content)
+		hash.Write(contentHash[:])
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,36 @@
+package assetserver
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestHash(t *testing.T) {
+	assets := fstest.MapFS{
+		"frontend/dist/index.html":       {Data: []byte("<html></html>")},
+		"frontend/dist/assets/main.js":   {Data: []byte("main()")},
+		"frontend/dist/.DS_Store":        {Data: []byte("ignored")},
+		"frontend/dist/_ignored/main.js": {Data: []byte("ignored")},
+	}
+	hash, err := Hash(assets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hash) != 64 {
+		t.Errorf("Hash() = %s, want a sha256 hex string", hash)
+	}
+
+	// Files excluded by go:embed don't change the hash
+	withoutIgnored := fstest.MapFS{
+		"dist/index.html":     assets["frontend/dist/index.html"],
+		"dist/assets/main.js": assets["frontend/dist/assets/main.js"],
+	}
+	if got, _ := Hash(withoutIgnored); got != hash {
+		t.Errorf("Hash() = %s, want %s", got, hash)
+	}
+
+	withoutIgnored["dist/assets/main.js"] = &fstest.MapFile{Data: []byte("main(1)")}
+	if got, _ := Hash(withoutIgnored); got == hash {
+		t.Error("Hash() didn't change when an asset changed")
+	}
+}
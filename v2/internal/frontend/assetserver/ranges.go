@@ -0,0 +1,151 @@
+package assetserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+var errInvalidRange = errors.New("invalid range")
+
+// httpRange specifies the byte range to be sent to the client
+type httpRange struct {
+	start, length int64
+}
+
+func (r httpRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.start, r.start+r.length-1, size)
+}
+
+// parseRange parses a `Range` header for content of the given size.
+// Ranges that start beyond the end of the content are dropped. An error is returned if
+// the header is malformed or none of the ranges can be satisfied.
+func parseRange(header string, size int64) ([]httpRange, error) {
+	const prefix = "bytes="
+	if !strings.HasPrefix(header, prefix) {
+		return nil, errInvalidRange
+	}
+
+	var ranges []httpRange
+	for _, spec := range strings.Split(header[len(prefix):], ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		split := strings.SplitN(spec, "-", 2)
+		if len(split) != 2 {
+			return nil, errInvalidRange
+		}
+		start, end := strings.TrimSpace(split[0]), strings.TrimSpace(split[1])
+
+		var r httpRange
+		if start == "" {
+			// Suffix range: the last N bytes
+			suffix, err := strconv.ParseInt(end, 10, 64)
+			if err != nil || suffix < 0 {
+				return nil, errInvalidRange
+			}
+			if suffix == 0 {
+				continue
+			}
+			if suffix > size {
+				suffix = size
+			}
+			r.start = size - suffix
+			r.length = suffix
+		} else {
+			first, err := strconv.ParseInt(start, 10, 64)
+			if err != nil || first < 0 {
+				return nil, errInvalidRange
+			}
+			if first >= size {
+				continue
+			}
+			r.start = first
+			if end == "" {
+				r.length = size - first
+			} else {
+				last, err := strconv.ParseInt(end, 10, 64)
+				if err != nil || last < first {
+					return nil, errInvalidRange
+				}
+				if last >= size {
+					last = size - 1
+				}
+				r.length = last - first + 1
+			}
+		}
+		ranges = append(ranges, r)
+	}
+
+	if len(ranges) == 0 {
+		return nil, errInvalidRange
+	}
+	return ranges, nil
+}
+
+// applyRange turns the response into a `206 Partial Content` response if the request
+// asks for a range of the content, or a `416 Range Not Satisfiable` if the range is invalid
+func applyRange(result *Response, reqHeader http.Header) error {
+	result.Header.Set("Accept-Ranges", "bytes")
+
+	rangeHeader := reqHeader.Get("Range")
+	if rangeHeader == "" || result.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	// If the content has changed since the client fetched the first part, send all of it
+	if ifRange := reqHeader.Get("If-Range"); ifRange != "" {
+		etag := result.Header.Get("ETag")
+		if etag == "" || ifRange != etag {
+			return nil
+		}
+	}
+
+	size := int64(len(result.Body))
+	ranges, err := parseRange(rangeHeader, size)
+	if err != nil {
+		result.StatusCode = http.StatusRequestedRangeNotSatisfiable
+		result.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+		result.Body = nil
+		return nil
+	}
+
+	if len(ranges) == 1 {
+		r := ranges[0]
+		result.StatusCode = http.StatusPartialContent
+		result.Header.Set("Content-Range", r.contentRange(size))
+		result.Body = result.Body[r.start : r.start+r.length]
+		return nil
+	}
+
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	for _, r := range ranges {
+		partHeader := textproto.MIMEHeader{}
+		if contentType := result.Header.Get("Content-Type"); contentType != "" {
+			partHeader.Set("Content-Type", contentType)
+		}
+		partHeader.Set("Content-Range", r.contentRange(size))
+		part, err := writer.CreatePart(partHeader)
+		if err != nil {
+			return err
+		}
+		if _, err := part.Write(result.Body[r.start : r.start+r.length]); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	result.StatusCode = http.StatusPartialContent
+	result.Header.Set("Content-Type", "multipart/byteranges; boundary="+writer.Boundary())
+	result.Body = buffer.Bytes()
+	return nil
+}
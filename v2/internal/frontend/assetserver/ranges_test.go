@@ -0,0 +1,165 @@
+package assetserver
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_parseRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		size    int64
+		want    []httpRange
+		wantErr bool
+	}{
+		{"single", "bytes=0-4", 10, []httpRange{{0, 5}}, false},
+		{"open ended", "bytes=5-", 10, []httpRange{{5, 5}}, false},
+		{"suffix", "bytes=-3", 10, []httpRange{{7, 3}}, false},
+		{"suffix larger than content", "bytes=-20", 10, []httpRange{{0, 10}}, false},
+		{"end beyond content", "bytes=8-20", 10, []httpRange{{8, 2}}, false},
+		{"multiple", "bytes=0-1, 4-5,-2", 10, []httpRange{{0, 2}, {4, 2}, {8, 2}}, false},
+		{"unsatisfiable dropped", "bytes=0-1,20-30", 10, []httpRange{{0, 2}}, false},
+		{"unsatisfiable", "bytes=10-", 10, nil, true},
+		{"wrong unit", "items=0-1", 10, nil, true},
+		{"end before start", "bytes=5-1", 10, nil, true},
+		{"malformed", "bytes=a-b", 10, nil, true},
+		{"missing dash", "bytes=5", 10, nil, true},
+		{"empty", "bytes=", 10, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRange(tt.header, tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newTestResponse(body string) *Response {
+	result := newResponse()
+	result.Body = []byte(body)
+	result.Header.Set("Content-Type", "text/plain")
+	result.Header.Set("ETag", `"etag"`)
+	return result
+}
+
+func Test_applyRange(t *testing.T) {
+	t.Run("no range", func(t *testing.T) {
+		result := newTestResponse("0123456789")
+		if err := applyRange(result, http.Header{}); err != nil {
+			t.Fatal(err)
+		}
+		if result.StatusCode != http.StatusOK || string(result.Body) != "0123456789" {
+			t.Errorf("got %d %s", result.StatusCode, result.Body)
+		}
+		if got := result.Header.Get("Accept-Ranges"); got != "bytes" {
+			t.Errorf("Accept-Ranges = %s, want bytes", got)
+		}
+	})
+
+	t.Run("single range", func(t *testing.T) {
+		result := newTestResponse("0123456789")
+		if err := applyRange(result, http.Header{"Range": []string{"bytes=2-5"}}); err != nil {
+			t.Fatal(err)
+		}
+		if result.StatusCode != http.StatusPartialContent {
+			t.Errorf("StatusCode = %d, want %d", result.StatusCode, http.StatusPartialContent)
+		}
+		if string(result.Body) != "2345" {
+			t.Errorf("Body = %s, want 2345", result.Body)
+		}
+		if got := result.Header.Get("Content-Range"); got != "bytes 2-5/10" {
+			t.Errorf("Content-Range = %s, want bytes 2-5/10", got)
+		}
+	})
+
+	t.Run("multiple ranges", func(t *testing.T) {
+		result := newTestResponse("0123456789")
+		if err := applyRange(result, http.Header{"Range": []string{"bytes=0-1,-3"}}); err != nil {
+			t.Fatal(err)
+		}
+		if result.StatusCode != http.StatusPartialContent {
+			t.Fatalf("StatusCode = %d, want %d", result.StatusCode, http.StatusPartialContent)
+		}
+		mediaType, params, err := mime.ParseMediaType(result.Header.Get("Content-Type"))
+		if err != nil || mediaType != "multipart/byteranges" {
+			t.Fatalf("Content-Type = %s", result.Header.Get("Content-Type"))
+		}
+
+		wantParts := []struct{ contentRange, body string }{
+			{"bytes 0-1/10", "01"},
+			{"bytes 7-9/10", "789"},
+		}
+		reader := multipart.NewReader(strings.NewReader(string(result.Body)), params["boundary"])
+		for _, want := range wantParts {
+			part, err := reader.NextPart()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := part.Header.Get("Content-Range"); got != want.contentRange {
+				t.Errorf("Content-Range = %s, want %s", got, want.contentRange)
+			}
+			if got := part.Header.Get("Content-Type"); got != "text/plain" {
+				t.Errorf("Content-Type = %s, want text/plain", got)
+			}
+			body, _ := io.ReadAll(part)
+			if string(body) != want.body {
+				t.Errorf("Body = %s, want %s", body, want.body)
+			}
+		}
+		if _, err := reader.NextPart(); err != io.EOF {
+			t.Errorf("expected %d parts", len(wantParts))
+		}
+	})
+
+	for _, header := range []string{"bytes=20-30", "bytes=abc", "lines=1-2"} {
+		t.Run("invalid range "+header, func(t *testing.T) {
+			result := newTestResponse("0123456789")
+			if err := applyRange(result, http.Header{"Range": []string{header}}); err != nil {
+				t.Fatal(err)
+			}
+			if result.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+				t.Errorf("StatusCode = %d, want %d", result.StatusCode, http.StatusRequestedRangeNotSatisfiable)
+			}
+			if got := result.Header.Get("Content-Range"); got != "bytes */10" {
+				t.Errorf("Content-Range = %s, want bytes */10", got)
+			}
+			if result.Body != nil {
+				t.Error("Body should be empty")
+			}
+		})
+	}
+
+	t.Run("if-range mismatch", func(t *testing.T) {
+		result := newTestResponse("0123456789")
+		header := http.Header{"Range": []string{"bytes=2-5"}, "If-Range": []string{`"old"`}}
+		if err := applyRange(result, header); err != nil {
+			t.Fatal(err)
+		}
+		if result.StatusCode != http.StatusOK || string(result.Body) != "0123456789" {
+			t.Errorf("got %d %s", result.StatusCode, result.Body)
+		}
+	})
+
+	t.Run("not modified", func(t *testing.T) {
+		result := newTestResponse("")
+		result.StatusCode = http.StatusNotModified
+		result.Body = nil
+		if err := applyRange(result, http.Header{"Range": []string{"bytes=2-5"}}); err != nil {
+			t.Fatal(err)
+		}
+		if result.StatusCode != http.StatusNotModified {
+			t.Errorf("StatusCode = %d, want %d", result.StatusCode, http.StatusNotModified)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// cssCursorAliases are the CSS cursors of the names accepted by SetCursor that aren't CSS cursor names
+var cssCursorAliases = map[string]string{
+	"arrow": "default",
+	"hand":  "pointer",
+	"ibeam": "text",
+}
+
+// CSSCursor is the cursor shown over the window on platforms where the webview draws the cursor itself, so it is
+// set with a stylesheet that overrides the cursors of the page. The setters return the Javascript applying the
+// cursor to the page. It is safe for concurrent use.
+type CSSCursor struct {
+	lock   sync.Mutex
+	cursor string
+	hidden bool
+	// Set while in presentation mode, which hides the cursor without changing the state set by SetHidden
+	presentationHidden bool
+}
+
+// SetCursor sets the cursor to the CSS cursor with the given name. An empty name restores the cursors of the page.
+func (c *CSSCursor) SetCursor(name string) string {
+	if alias, exists := cssCursorAliases[name]; exists {
+		name = alias
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cursor = name
+	return c.script()
+}
+
+// SetCustomCursor sets the cursor to the given .ico or .png image
+func (c *CSSCursor) SetCustomCursor(image []byte, hotspotX int, hotspotY int) string {
+	url := "data:" + http.DetectContentType(image) + ";base64," + base64.StdEncoding.EncodeToString(image)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cursor = fmt.Sprintf(`url("%s") %d %d, auto`, url, hotspotX, hotspotY)
+	return c.script()
+}
+
+func (c *CSSCursor) SetHidden(hidden bool) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.hidden = hidden
+	return c.script()
+}
+
+func (c *CSSCursor) SetPresentationHidden(hidden bool) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.presentationHidden = hidden
+	return c.script()
+}
+
+// Script returns the Javascript applying the cursor to the page, EG: after the page is reloaded
+func (c *CSSCursor) Script() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.script()
+}
+
+func (c *CSSCursor) script() string {
+	cursor := c.cursor
+	if c.hidden || c.presentationHidden {
+		cursor = "none"
+	}
+	value, _ := json.Marshal(cursor)
+	return "window.wails.SetCursorStyle(" + string(value) + ");"
+}
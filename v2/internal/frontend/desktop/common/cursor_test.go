@@ -0,0 +1,29 @@
+package common
+
+import "testing"
+
+func TestCSSCursor(t *testing.T) {
+	var cursor CSSCursor
+	if got := cursor.SetCursor("hand"); got != `window.wails.SetCursorStyle("pointer");` {
+		t.Errorf("SetCursor() = %s", got)
+	}
+	if got := cursor.SetHidden(true); got != `window.wails.SetCursorStyle("none");` {
+		t.Errorf("SetHidden(true) = %s", got)
+	}
+	if got := cursor.SetPresentationHidden(true); got != `window.wails.SetCursorStyle("none");` {
+		t.Errorf("SetPresentationHidden(true) = %s", got)
+	}
+	cursor.SetHidden(false)
+	if got := cursor.SetPresentationHidden(false); got != `window.wails.SetCursorStyle("pointer");` {
+		t.Errorf("SetPresentationHidden(false) = %s", got)
+	}
+	if got := cursor.SetCustomCursor([]byte("\x89PNG\r\n\x1a\n"), 4, 8); got != `window.wails.SetCursorStyle("url(\"data:image/png;base64,iVBORw0KGgo=\") 4 8, auto");` {
+		t.Errorf("SetCustomCursor() = %s", got)
+	}
+	if got := cursor.SetCursor(""); got != `window.wails.SetCursorStyle("");` {
+		t.Errorf("SetCursor(\"\") = %s", got)
+	}
+	if got := cursor.Script(); got != `window.wails.SetCursorStyle("");` {
+		t.Errorf("Script() = %s", got)
+	}
+}
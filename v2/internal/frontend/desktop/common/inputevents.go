@@ -0,0 +1,13 @@
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// PageInputEventsScript returns the Javascript setting which input events are forwarded from the page
+func PageInputEventsScript(events options.InputEvents) string {
+	data, _ := json.Marshal(events)
+	return "window.wails.SetPageInputEvents(" + string(data) + ");"
+}
@@ -18,7 +18,7 @@
 #define WindowStartsFullscreen 3
 
 WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int debug, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight);
-void Run(void*);
+void Run(void*, const char* url);
 
 void SetTitle(void* ctx, const char *title);
 void Center(void* ctx);
@@ -28,14 +28,19 @@ void SetMaxSize(void* ctx, int width, int height);
 void SetPosition(void* ctx, int x, int y);
 void Fullscreen(void* ctx);
 void UnFullscreen(void* ctx);
+int IsFullScreen(void* ctx);
 void Minimise(void* ctx);
 void UnMinimise(void* ctx);
 void Maximise(void* ctx);
 void UnMaximise(void* ctx);
 void Hide(void* ctx);
 void Show(void* ctx);
+void ShowNoActivate(void* ctx);
+void SetIgnoreMouseEvents(void* ctx, int ignore);
+void SetAlwaysOnBottom(void* ctx, int bottom);
 void SetRGBA(void* ctx, int r, int g, int b, int a);
 void ExecJS(void* ctx, const char*);
+void LoadURL(void* ctx, const char* url);
 void Quit(void*);
 
 const char* GetSize(void *ctx);
@@ -70,6 +70,14 @@ void ExecJS(void* inctx, const char *script) {
     );
 }
 
+void LoadURL(void* inctx, const char *url) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *nsurl = safeInit(url);
+    ON_MAIN_THREAD(
+       [ctx loadRequest:nsurl];
+    );
+}
+
 void SetTitle(void* inctx, const char *title) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     NSString *_title = safeInit(title);
@@ -135,6 +143,11 @@ void UnFullscreen(void* inctx) {
     );
 }
 
+int IsFullScreen(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    return [ctx isFullscreen];
+}
+
 void Minimise(void* inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -195,6 +208,20 @@ void Hide(void *inctx) {
     );
 }
 
+void SetIgnoreMouseEvents(void *inctx, int ignore) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetIgnoreMouseEvents:ignore];
+    );
+}
+
+void SetAlwaysOnBottom(void *inctx, int bottom) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetAlwaysOnBottom:bottom];
+    );
+}
+
 void Show(void *inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -202,6 +229,13 @@ void Show(void *inctx) {
     );
 }
 
+void ShowNoActivate(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx ShowNoActivate];
+    );
+}
+
 NSString* safeInit(const char* input) {
     NSString *result = nil;
     if (input != nil) {
@@ -210,6 +244,8 @@ NSString* safeInit(const char* input) {
     return result;
 }
 
+#ifndef WAILS_NODIALOGS
+
 void MessageDialog(void *inctx, const char* dialogType, const char* title, const char* message, const char* button1, const char* button2, const char* button3, const char* button4, const char* defaultButton, const char* cancelButton, void* iconData, int iconDataLength) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     
@@ -254,6 +290,8 @@ void SaveFileDialog(void *inctx, const char* title, const char* defaultFilename,
     )
 }
 
+#endif
+
 void AppendRole(void *inctx, void *inMenu, int role) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     WailsMenu *menu = (__bridge WailsMenu*) inMenu;
@@ -321,7 +359,7 @@ void AppendSeparator(void* inMenu) {
 
 
 
-void Run(void *inctx) {
+void Run(void *inctx, const char* url) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     NSApplication *app = [NSApplication sharedApplication];
     AppDelegate* delegate = [AppDelegate new];
@@ -332,7 +370,7 @@ void Run(void *inctx) {
     delegate.startHidden = ctx.startHidden;
     delegate.startFullscreen = ctx.startFullscreen;
 
-    [ctx loadRequest:@"wails://wails/"];
+    [ctx loadRequest:safeInit(url)];
     [app setMainMenu:ctx.applicationMenu];
     [app run];
     [ctx release];
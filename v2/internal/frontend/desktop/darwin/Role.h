@@ -12,5 +12,7 @@ typedef int Role;
 
 static const Role AppMenu = 1;
 static const Role EditMenu = 2;
+// Handled in Go
+static const Role About = 3;
 
 #endif /* Role_h */
@@ -64,6 +64,7 @@
 - (void) Center;
 - (void) Fullscreen;
 - (void) UnFullscreen;
+- (BOOL) isFullscreen;
 - (void) Minimise;
 - (void) UnMinimise;
 - (void) Maximise;
@@ -73,11 +74,16 @@
 - (void) ShowMouse;
 - (void) Hide;
 - (void) Show;
+- (void) ShowNoActivate;
+- (void) SetIgnoreMouseEvents:(bool)ignore;
+- (void) SetAlwaysOnBottom:(bool)bottom;
 - (void) Quit;
 
+#ifndef WAILS_NODIALOGS
 -(void) MessageDialog :(NSString*)dialogType :(NSString*)title :(NSString*)message :(NSString*)button1 :(NSString*)button2 :(NSString*)button3 :(NSString*)button4 :(NSString*)defaultButton :(NSString*)cancelButton :(void*)iconData :(int)iconDataLength;
 - (void) OpenFileDialog :(NSString*)title :(NSString*)defaultFilename :(NSString*)defaultDirectory :(bool)allowDirectories :(bool)allowFiles :(bool)canCreateDirectories :(bool)treatPackagesAsDirectories :(bool)resolveAliases :(bool)showHiddenFiles :(bool)allowMultipleSelection :(NSString*)filters;
 - (void) SaveFileDialog :(NSString*)title :(NSString*)defaultFilename :(NSString*)defaultDirectory :(bool)canCreateDirectories :(bool)treatPackagesAsDirectories :(bool)showHiddenFiles :(NSString*)filters;
+#endif
 
 - (void) loadRequest:(NSString*)url;
 - (void) processURLResponse:(NSString *)url :(int)statusCode :(NSString *)contentType :(NSData*)data;
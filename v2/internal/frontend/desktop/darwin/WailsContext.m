@@ -355,11 +355,29 @@
     [self.mainWindow orderOut:nil];
 }
 
+- (void) SetIgnoreMouseEvents:(bool)ignore {
+    [self.mainWindow setIgnoresMouseEvents:ignore];
+}
+
+- (void) SetAlwaysOnBottom:(bool)bottom {
+    if (bottom) {
+        [self.mainWindow setLevel:kCGDesktopWindowLevel];
+    } else if (self.alwaysOnTop) {
+        [self.mainWindow setLevel:NSStatusWindowLevel];
+    } else {
+        [self.mainWindow setLevel:NSNormalWindowLevel];
+    }
+}
+
 - (void) Show {
     [self.mainWindow makeKeyAndOrderFront:nil];
     [NSApp activateIgnoringOtherApps:YES];
 }
 
+- (void) ShowNoActivate {
+    [self.mainWindow orderFront:nil];
+}
+
 - (void) Maximise {
     if (![self.mainWindow isZoomed]) {
         [self.mainWindow zoom:nil];
@@ -402,6 +420,10 @@
     
 }
 
+- (void)webView:(WKWebView *)webView didCommitNavigation:(WKNavigation *)navigation {
+    processMessage("WebviewReady");
+}
+
 - (void)webView:(WKWebView *)webView didFinishNavigation:(WKNavigation *)navigation {
     processMessage("DomReady");
 }
@@ -426,6 +448,8 @@
 }
 
 
+#ifndef WAILS_NODIALOGS
+
 /***** Dialogs ******/
 -(void) MessageDialog :(NSString*)dialogType :(NSString*)title :(NSString*)message :(NSString*)button1 :(NSString*)button2 :(NSString*)button3 :(NSString*)button4 :(NSString*)defaultButton :(NSString*)cancelButton :(void*)iconData :(int)iconDataLength {
 
@@ -608,6 +632,8 @@
         
 }
 
+#endif
+
 - (void) SetAbout :(NSString*)title :(NSString*)description :(void*)imagedata :(int)datalen {
     self.aboutTitle = title;
     self.aboutDescription = description;
@@ -4,11 +4,13 @@
 package darwin
 
 import (
-	"github.com/pkg/browser"
+	"github.com/wailsapp/wails/v2/internal/shell"
 )
 
 // BrowserOpenURL Use the default browser to open the url
 func (f *Frontend) BrowserOpenURL(url string) {
-	// Specific method implementation
-	_ = browser.OpenURL(url)
+	err := shell.OpenURL(url)
+	if err != nil {
+		f.logger.Error("BrowserOpenURL: %s", err.Error())
+	}
 }
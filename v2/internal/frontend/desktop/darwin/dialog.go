@@ -1,5 +1,5 @@
-//go:build darwin
-// +build darwin
+//go:build darwin && !wails.nodialogs
+// +build darwin,!wails.nodialogs
 
 package darwin
 
@@ -0,0 +1,35 @@
+//go:build darwin && wails.nodialogs
+// +build darwin,wails.nodialogs
+
+package darwin
+
+// The dialogs of the Objective-C code call back into dialog.go, so they are left out with WAILS_NODIALOGS
+
+/*
+#cgo CFLAGS: -DWAILS_NODIALOGS
+*/
+import "C"
+import (
+	"github.com/wailsapp/wails/v2/internal/features"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+func (f *Frontend) OpenDirectoryDialog(_ frontend.OpenDialogOptions) (string, error) {
+	return "", features.Disabled(features.Dialogs)
+}
+
+func (f *Frontend) OpenFileDialog(_ frontend.OpenDialogOptions) (string, error) {
+	return "", features.Disabled(features.Dialogs)
+}
+
+func (f *Frontend) OpenMultipleFilesDialog(_ frontend.OpenDialogOptions) ([]string, error) {
+	return nil, features.Disabled(features.Dialogs)
+}
+
+func (f *Frontend) SaveFileDialog(_ frontend.SaveDialogOptions) (string, error) {
+	return "", features.Disabled(features.Dialogs)
+}
+
+func (f *Frontend) MessageDialog(_ frontend.MessageDialogOptions) (string, error) {
+	return "", features.Disabled(features.Dialogs)
+}
@@ -16,11 +16,13 @@ import "C"
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
 	"log"
 	"os"
 	"strconv"
+	"sync"
 	"unsafe"
 
 	"github.com/wailsapp/wails/v2/internal/binding"
@@ -28,6 +30,7 @@ import (
 	"github.com/wailsapp/wails/v2/internal/frontend/assetserver"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/common"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/internal/startup"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
@@ -50,13 +53,22 @@ type Frontend struct {
 	debug           bool
 
 	// Assets
-	assets *assetserver.DesktopAssetServer
+	assets   *assetserver.DesktopAssetServer
+	startURL string
 
 	// main window handle
 	mainWindow      *Window
 	bindings        *binding.Bindings
 	dispatcher      frontend.Dispatcher
 	servingFromDisk bool
+
+	// The cursor set with the runtime, which is applied to the page with a stylesheet
+	cursor common.CSSCursor
+
+	// Set while in presentation mode, with whether the window was already fullscreen
+	presentationLock          sync.Mutex
+	presentationMode          bool
+	presentationWasFullscreen bool
 }
 
 func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.Logger, appBindings *binding.Bindings, dispatcher frontend.Dispatcher) *Frontend {
@@ -82,11 +94,12 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 	if err != nil {
 		log.Fatal(err)
 	}
-	assets, err := assetserver.NewDesktopAssetServer(ctx, appoptions.Assets, bindingsJSON)
+	assets, err := assetserver.NewDesktopAssetServer(ctx, appoptions, bindingsJSON)
 	if err != nil {
 		log.Fatal(err)
 	}
 	result.assets = assets
+	result.startURL = "wails://wails" + assets.BasePath()
 
 	go result.startMessageProcessor()
 	go result.startRequestProcessor()
@@ -118,6 +131,15 @@ func (f *Frontend) WindowReload() {
 	f.ExecJS("runtime.WindowReload();")
 }
 
+func (f *Frontend) WindowLoadURL(url string) error {
+	target, err := frontend.ResolveURL(f.startURL, url, f.frontendOptions.RestrictWindowLoadURL)
+	if err != nil {
+		return err
+	}
+	f.mainWindow.LoadURL(target)
+	return nil
+}
+
 func (f *Frontend) Run(ctx context.Context) error {
 
 	f.ctx = context.WithValue(ctx, "frontend", f)
@@ -129,6 +151,7 @@ func (f *Frontend) Run(ctx context.Context) error {
 
 	mainWindow := NewWindow(f.frontendOptions, f.debug)
 	f.mainWindow = mainWindow
+	startup.Record(f.ctx, startup.WindowCreated)
 	f.mainWindow.Center()
 
 	go func() {
@@ -136,7 +159,7 @@ func (f *Frontend) Run(ctx context.Context) error {
 			f.frontendOptions.OnStartup(f.ctx)
 		}
 	}()
-	mainWindow.Run()
+	mainWindow.Run(f.startURL)
 	return nil
 }
 
@@ -175,6 +198,10 @@ func (f *Frontend) WindowShow() {
 	f.mainWindow.Show()
 }
 
+func (f *Frontend) WindowShowNoActivate() {
+	f.mainWindow.ShowNoActivate()
+}
+
 func (f *Frontend) WindowHide() {
 	f.mainWindow.Hide()
 }
@@ -198,6 +225,83 @@ func (f *Frontend) WindowSetMaxSize(width int, height int) {
 	f.mainWindow.SetMaxSize(width, height)
 }
 
+func (f *Frontend) WindowSetIgnoreMouseEvents(ignore bool) {
+	f.mainWindow.SetIgnoreMouseEvents(ignore)
+}
+
+func (f *Frontend) WindowSetAlwaysOnBottom(bottom bool) {
+	f.mainWindow.SetAlwaysOnBottom(bottom)
+}
+
+func (f *Frontend) WindowSetEnabled(_ bool) {
+	// Not supported on macOS
+}
+
+func (f *Frontend) WindowIsEnabled() bool {
+	return true
+}
+
+// WindowEnterPresentationMode makes the window fullscreen and hides the cursor while it is over the window.
+// Keeping the display on isn't supported on macOS.
+func (f *Frontend) WindowEnterPresentationMode() {
+	f.presentationLock.Lock()
+	defer f.presentationLock.Unlock()
+	if f.presentationMode {
+		return
+	}
+	f.presentationMode = true
+	f.presentationWasFullscreen = f.mainWindow.IsFullScreen()
+	if !f.presentationWasFullscreen {
+		f.mainWindow.Fullscreen()
+	}
+	f.ExecJS(f.cursor.SetPresentationHidden(true))
+}
+
+func (f *Frontend) WindowExitPresentationMode() {
+	f.presentationLock.Lock()
+	defer f.presentationLock.Unlock()
+	if !f.presentationMode {
+		return
+	}
+	f.presentationMode = false
+	if !f.presentationWasFullscreen {
+		f.mainWindow.UnFullscreen()
+	}
+	f.ExecJS(f.cursor.SetPresentationHidden(false))
+}
+
+func (f *Frontend) WindowSetCursor(cursor string) {
+	f.ExecJS(f.cursor.SetCursor(cursor))
+}
+
+func (f *Frontend) WindowSetCustomCursor(image []byte, hotspotX int, hotspotY int) {
+	f.ExecJS(f.cursor.SetCustomCursor(image, hotspotX, hotspotY))
+}
+
+func (f *Frontend) WindowHideCursor() {
+	f.ExecJS(f.cursor.SetHidden(true))
+}
+
+func (f *Frontend) WindowShowCursor() {
+	f.ExecJS(f.cursor.SetHidden(false))
+}
+
+func (f *Frontend) WindowSetInputEvents(events options.InputEvents) {
+	f.ExecJS(common.PageInputEventsScript(events))
+}
+
+func (f *Frontend) WindowSetThumbnailButtons(_ []frontend.ThumbnailButton) error {
+	return errors.New("thumbnail buttons are only supported on Windows")
+}
+
+func (f *Frontend) DownloadCancel(_ string) error {
+	return errors.New("download events are only supported on Windows")
+}
+
+func (f *Frontend) StartDrag(_ []string) error {
+	return errors.New("dragging files from the window is only supported on Windows")
+}
+
 func (f *Frontend) WindowSetRGBA(col *options.RGBA) {
 	if col == nil {
 		return
@@ -232,7 +336,16 @@ func (f *Frontend) Notify(name string, data ...interface{}) {
 
 func (f *Frontend) processMessage(message string) {
 
+	// The webview only starts its content process when the first page is loaded
+	if message == "WebviewReady" {
+		startup.Record(f.ctx, startup.WebviewReady)
+		return
+	}
+
 	if message == "DomReady" {
+		startup.Record(f.ctx, startup.DomReady)
+		// The stylesheet setting the cursor is lost when the page is reloaded
+		f.ExecJS(f.cursor.Script())
 		if f.frontendOptions.OnDomReady != nil {
 			f.frontendOptions.OnDomReady(f.ctx)
 		}
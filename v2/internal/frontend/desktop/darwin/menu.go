@@ -68,7 +68,7 @@ func (m *NSMenu) AddMenuItem(menuItem *menu.MenuItem) *MenuItem {
 	}
 
 	result.id = createMenuItemID(result)
-	result.nsmenuitem = C.AppendMenuItem(m.context, m.nsmenu, c.String(menuItem.Label), key, modifier, bool2Cint(menuItem.Disabled), bool2Cint(menuItem.Checked), C.int(result.id))
+	result.nsmenuitem = C.AppendMenuItem(m.context, m.nsmenu, c.String(menuItem.DisplayLabel()), key, modifier, bool2Cint(menuItem.Disabled), bool2Cint(menuItem.Checked), C.int(result.id))
 	return result
 }
 
@@ -86,7 +86,7 @@ func processMenu(parent *NSMenu, wailsMenu *menu.Menu) {
 				processRadioGroups(radioGroups)
 				radioGroups = []*MenuItem{}
 			}
-			submenu := parent.AddSubMenu(menuItem.Label)
+			submenu := parent.AddSubMenu(menuItem.DisplayLabel())
 			processMenu(submenu, menuItem.SubMenu)
 		} else {
 			lastMenuItem := processMenuItem(parent, menuItem)
@@ -112,7 +112,8 @@ func processMenuItem(parent *NSMenu, menuItem *menu.MenuItem) *MenuItem {
 	if menuItem.Hidden {
 		return nil
 	}
-	if menuItem.Role != 0 {
+	// The About role is a regular menu item that shows the about dialog
+	if menuItem.Role != 0 && menuItem.Role != menu.AboutRole {
 		parent.AppendRole(menuItem.Role)
 		return nil
 	}
@@ -119,8 +119,10 @@ func (w *Window) Center() {
 	C.Center(w.context)
 }
 
-func (w *Window) Run() {
-	C.Run(w.context)
+func (w *Window) Run(url string) {
+	_url := C.CString(url)
+	C.Run(w.context, _url)
+	C.free(unsafe.Pointer(_url))
 }
 
 func (w *Window) Quit() {
@@ -137,6 +139,12 @@ func (w *Window) ExecJS(js string) {
 	C.free(unsafe.Pointer(_js))
 }
 
+func (w *Window) LoadURL(url string) {
+	_url := C.CString(url)
+	C.LoadURL(w.context, _url)
+	C.free(unsafe.Pointer(_url))
+}
+
 func (w *Window) SetPosition(x int, y int) {
 	C.SetPosition(w.context, C.int(x), C.int(y))
 }
@@ -183,14 +191,30 @@ func (w *Window) UnFullscreen() {
 	C.UnFullscreen(w.context)
 }
 
+func (w *Window) IsFullScreen() bool {
+	return C.IsFullScreen(w.context) != 0
+}
+
 func (w *Window) Show() {
 	C.Show(w.context)
 }
 
+func (w *Window) ShowNoActivate() {
+	C.ShowNoActivate(w.context)
+}
+
 func (w *Window) Hide() {
 	C.Hide(w.context)
 }
 
+func (w *Window) SetIgnoreMouseEvents(ignore bool) {
+	C.SetIgnoreMouseEvents(w.context, bool2Cint(ignore))
+}
+
+func (w *Window) SetAlwaysOnBottom(bottom bool) {
+	C.SetAlwaysOnBottom(w.context, bool2Cint(bottom))
+}
+
 func parseIntDuo(temp string) (int, int) {
 	split := strings.Split(temp, ",")
 	x, err := strconv.Atoi(split[0])
@@ -218,6 +242,7 @@ func (w *Window) Size() (int, int) {
 
 func (w *Window) SetApplicationMenu(inMenu *menu.Menu) {
 	mainMenu := NewNSMenu(w.context, "")
+	inMenu.ResolveLabels()
 	processMenu(mainMenu, inMenu)
 	C.SetAsApplicationMenu(w.context, mainMenu.nsmenu)
 }
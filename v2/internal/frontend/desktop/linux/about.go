@@ -0,0 +1,17 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import "github.com/wailsapp/wails/v2/internal/frontend"
+
+// ShowAboutDialog shows the about dialog of the application as a message dialog, so it returns the error of the
+// dialogs when they are compiled out. The GTK about dialog is planned.
+func (f *Frontend) ShowAboutDialog(info frontend.AboutInfo) error {
+	_, err := f.MessageDialog(frontend.MessageDialogOptions{
+		Type:    frontend.InfoDialog,
+		Title:   "About " + info.Name,
+		Message: info.Description(),
+	})
+	return err
+}
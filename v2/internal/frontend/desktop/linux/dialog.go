@@ -1,5 +1,5 @@
-//go:build linux
-// +build linux
+//go:build linux && !wails.nodialogs
+// +build linux,!wails.nodialogs
 
 package linux
 
@@ -0,0 +1,29 @@
+//go:build linux && wails.nodialogs
+// +build linux,wails.nodialogs
+
+package linux
+
+import (
+	"github.com/wailsapp/wails/v2/internal/features"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+func (f *Frontend) OpenDirectoryDialog(_ frontend.OpenDialogOptions) (string, error) {
+	return "", features.Disabled(features.Dialogs)
+}
+
+func (f *Frontend) OpenFileDialog(_ frontend.OpenDialogOptions) (string, error) {
+	return "", features.Disabled(features.Dialogs)
+}
+
+func (f *Frontend) OpenMultipleFilesDialog(_ frontend.OpenDialogOptions) ([]string, error) {
+	return nil, features.Disabled(features.Dialogs)
+}
+
+func (f *Frontend) SaveFileDialog(_ frontend.SaveDialogOptions) (string, error) {
+	return "", features.Disabled(features.Dialogs)
+}
+
+func (f *Frontend) MessageDialog(_ frontend.MessageDialogOptions) (string, error) {
+	return "", features.Disabled(features.Dialogs)
+}
@@ -14,10 +14,12 @@ import "C"
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"strconv"
+	"sync"
 	"text/template"
 	"unsafe"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/wailsapp/wails/v2/internal/frontend/assetserver"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/common"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/internal/startup"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
@@ -47,6 +50,14 @@ type Frontend struct {
 	bindings        *binding.Bindings
 	dispatcher      frontend.Dispatcher
 	servingFromDisk bool
+
+	// The cursor set with the runtime, which is applied to the page with a stylesheet
+	cursor common.CSSCursor
+
+	// Set while in presentation mode, with whether the window was already fullscreen
+	presentationLock          sync.Mutex
+	presentationMode          bool
+	presentationWasFullscreen bool
 }
 
 func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.Logger, appBindings *binding.Bindings, dispatcher frontend.Dispatcher) *Frontend {
@@ -71,7 +82,8 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 	_devServerURL := ctx.Value("devserverurl")
 	if _devServerURL != nil {
 		devServerURL := _devServerURL.(string)
-		if len(devServerURL) > 0 && devServerURL != "http://localhost:34115" {
+		devServer, _ := ctx.Value("devserver").(string)
+		if len(devServerURL) > 0 && devServerURL != "http://"+devServer {
 			result.startURL = devServerURL
 			return result
 		}
@@ -87,11 +99,12 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		result.servingFromDisk = true
 	}
 
-	assets, err := assetserver.NewDesktopAssetServer(ctx, appoptions.Assets, bindingsJSON)
+	assets, err := assetserver.NewDesktopAssetServer(ctx, appoptions, bindingsJSON)
 	if err != nil {
 		log.Fatal(err)
 	}
 	result.assets = assets
+	result.startURL = "wails://" + assets.BasePath()
 
 	go result.startMessageProcessor()
 	go result.startRequestProcessor()
@@ -103,6 +116,7 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		result.debug = _debug.(bool)
 	}
 	result.mainWindow = NewWindow(appoptions, result.debug)
+	startup.Record(ctx, startup.WindowCreated)
 
 	return result
 }
@@ -117,6 +131,15 @@ func (f *Frontend) WindowReload() {
 	f.ExecJS("runtime.WindowReload();")
 }
 
+func (f *Frontend) WindowLoadURL(url string) error {
+	target, err := frontend.ResolveURL(f.startURL, url, f.frontendOptions.RestrictWindowLoadURL)
+	if err != nil {
+		return err
+	}
+	f.mainWindow.LoadURL(target)
+	return nil
+}
+
 func (f *Frontend) Run(ctx context.Context) error {
 
 	f.ctx = context.WithValue(ctx, "frontend", f)
@@ -127,7 +150,7 @@ func (f *Frontend) Run(ctx context.Context) error {
 		}
 	}()
 
-	f.mainWindow.Run()
+	f.mainWindow.Run(f.startURL)
 
 	return nil
 }
@@ -167,6 +190,10 @@ func (f *Frontend) WindowShow() {
 	f.mainWindow.Show()
 }
 
+func (f *Frontend) WindowShowNoActivate() {
+	f.mainWindow.ShowNoActivate()
+}
+
 func (f *Frontend) WindowHide() {
 	f.mainWindow.Hide()
 }
@@ -190,6 +217,83 @@ func (f *Frontend) WindowSetMaxSize(width int, height int) {
 	f.mainWindow.SetMaxSize(width, height)
 }
 
+func (f *Frontend) WindowSetIgnoreMouseEvents(ignore bool) {
+	f.mainWindow.SetIgnoreMouseEvents(ignore)
+}
+
+func (f *Frontend) WindowSetAlwaysOnBottom(bottom bool) {
+	f.mainWindow.SetAlwaysOnBottom(bottom)
+}
+
+func (f *Frontend) WindowSetEnabled(enabled bool) {
+	f.mainWindow.SetEnabled(enabled)
+}
+
+func (f *Frontend) WindowIsEnabled() bool {
+	return f.mainWindow.IsEnabled()
+}
+
+// WindowEnterPresentationMode makes the window fullscreen and hides the cursor while it is over the window.
+// Keeping the display on isn't supported on Linux.
+func (f *Frontend) WindowEnterPresentationMode() {
+	f.presentationLock.Lock()
+	defer f.presentationLock.Unlock()
+	if f.presentationMode {
+		return
+	}
+	f.presentationMode = true
+	f.presentationWasFullscreen = f.mainWindow.IsFullScreen()
+	if !f.presentationWasFullscreen {
+		f.mainWindow.Fullscreen()
+	}
+	f.ExecJS(f.cursor.SetPresentationHidden(true))
+}
+
+func (f *Frontend) WindowExitPresentationMode() {
+	f.presentationLock.Lock()
+	defer f.presentationLock.Unlock()
+	if !f.presentationMode {
+		return
+	}
+	f.presentationMode = false
+	if !f.presentationWasFullscreen {
+		f.mainWindow.UnFullscreen()
+	}
+	f.ExecJS(f.cursor.SetPresentationHidden(false))
+}
+
+func (f *Frontend) WindowSetCursor(cursor string) {
+	f.ExecJS(f.cursor.SetCursor(cursor))
+}
+
+func (f *Frontend) WindowSetCustomCursor(image []byte, hotspotX int, hotspotY int) {
+	f.ExecJS(f.cursor.SetCustomCursor(image, hotspotX, hotspotY))
+}
+
+func (f *Frontend) WindowHideCursor() {
+	f.ExecJS(f.cursor.SetHidden(true))
+}
+
+func (f *Frontend) WindowShowCursor() {
+	f.ExecJS(f.cursor.SetHidden(false))
+}
+
+func (f *Frontend) WindowSetInputEvents(events options.InputEvents) {
+	f.ExecJS(common.PageInputEventsScript(events))
+}
+
+func (f *Frontend) WindowSetThumbnailButtons(_ []frontend.ThumbnailButton) error {
+	return errors.New("thumbnail buttons are only supported on Windows")
+}
+
+func (f *Frontend) DownloadCancel(_ string) error {
+	return errors.New("download events are only supported on Windows")
+}
+
+func (f *Frontend) StartDrag(_ []string) error {
+	return errors.New("dragging files from the window is only supported on Windows")
+}
+
 func (f *Frontend) WindowSetRGBA(col *options.RGBA) {
 	if col == nil {
 		return
@@ -223,6 +327,22 @@ func (f *Frontend) Notify(name string, data ...interface{}) {
 }
 
 func (f *Frontend) processMessage(message string) {
+	// The webview only starts its web process when the first page is loaded
+	if message == "WebviewReady" {
+		startup.Record(f.ctx, startup.WebviewReady)
+		return
+	}
+
+	if message == "DomReady" {
+		startup.Record(f.ctx, startup.DomReady)
+		// The stylesheet setting the cursor is lost when the page is reloaded
+		f.ExecJS(f.cursor.Script())
+		if f.frontendOptions.OnDomReady != nil {
+			go f.frontendOptions.OnDomReady(f.ctx)
+		}
+		return
+	}
+
 	if message == "drag" {
 		if !f.mainWindow.IsFullScreen() {
 			f.startDrag()
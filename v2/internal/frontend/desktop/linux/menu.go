@@ -72,6 +72,7 @@ func (w *Window) SetApplicationMenu(inmenu *menu.Menu) {
 	// Increase ref count?
 	w.menubar = C.gtk_menu_bar_new()
 
+	inmenu.ResolveLabels()
 	processMenu(w, inmenu)
 
 	C.gtk_widget_show(w.menubar)
@@ -90,7 +91,7 @@ func processSubmenu(menuItem *menu.MenuItem, group *C.GtkAccelGroup) *C.GtkWidge
 		return existingMenu
 	}
 	gtkMenu := C.gtk_menu_new()
-	submenu := GtkMenuItemWithLabel(menuItem.Label)
+	submenu := GtkMenuItemWithLabel(menuItem.DisplayLabel())
 	for _, menuItem := range menuItem.SubMenu.Items {
 		menuID := menuIdCounter
 		menuIdToItem[menuID] = menuItem
@@ -124,16 +125,16 @@ func processMenuItem(parent *C.GtkWidget, menuItem *menu.MenuItem, group *C.GtkA
 
 	switch menuItem.Type {
 	case menu.TextType:
-		result = GtkMenuItemWithLabel(menuItem.Label)
+		result = GtkMenuItemWithLabel(menuItem.DisplayLabel())
 	case menu.CheckboxType:
-		result = GtkCheckMenuItemWithLabel(menuItem.Label)
+		result = GtkCheckMenuItemWithLabel(menuItem.DisplayLabel())
 		if menuItem.Checked {
 			C.gtk_check_menu_item_set_active(C.toGtkCheckMenuItem(unsafe.Pointer(result)), 1)
 		}
 		gtkCheckboxCache[menuItem] = append(gtkCheckboxCache[menuItem], result)
 
 	case menu.RadioType:
-		result = GtkRadioMenuItemWithLabel(menuItem.Label, currentRadioGroup)
+		result = GtkRadioMenuItemWithLabel(menuItem.DisplayLabel(), currentRadioGroup)
 		currentRadioGroup = C.gtk_radio_menu_item_get_group(C.toGtkRadioMenuItem(unsafe.Pointer(result)))
 		if menuItem.Checked {
 			C.gtk_check_menu_item_set_active(C.toGtkCheckMenuItem(unsafe.Pointer(result)), 1)
@@ -151,6 +151,21 @@ void connectButtons(void* webview) {
 
 extern void processURLRequest(void *request);
 
+// This is called as the webview loads a page
+void loadChanged(WebKitWebView *webview, WebKitLoadEvent event, void* data)
+{
+	switch (event) {
+	case WEBKIT_LOAD_COMMITTED:
+		processMessage("WebviewReady");
+		break;
+	case WEBKIT_LOAD_FINISHED:
+		processMessage("DomReady");
+		break;
+	default:
+		break;
+	}
+}
+
 // This is called when the close button on the window is pressed
 gboolean close_button_pressed(GtkWidget *widget, GdkEvent *event, void* data)
 {
@@ -163,7 +178,7 @@ GtkWidget* setupWebview(void* contentManager, GtkWindow* window, int hideWindowO
 	//gtk_container_add(GTK_CONTAINER(window), webview);
 	WebKitWebContext *context = webkit_web_context_get_default();
 	webkit_web_context_register_uri_scheme(context, "wails", (WebKitURISchemeRequestCallback)processURLRequest, NULL, NULL);
-	//g_signal_connect(G_OBJECT(webview), "load-changed", G_CALLBACK(webview_load_changed_cb), NULL);
+	g_signal_connect(G_OBJECT(webview), "load-changed", G_CALLBACK(loadChanged), NULL);
 	if (hideWindowOnClose) {
 		g_signal_connect(GTK_WIDGET(window), "delete-event", G_CALLBACK(gtk_widget_hide_on_delete), NULL);
 	} else {
@@ -178,8 +193,8 @@ void devtoolsEnabled(void* webview, int enabled) {
 	webkit_settings_set_enable_developer_extras(settings, genabled);
 }
 
-void loadIndex(void* webview) {
-	webkit_web_view_load_uri(WEBKIT_WEB_VIEW(webview), "wails:///");
+void loadIndex(void* webview, char* url) {
+	webkit_web_view_load_uri(WEBKIT_WEB_VIEW(webview), url);
 }
 
 typedef struct DragOptions {
@@ -218,172 +233,6 @@ int executeJS(gpointer data) {
     return G_SOURCE_REMOVE;
 }
 
-void extern processMessageDialogResult(char*);
-
-typedef struct MessageDialogOptions {
-	void* window;
-	char* title;
-	char* message;
-	int messageType;
-} MessageDialogOptions;
-
-void messageDialog(gpointer data) {
-
-	GtkDialogFlags flags;
-	GtkMessageType messageType;
-	MessageDialogOptions *options = (MessageDialogOptions*) data;
-	if( options->messageType == 0 ) {
-		messageType = GTK_MESSAGE_INFO;
-		flags = GTK_BUTTONS_OK;
-	} else if( options->messageType == 1 ) {
-		messageType = GTK_MESSAGE_ERROR;
-		flags = GTK_BUTTONS_OK;
-	} else if( options->messageType == 2 ) {
-		messageType = GTK_MESSAGE_QUESTION;
-		flags = GTK_BUTTONS_YES_NO;
-	} else {
-		messageType = GTK_MESSAGE_WARNING;
-		flags = GTK_BUTTONS_OK;
-	}
-
-	GtkWidget *dialog;
-	dialog = gtk_message_dialog_new(GTK_WINDOW(options->window),
-			GTK_DIALOG_DESTROY_WITH_PARENT,
-			messageType,
-			flags,
-			options->message, NULL);
-	gtk_window_set_title(GTK_WINDOW(dialog), options->title);
-	GtkResponseType result = gtk_dialog_run(GTK_DIALOG(dialog));
-	if ( result == GTK_RESPONSE_YES ) {
-		processMessageDialogResult("Yes");
-	} else if ( result == GTK_RESPONSE_NO ) {
-		processMessageDialogResult("No");
-	} else if ( result == GTK_RESPONSE_OK ) {
-		processMessageDialogResult("OK");
-	} else if ( result == GTK_RESPONSE_CANCEL ) {
-		processMessageDialogResult("Cancel");
-	} else {
-		processMessageDialogResult("");
-	}
-
-	gtk_widget_destroy(dialog);
-	free(options->title);
-	free(options->message);
-}
-
-void extern processOpenFileResult(void*);
-
-typedef struct OpenFileDialogOptions {
-    void* webview;
-    char* title;
-	char* defaultFilename;
-	char* defaultDirectory;
-	int createDirectories;
-	int multipleFiles;
-	int showHiddenFiles;
- 	GtkFileChooserAction action;
-	GtkFileFilter** filters;
-} OpenFileDialogOptions;
-
-GtkFileFilter** allocFileFilterArray(size_t ln) {
-	return (GtkFileFilter**) malloc(ln * sizeof(GtkFileFilter*));
-}
-
-void freeFileFilterArray(GtkFileFilter** filters) {
-	free(filters);
-}
-
-int opendialog(gpointer data) {
-    struct OpenFileDialogOptions *options = data;
-	char *label = "_Open";
-	if (options->action == GTK_FILE_CHOOSER_ACTION_SAVE) {
-		label = "_Save";
-	}
-    GtkWidget *dlgWidget = gtk_file_chooser_dialog_new(options->title, options->webview, options->action,
-          "_Cancel", GTK_RESPONSE_CANCEL,
-          label, GTK_RESPONSE_ACCEPT,
-			NULL);
-
-	GtkFileChooser *fc = GTK_FILE_CHOOSER(dlgWidget);
-	// filters
-	if (options->filters != 0) {
-		int index = 0;
-		GtkFileFilter* thisFilter;
-		while(options->filters[index] != NULL) {
-			thisFilter = options->filters[index];
-			gtk_file_chooser_add_filter(fc, thisFilter);
-			index++;
-		}
-	}
-
-	gtk_file_chooser_set_local_only(fc, FALSE);
-
-	if (options->multipleFiles == 1) {
-		gtk_file_chooser_set_select_multiple(fc, TRUE);
-	}
-	gtk_file_chooser_set_do_overwrite_confirmation(fc, TRUE);
-	if (options->createDirectories == 1) {
-		gtk_file_chooser_set_create_folders(fc, TRUE);
-	}
-	if (options->showHiddenFiles == 1) {
-		gtk_file_chooser_set_show_hidden(fc, TRUE);
-	}
-
-	if (options->defaultDirectory != NULL) {
-		gtk_file_chooser_set_current_folder (fc, options->defaultDirectory);
-		free(options->defaultDirectory);
-	}
-
-	if (options->action == GTK_FILE_CHOOSER_ACTION_SAVE) {
-		if (options->defaultFilename != NULL) {
-			gtk_file_chooser_set_current_name(fc, options->defaultFilename);
-			free(options->defaultFilename);
-		}
-	}
-
-	gint response = gtk_dialog_run(GTK_DIALOG(dlgWidget));
-
-	// Max 1024 files to select
-	char** result = calloc(1024, sizeof(char*));
-	int resultIndex = 0;
-
-    if (response == GTK_RESPONSE_ACCEPT) {
-        GSList* filenames = gtk_file_chooser_get_filenames(fc);
-		GSList *iter = filenames;
-		while(iter) {
-		  	result[resultIndex++] = (char *)iter->data;
-		  	iter = g_slist_next(iter);
-          	if (resultIndex == 1024) {
-				break;
-			}
-		}
-		processOpenFileResult(result);
-		iter = filenames;
-		while(iter) {
-		  g_free(iter->data);
-		  iter = g_slist_next(iter);
-		}
-    } else {
-		processOpenFileResult(result);
-	}
-	free(result);
-
-	// Release filters
-	if (options->filters != NULL) {
-		int index = 0;
-		GtkFileFilter* thisFilter;
-		while(options->filters[index] != 0) {
-			thisFilter = options->filters[index];
-			g_object_unref(thisFilter);
-			index++;
-		}
-		freeFileFilterArray(options->filters);
-	}
-    gtk_widget_destroy(dlgWidget);
-    free(options->title);
-    return G_SOURCE_REMOVE;
-}
-
 GtkFileFilter* newFileFilter() {
 	GtkFileFilter* result = gtk_file_filter_new();
 	g_object_ref(result);
@@ -423,6 +272,25 @@ void SetTitle(GtkWindow* window, char* title) {
 	ExecuteOnMainThread(setTitle, (gpointer)args);
 }
 
+typedef struct LoadURLArgs {
+	WebKitWebView* webview;
+	char* url;
+} LoadURLArgs;
+
+void loadURL(gpointer data) {
+	LoadURLArgs* args = (LoadURLArgs*)data;
+	webkit_web_view_load_uri(args->webview, args->url);
+	free((void*)args->url);
+	free((void*)data);
+}
+
+void LoadURL(void* webview, char* url) {
+	LoadURLArgs* args = malloc(sizeof(LoadURLArgs));
+	args->webview = WEBKIT_WEB_VIEW(webview);
+	args->url = url;
+	ExecuteOnMainThread(loadURL, (gpointer)args);
+}
+
 typedef struct SetPositionArgs {
 	int x;
 	int y;
@@ -448,6 +316,13 @@ void Show(gpointer data) {
 	gtk_widget_show((GtkWidget*)data);
 }
 
+void ShowNoActivate(gpointer data) {
+	// The window is only focused when it is mapped, which happens when it is shown
+	gtk_window_set_focus_on_map((GtkWindow*)data, FALSE);
+	gtk_widget_show((GtkWidget*)data);
+	gtk_window_set_focus_on_map((GtkWindow*)data, TRUE);
+}
+
 void Hide(gpointer data) {
 	gtk_widget_hide((GtkWidget*)data);
 }
@@ -468,6 +343,33 @@ void UnMinimise(gpointer data) {
 	gtk_window_present((GtkWindow*)data);
 }
 
+void IgnoreMouseEvents(gpointer data) {
+	// An empty input shape passes all mouse events to the windows beneath
+	cairo_region_t *region = cairo_region_create();
+	gtk_widget_input_shape_combine_region((GtkWidget*)data, region);
+	cairo_region_destroy(region);
+}
+
+void AcceptMouseEvents(gpointer data) {
+	gtk_widget_input_shape_combine_region((GtkWidget*)data, NULL);
+}
+
+void Enable(gpointer data) {
+	gtk_widget_set_sensitive((GtkWidget*)data, TRUE);
+}
+
+void Disable(gpointer data) {
+	gtk_widget_set_sensitive((GtkWidget*)data, FALSE);
+}
+
+void KeepBelow(gpointer data) {
+	gtk_window_set_keep_below((GtkWindow*)data, TRUE);
+}
+
+void UnKeepBelow(gpointer data) {
+	gtk_window_set_keep_below((GtkWindow*)data, FALSE);
+}
+
 bool disableContextMenu(GtkWindow* window) {
 	return TRUE;
 }
@@ -480,10 +382,8 @@ void DisableContextMenu(void* webview) {
 */
 import "C"
 import (
-	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 	"github.com/wailsapp/wails/v2/pkg/options"
-	"strings"
 	"unsafe"
 )
 
@@ -644,10 +544,43 @@ func (w *Window) Show() {
 	C.ExecuteOnMainThread(C.Show, C.gpointer(w.asGTKWindow()))
 }
 
+func (w *Window) ShowNoActivate() {
+	C.ExecuteOnMainThread(C.ShowNoActivate, C.gpointer(w.asGTKWindow()))
+}
+
 func (w *Window) Hide() {
 	C.ExecuteOnMainThread(C.Hide, C.gpointer(w.asGTKWindow()))
 }
 
+func (w *Window) SetIgnoreMouseEvents(ignore bool) {
+	if ignore {
+		C.ExecuteOnMainThread(C.IgnoreMouseEvents, C.gpointer(w.asGTKWindow()))
+	} else {
+		C.ExecuteOnMainThread(C.AcceptMouseEvents, C.gpointer(w.asGTKWindow()))
+	}
+}
+
+func (w *Window) SetAlwaysOnBottom(bottom bool) {
+	if bottom {
+		C.ExecuteOnMainThread(C.KeepBelow, C.gpointer(w.asGTKWindow()))
+	} else {
+		C.ExecuteOnMainThread(C.UnKeepBelow, C.gpointer(w.asGTKWindow()))
+	}
+}
+
+// SetEnabled enables or disables mouse and keyboard input to the window and the webview
+func (w *Window) SetEnabled(enabled bool) {
+	if enabled {
+		C.ExecuteOnMainThread(C.Enable, C.gpointer(w.asGTKWindow()))
+	} else {
+		C.ExecuteOnMainThread(C.Disable, C.gpointer(w.asGTKWindow()))
+	}
+}
+
+func (w *Window) IsEnabled() bool {
+	return C.gtk_widget_get_sensitive(w.asGTKWidget()) != 0
+}
+
 func (w *Window) Maximise() {
 	C.ExecuteOnMainThread(C.Maximise, C.gpointer(w.asGTKWindow()))
 }
@@ -684,10 +617,12 @@ func (w *Window) SetRGBA(r uint8, g uint8, b uint8, a uint8) {
 
 }
 
-func (w *Window) Run() {
+func (w *Window) Run(url string) {
 	C.gtk_box_pack_start(C.GTKBOX(unsafe.Pointer(w.vbox)), w.menubar, 0, 0, 0)
 	C.gtk_box_pack_start(C.GTKBOX(unsafe.Pointer(w.vbox)), C.GTKWIDGET(w.webview), 1, 1, 0)
-	C.loadIndex(w.webview)
+	_url := C.CString(url)
+	C.loadIndex(w.webview, _url)
+	C.free(unsafe.Pointer(_url))
 	C.gtk_widget_show_all(w.asGTKWidget())
 	w.Center()
 	switch w.appoptions.WindowStartState {
@@ -723,6 +658,10 @@ func (w *Window) SetTitle(title string) {
 	C.SetTitle(w.asGTKWindow(), C.CString(title))
 }
 
+func (w *Window) LoadURL(url string) {
+	C.LoadURL(w.webview, C.CString(url))
+}
+
 func (w *Window) ExecJS(js string) {
 	jscallback := C.JSCallback{
 		webview: w.webview,
@@ -738,77 +677,3 @@ func (w *Window) StartDrag() {
 func (w *Window) Quit() {
 	C.gtk_main_quit()
 }
-
-func (w *Window) OpenFileDialog(dialogOptions frontend.OpenDialogOptions, multipleFiles int, action C.GtkFileChooserAction) {
-
-	data := C.OpenFileDialogOptions{
-		webview:       w.webview,
-		title:         C.CString(dialogOptions.Title),
-		multipleFiles: C.int(multipleFiles),
-		action:        action,
-	}
-
-	if len(dialogOptions.Filters) > 0 {
-		// Create filter array
-		mem := NewCalloc()
-		arraySize := len(dialogOptions.Filters) + 1
-		data.filters = C.allocFileFilterArray((C.ulong)(arraySize))
-		filters := (*[1 << 30]*C.struct__GtkFileFilter)(unsafe.Pointer(data.filters))
-		for index, filter := range dialogOptions.Filters {
-			thisFilter := C.gtk_file_filter_new()
-			C.g_object_ref(C.gpointer(thisFilter))
-			if filter.DisplayName != "" {
-				cName := mem.String(filter.DisplayName)
-				C.gtk_file_filter_set_name(thisFilter, cName)
-			}
-			if filter.Pattern != "" {
-				for _, thisPattern := range strings.Split(filter.Pattern, ";") {
-					cThisPattern := mem.String(thisPattern)
-					C.gtk_file_filter_add_pattern(thisFilter, cThisPattern)
-				}
-			}
-			// Add filter to array
-			filters[index] = thisFilter
-		}
-		mem.Free()
-		filters[arraySize-1] = nil
-	}
-
-	if dialogOptions.CanCreateDirectories {
-		data.createDirectories = C.int(1)
-	}
-
-	if dialogOptions.ShowHiddenFiles {
-		data.showHiddenFiles = C.int(1)
-	}
-
-	if dialogOptions.DefaultFilename != "" {
-		data.defaultFilename = C.CString(dialogOptions.DefaultFilename)
-	}
-
-	if dialogOptions.DefaultDirectory != "" {
-		data.defaultDirectory = C.CString(dialogOptions.DefaultDirectory)
-	}
-
-	C.ExecuteOnMainThread(C.opendialog, C.gpointer(&data))
-}
-
-func (w *Window) MessageDialog(dialogOptions frontend.MessageDialogOptions) {
-
-	data := C.MessageDialogOptions{
-		window:  w.gtkWindow,
-		title:   C.CString(dialogOptions.Title),
-		message: C.CString(dialogOptions.Message),
-	}
-	switch dialogOptions.Type {
-	case frontend.InfoDialog:
-		data.messageType = C.int(0)
-	case frontend.ErrorDialog:
-		data.messageType = C.int(1)
-	case frontend.QuestionDialog:
-		data.messageType = C.int(2)
-	case frontend.WarningDialog:
-		data.messageType = C.int(3)
-	}
-	C.ExecuteOnMainThread(C.messageDialog, C.gpointer(&data))
-}
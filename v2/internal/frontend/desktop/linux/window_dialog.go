@@ -0,0 +1,257 @@
+//go:build linux && !wails.nodialogs
+// +build linux,!wails.nodialogs
+
+package linux
+
+/*
+#include "gtk/gtk.h"
+#include <stdlib.h>
+
+void ExecuteOnMainThread(void* f, gpointer jscallback);
+
+void extern processMessageDialogResult(char*);
+
+typedef struct MessageDialogOptions {
+	void* window;
+	char* title;
+	char* message;
+	int messageType;
+} MessageDialogOptions;
+
+void messageDialog(gpointer data) {
+
+	GtkDialogFlags flags;
+	GtkMessageType messageType;
+	MessageDialogOptions *options = (MessageDialogOptions*) data;
+	if( options->messageType == 0 ) {
+		messageType = GTK_MESSAGE_INFO;
+		flags = GTK_BUTTONS_OK;
+	} else if( options->messageType == 1 ) {
+		messageType = GTK_MESSAGE_ERROR;
+		flags = GTK_BUTTONS_OK;
+	} else if( options->messageType == 2 ) {
+		messageType = GTK_MESSAGE_QUESTION;
+		flags = GTK_BUTTONS_YES_NO;
+	} else {
+		messageType = GTK_MESSAGE_WARNING;
+		flags = GTK_BUTTONS_OK;
+	}
+
+	GtkWidget *dialog;
+	dialog = gtk_message_dialog_new(GTK_WINDOW(options->window),
+			GTK_DIALOG_DESTROY_WITH_PARENT,
+			messageType,
+			flags,
+			options->message, NULL);
+	gtk_window_set_title(GTK_WINDOW(dialog), options->title);
+	GtkResponseType result = gtk_dialog_run(GTK_DIALOG(dialog));
+	if ( result == GTK_RESPONSE_YES ) {
+		processMessageDialogResult("Yes");
+	} else if ( result == GTK_RESPONSE_NO ) {
+		processMessageDialogResult("No");
+	} else if ( result == GTK_RESPONSE_OK ) {
+		processMessageDialogResult("OK");
+	} else if ( result == GTK_RESPONSE_CANCEL ) {
+		processMessageDialogResult("Cancel");
+	} else {
+		processMessageDialogResult("");
+	}
+
+	gtk_widget_destroy(dialog);
+	free(options->title);
+	free(options->message);
+}
+
+void extern processOpenFileResult(void*);
+
+typedef struct OpenFileDialogOptions {
+    void* webview;
+    char* title;
+	char* defaultFilename;
+	char* defaultDirectory;
+	int createDirectories;
+	int multipleFiles;
+	int showHiddenFiles;
+ 	GtkFileChooserAction action;
+	GtkFileFilter** filters;
+} OpenFileDialogOptions;
+
+GtkFileFilter** allocFileFilterArray(size_t ln) {
+	return (GtkFileFilter**) malloc(ln * sizeof(GtkFileFilter*));
+}
+
+void freeFileFilterArray(GtkFileFilter** filters) {
+	free(filters);
+}
+
+int opendialog(gpointer data) {
+    struct OpenFileDialogOptions *options = data;
+	char *label = "_Open";
+	if (options->action == GTK_FILE_CHOOSER_ACTION_SAVE) {
+		label = "_Save";
+	}
+    GtkWidget *dlgWidget = gtk_file_chooser_dialog_new(options->title, options->webview, options->action,
+          "_Cancel", GTK_RESPONSE_CANCEL,
+          label, GTK_RESPONSE_ACCEPT,
+			NULL);
+
+	GtkFileChooser *fc = GTK_FILE_CHOOSER(dlgWidget);
+	// filters
+	if (options->filters != 0) {
+		int index = 0;
+		GtkFileFilter* thisFilter;
+		while(options->filters[index] != NULL) {
+			thisFilter = options->filters[index];
+			gtk_file_chooser_add_filter(fc, thisFilter);
+			index++;
+		}
+	}
+
+	gtk_file_chooser_set_local_only(fc, FALSE);
+
+	if (options->multipleFiles == 1) {
+		gtk_file_chooser_set_select_multiple(fc, TRUE);
+	}
+	gtk_file_chooser_set_do_overwrite_confirmation(fc, TRUE);
+	if (options->createDirectories == 1) {
+		gtk_file_chooser_set_create_folders(fc, TRUE);
+	}
+	if (options->showHiddenFiles == 1) {
+		gtk_file_chooser_set_show_hidden(fc, TRUE);
+	}
+
+	if (options->defaultDirectory != NULL) {
+		gtk_file_chooser_set_current_folder (fc, options->defaultDirectory);
+		free(options->defaultDirectory);
+	}
+
+	if (options->action == GTK_FILE_CHOOSER_ACTION_SAVE) {
+		if (options->defaultFilename != NULL) {
+			gtk_file_chooser_set_current_name(fc, options->defaultFilename);
+			free(options->defaultFilename);
+		}
+	}
+
+	gint response = gtk_dialog_run(GTK_DIALOG(dlgWidget));
+
+	// Max 1024 files to select
+	char** result = calloc(1024, sizeof(char*));
+	int resultIndex = 0;
+
+    if (response == GTK_RESPONSE_ACCEPT) {
+        GSList* filenames = gtk_file_chooser_get_filenames(fc);
+		GSList *iter = filenames;
+		while(iter) {
+		  	result[resultIndex++] = (char *)iter->data;
+		  	iter = g_slist_next(iter);
+          	if (resultIndex == 1024) {
+				break;
+			}
+		}
+		processOpenFileResult(result);
+		iter = filenames;
+		while(iter) {
+		  g_free(iter->data);
+		  iter = g_slist_next(iter);
+		}
+    } else {
+		processOpenFileResult(result);
+	}
+	free(result);
+
+	// Release filters
+	if (options->filters != NULL) {
+		int index = 0;
+		GtkFileFilter* thisFilter;
+		while(options->filters[index] != 0) {
+			thisFilter = options->filters[index];
+			g_object_unref(thisFilter);
+			index++;
+		}
+		freeFileFilterArray(options->filters);
+	}
+    gtk_widget_destroy(dlgWidget);
+    free(options->title);
+    return G_SOURCE_REMOVE;
+}
+*/
+import "C"
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"strings"
+	"unsafe"
+)
+
+func (w *Window) OpenFileDialog(dialogOptions frontend.OpenDialogOptions, multipleFiles int, action C.GtkFileChooserAction) {
+
+	data := C.OpenFileDialogOptions{
+		webview:       w.webview,
+		title:         C.CString(dialogOptions.Title),
+		multipleFiles: C.int(multipleFiles),
+		action:        action,
+	}
+
+	if len(dialogOptions.Filters) > 0 {
+		// Create filter array
+		mem := NewCalloc()
+		arraySize := len(dialogOptions.Filters) + 1
+		data.filters = C.allocFileFilterArray((C.ulong)(arraySize))
+		filters := (*[1 << 30]*C.struct__GtkFileFilter)(unsafe.Pointer(data.filters))
+		for index, filter := range dialogOptions.Filters {
+			thisFilter := C.gtk_file_filter_new()
+			C.g_object_ref(C.gpointer(thisFilter))
+			if filter.DisplayName != "" {
+				cName := mem.String(filter.DisplayName)
+				C.gtk_file_filter_set_name(thisFilter, cName)
+			}
+			if filter.Pattern != "" {
+				for _, thisPattern := range strings.Split(filter.Pattern, ";") {
+					cThisPattern := mem.String(thisPattern)
+					C.gtk_file_filter_add_pattern(thisFilter, cThisPattern)
+				}
+			}
+			// Add filter to array
+			filters[index] = thisFilter
+		}
+		mem.Free()
+		filters[arraySize-1] = nil
+	}
+
+	if dialogOptions.CanCreateDirectories {
+		data.createDirectories = C.int(1)
+	}
+
+	if dialogOptions.ShowHiddenFiles {
+		data.showHiddenFiles = C.int(1)
+	}
+
+	if dialogOptions.DefaultFilename != "" {
+		data.defaultFilename = C.CString(dialogOptions.DefaultFilename)
+	}
+
+	if dialogOptions.DefaultDirectory != "" {
+		data.defaultDirectory = C.CString(dialogOptions.DefaultDirectory)
+	}
+
+	C.ExecuteOnMainThread(C.opendialog, C.gpointer(&data))
+}
+
+func (w *Window) MessageDialog(dialogOptions frontend.MessageDialogOptions) {
+
+	data := C.MessageDialogOptions{
+		window:  w.gtkWindow,
+		title:   C.CString(dialogOptions.Title),
+		message: C.CString(dialogOptions.Message),
+	}
+	switch dialogOptions.Type {
+	case frontend.InfoDialog:
+		data.messageType = C.int(0)
+	case frontend.ErrorDialog:
+		data.messageType = C.int(1)
+	case frontend.QuestionDialog:
+		data.messageType = C.int(2)
+	case frontend.WarningDialog:
+		data.messageType = C.int(3)
+	}
+	C.ExecuteOnMainThread(C.messageDialog, C.gpointer(&data))
+}
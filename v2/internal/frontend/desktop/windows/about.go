@@ -0,0 +1,77 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modcomctl32    = syscall.NewLazyDLL("comctl32.dll")
+	procTaskDialog = modcomctl32.NewProc("TaskDialog")
+)
+
+const (
+	_TDCBF_OK_BUTTON = 0x0001
+	// MAKEINTRESOURCE(-3)
+	_TD_INFORMATION_ICON = 0xFFFD
+	// The ID of the application icon, set by `wails build`
+	appIconResourceID = 3
+)
+
+// ShowAboutDialog shows the about dialog of the application as a TaskDialog with the application icon
+func (f *Frontend) ShowAboutDialog(info frontend.AboutInfo) error {
+	// TaskDialog requires version 6 of the common controls, enabled by the application manifest
+	if procTaskDialog.Find() != nil {
+		_, err := f.MessageDialog(frontend.MessageDialogOptions{
+			Type:    frontend.InfoDialog,
+			Title:   "About " + info.Name,
+			Message: info.Description(),
+		})
+		return err
+	}
+
+	title, err := syscall.UTF16PtrFromString("About " + info.Name)
+	if err != nil {
+		return err
+	}
+	instruction, err := syscall.UTF16PtrFromString(info.Name)
+	if err != nil {
+		return err
+	}
+	content, err := syscall.UTF16PtrFromString(info.Description())
+	if err != nil {
+		return err
+	}
+
+	// Use the application icon if the executable has one
+	var instance, module windows.Handle
+	var icon uintptr = _TD_INFORMATION_ICON
+	if windows.GetModuleHandleEx(0, nil, &module) == nil {
+		if _, err := windows.FindResource(module, windows.ResourceID(appIconResourceID), windows.RT_GROUP_ICON); err == nil {
+			instance = module
+			icon = appIconResourceID
+		}
+	}
+
+	var button int32
+	hr, _, _ := procTaskDialog.Call(
+		uintptr(f.mainWindow.Handle()),
+		uintptr(instance),
+		uintptr(unsafe.Pointer(title)),
+		uintptr(unsafe.Pointer(instruction)),
+		uintptr(unsafe.Pointer(content)),
+		_TDCBF_OK_BUTTON,
+		icon,
+		uintptr(unsafe.Pointer(&button)),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
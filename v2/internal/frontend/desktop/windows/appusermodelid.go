@@ -0,0 +1,78 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+var (
+	modshell32                                  = syscall.NewLazyDLL("shell32.dll")
+	procSetCurrentProcessExplicitAppUserModelID = modshell32.NewProc("SetCurrentProcessExplicitAppUserModelID")
+)
+
+// The maximum length of an AppUserModelID
+const maxAppUserModelIDLength = 128
+
+// setAppUserModelID sets the AppUserModelID of the process, which Windows uses to group the taskbar
+// buttons, pin the application and attribute its notifications and jump lists.
+// It must be set before the first window is shown.
+func (f *Frontend) setAppUserModelID() {
+	var id string
+	if f.frontendOptions.Windows != nil {
+		id = f.frontendOptions.Windows.AppUserModelID
+	}
+	if id == "" {
+		id, _ = f.ctx.Value("identifier").(string)
+	}
+	if id == "" {
+		info, _ := f.ctx.Value("aboutinfo").(frontend.AboutInfo)
+		id = defaultAppUserModelID(info.Name)
+	}
+	if id == "" {
+		return
+	}
+
+	idPtr, err := syscall.UTF16PtrFromString(id)
+	if err != nil {
+		f.logger.Error("Invalid AppUserModelID '%s': %s", id, err.Error())
+		return
+	}
+	hr, _, _ := procSetCurrentProcessExplicitAppUserModelID.Call(uintptr(unsafe.Pointer(idPtr)))
+	if hr != 0 {
+		f.logger.Error("Unable to set the AppUserModelID '%s': %s", id, syscall.Errno(hr).Error())
+	}
+}
+
+// defaultAppUserModelID derives an AppUserModelID from the application name, or the name of the
+// executable if it isn't known. AppUserModelIDs can't contain spaces and are limited to 128 characters.
+func defaultAppUserModelID(name string) string {
+	if name == "" {
+		exe, err := os.Executable()
+		if err != nil {
+			return ""
+		}
+		name = strings.TrimSuffix(filepath.Base(exe), filepath.Ext(exe))
+	}
+
+	id := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		default:
+			return -1
+		}
+	}, name)
+	id = strings.Trim(id, ".")
+	if len(id) > maxAppUserModelIDLength {
+		id = id[:maxAppUserModelIDLength]
+	}
+	return id
+}
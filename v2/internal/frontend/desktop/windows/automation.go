@@ -0,0 +1,95 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/com"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	clsidAccPropServices = "{B5F8350B-0548-48B1-A6EE-88BD00B4A5E7}"
+	iidIAccPropServices  = "{6E26E776-04F0-495D-80E4-3330352E3169}"
+	// IAccPropServices
+	accPropServicesSetHwndPropStr = 7
+
+	objidClient = 0xFFFFFFFC // OBJID_CLIENT (-4)
+	childidSelf = 0
+)
+
+// The UI Automation properties that are set by annotating the window
+var (
+	uiaNamePropertyGUID         = windows.GUID{Data1: 0xC3A6921B, Data2: 0x4A99, Data3: 0x44F1, Data4: [8]byte{0xBC, 0xA6, 0x61, 0x18, 0x70, 0x52, 0xC4, 0x31}}
+	uiaAutomationIDPropertyGUID = windows.GUID{Data1: 0xC82C0500, Data2: 0xB60E, Data3: 0x4310, Data4: [8]byte{0xA2, 0x67, 0x30, 0x3C, 0x53, 0x1F, 0x8E, 0xE8}}
+)
+
+// setAutomationProperties sets the UI Automation properties of the window from the options
+func (f *Frontend) setAutomationProperties() {
+	if f.frontendOptions.Windows == nil {
+		return
+	}
+	err := f.mainWindow.setAutomationProperties(f.frontendOptions.Windows.AutomationName, f.frontendOptions.Windows.AutomationID)
+	if err != nil {
+		f.logger.Error("Unable to set the UI Automation properties of the window: %s", err.Error())
+	}
+}
+
+// setAutomationProperties sets the UI Automation Name and AutomationId of the window, which
+// screen readers and UI test frameworks use to identify it. Once the Name is set, it is no
+// longer derived from the window title.
+func (w *Window) setAutomationProperties(name string, automationID string) error {
+	if name == "" && automationID == "" {
+		return nil
+	}
+
+	uninitialize, err := com.Initialize()
+	if err != nil {
+		return err
+	}
+	defer uninitialize()
+
+	services, err := com.CreateInstance(clsidAccPropServices, iidIAccPropServices)
+	if err != nil {
+		return err
+	}
+	defer services.Release()
+
+	if name != "" {
+		err = w.setHwndPropStr(services, &uiaNamePropertyGUID, name)
+		if err != nil {
+			return err
+		}
+	}
+	if automationID != "" {
+		err = w.setHwndPropStr(services, &uiaAutomationIDPropertyGUID, automationID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Window) setHwndPropStr(services *com.Object, property *windows.GUID, value string) error {
+	valuePtr, err := syscall.UTF16PtrFromString(value)
+	if err != nil {
+		return err
+	}
+	args := []uintptr{uintptr(w.Handle()), objidClient, childidSelf}
+	args = append(args, guidArgs(property)...)
+	args = append(args, uintptr(unsafe.Pointer(valuePtr)))
+	return services.Call(accPropServicesSetHwndPropStr, args...)
+}
+
+// guidArgs returns the arguments that pass a GUID by value. 64-bit Windows passes it as a
+// pointer to the GUID, 32-bit Windows passes it on the stack.
+func guidArgs(guid *windows.GUID) []uintptr {
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		return []uintptr{uintptr(unsafe.Pointer(guid))}
+	}
+	words := (*[4]uint32)(unsafe.Pointer(guid))
+	return []uintptr{uintptr(words[0]), uintptr(words[1]), uintptr(words[2]), uintptr(words[3])}
+}
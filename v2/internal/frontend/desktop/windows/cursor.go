@@ -0,0 +1,207 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/leaanthony/winc/w32"
+)
+
+var (
+	procGetIconInfo        = moduser32.NewProc("GetIconInfo")
+	procCreateIconIndirect = moduser32.NewProc("CreateIconIndirect")
+)
+
+// systemCursors are the system cursors for the cursor names accepted by SetCursor. Cursors without a system
+// equivalent use the closest one.
+var systemCursors = map[string]uint16{
+	"default":     w32.IDC_ARROW,
+	"arrow":       w32.IDC_ARROW,
+	"pointer":     w32.IDC_HAND,
+	"hand":        w32.IDC_HAND,
+	"text":        w32.IDC_IBEAM,
+	"ibeam":       w32.IDC_IBEAM,
+	"crosshair":   w32.IDC_CROSS,
+	"wait":        w32.IDC_WAIT,
+	"progress":    w32.IDC_APPSTARTING,
+	"move":        w32.IDC_SIZEALL,
+	"all-scroll":  w32.IDC_SIZEALL,
+	"not-allowed": w32.IDC_NO,
+	"no-drop":     w32.IDC_NO,
+	"grab":        w32.IDC_HAND,
+	"grabbing":    w32.IDC_HAND,
+	"ew-resize":   w32.IDC_SIZEWE,
+	"col-resize":  w32.IDC_SIZEWE,
+	"ns-resize":   w32.IDC_SIZENS,
+	"row-resize":  w32.IDC_SIZENS,
+	"nesw-resize": w32.IDC_SIZENESW,
+	"nwse-resize": w32.IDC_SIZENWSE,
+	"help":        w32.IDC_HELP,
+	"zoom-in":     w32.IDC_CROSS,
+	"zoom-out":    w32.IDC_CROSS,
+}
+
+// iconInfo is the ICONINFO structure
+type iconInfo struct {
+	fIcon    int32
+	xHotspot uint32
+	yHotspot uint32
+	hbmMask  w32.HBITMAP
+	hbmColor w32.HBITMAP
+}
+
+// cursorState is the cursor shown over the client area of the window, which overrides the cursors set by the page
+type cursorState struct {
+	// The cursor set with SetCursor or SetCustomCursor, or 0 to use the cursors of the page
+	cursor w32.HCURSOR
+	// Set if the cursor was created from an image, so it is destroyed when it is replaced
+	custom bool
+	hidden bool
+	// Set while in presentation mode, which hides the cursor without changing the state set by HideCursor
+	presentationHidden bool
+}
+
+func (f *Frontend) WindowSetCursor(cursor string) {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		err := f.mainWindow.SetCursor(cursor)
+		if err != nil {
+			f.logger.Error(err.Error())
+		}
+	})
+}
+
+func (f *Frontend) WindowSetCustomCursor(image []byte, hotspotX int, hotspotY int) {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		err := f.mainWindow.SetCustomCursor(image, hotspotX, hotspotY)
+		if err != nil {
+			f.logger.Error("Unable to set the custom cursor: %s", err.Error())
+		}
+	})
+}
+
+func (f *Frontend) WindowHideCursor() {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.cursor.hidden = true
+		f.mainWindow.refreshCursor()
+	})
+}
+
+func (f *Frontend) WindowShowCursor() {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.cursor.hidden = false
+		f.mainWindow.refreshCursor()
+	})
+}
+
+// SetCursor sets the cursor shown over the window to the system cursor with the given CSS name.
+// An empty name restores the cursors of the page.
+func (w *Window) SetCursor(name string) error {
+	var cursor w32.HCURSOR
+	if name != "" {
+		id, exists := systemCursors[name]
+		if !exists {
+			return fmt.Errorf("unknown cursor '%s'", name)
+		}
+		cursor = w32.LoadCursor(0, w32.MakeIntResource(id))
+	}
+	w.replaceCursor(cursor, false)
+	return nil
+}
+
+// SetCustomCursor sets the cursor shown over the window to the given .ico or .png image
+func (w *Window) SetCustomCursor(image []byte, hotspotX int, hotspotY int) error {
+	cursor, err := createCursor(image, hotspotX, hotspotY)
+	if err != nil {
+		return err
+	}
+	w.replaceCursor(cursor, true)
+	return nil
+}
+
+func (w *Window) replaceCursor(cursor w32.HCURSOR, custom bool) {
+	previous := w.cursor
+	w.cursor.cursor = cursor
+	w.cursor.custom = custom
+	w.refreshCursor()
+	if previous.custom {
+		w32.DestroyIcon(previous.cursor)
+	}
+}
+
+// setCursorForHitTest sets the cursor for the WM_SETCURSOR message with the given lparam. It returns false if the
+// cursor isn't overridden, so the webview sets the cursor of the page.
+func (w *Window) setCursorForHitTest(lparam uintptr) bool {
+	if w32.LOWORD(uint32(lparam)) != w32.HTCLIENT {
+		return false
+	}
+	if w.cursor.hidden || w.cursor.presentationHidden {
+		w32.SetCursor(0)
+		return true
+	}
+	if w.cursor.cursor != 0 {
+		w32.SetCursor(w.cursor.cursor)
+		return true
+	}
+	return false
+}
+
+// refreshCursor applies the cursor straight away if it is over the client area, rather than when it next moves
+func (w *Window) refreshCursor() {
+	x, y, ok := w32.GetCursorPos()
+	if !ok {
+		return
+	}
+	x, y, ok = w32.ScreenToClient(w.Handle(), x, y)
+	if !ok || !w32.PtInRect(w32.GetClientRect(w.Handle()), x, y) {
+		return
+	}
+	w.setCursorForHitTest(w32.HTCLIENT)
+}
+
+// createCursor creates a cursor from the data of an .ico or .png file, with the hotspot given in pixels of the image
+func createCursor(data []byte, hotspotX int, hotspotY int) (w32.HCURSOR, error) {
+	image, err := iconImage(data, w32.GetSystemMetrics(w32.SM_CXCURSOR))
+	if err != nil {
+		return 0, err
+	}
+	// A size of 0 keeps the size of the image, so the hotspot stays where it is in the image
+	icon, _, err := procCreateIconFromResourceEx.Call(
+		uintptr(unsafe.Pointer(&image[0])),
+		uintptr(len(image)),
+		1,          // fIcon
+		0x00030000, // dwVer
+		0,
+		0,
+		0)
+	if icon == 0 {
+		return 0, err
+	}
+	defer w32.DestroyIcon(w32.HICON(icon))
+
+	var info iconInfo
+	ok, _, err := procGetIconInfo.Call(icon, uintptr(unsafe.Pointer(&info)))
+	if ok == 0 {
+		return 0, err
+	}
+	defer w32.DeleteObject(w32.HGDIOBJ(info.hbmMask))
+	if info.hbmColor != 0 {
+		defer w32.DeleteObject(w32.HGDIOBJ(info.hbmColor))
+	}
+
+	info.fIcon = 0
+	info.xHotspot = uint32(hotspotX)
+	info.yHotspot = uint32(hotspotY)
+	cursor, _, err := procCreateIconIndirect.Call(uintptr(unsafe.Pointer(&info)))
+	if cursor == 0 {
+		return 0, err
+	}
+	return w32.HCURSOR(cursor), nil
+}
@@ -1,5 +1,5 @@
-//go:build windows
-// +build windows
+//go:build windows && !wails.nodialogs
+// +build windows,!wails.nodialogs
 
 package windows
 
@@ -0,0 +1,130 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"sync"
+	"time"
+
+	"github.com/leaanthony/winc"
+	"github.com/leaanthony/winc/w32"
+)
+
+// wmDispatch is posted to the window to run the functions queued with Dispatch
+const wmDispatch = w32.WM_APP + 1
+
+// dispatchQueue holds the functions waiting to be run on the UI thread
+type dispatchQueue struct {
+	lock   sync.Mutex
+	funcs  []func()
+	closed bool
+}
+
+// push queues fn. It returns false if the queue has been closed.
+func (q *dispatchQueue) push(fn func()) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.closed {
+		return false
+	}
+	q.funcs = append(q.funcs, fn)
+	return true
+}
+
+// take removes the queued functions and returns them in the order they were queued
+func (q *dispatchQueue) take() []func() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	funcs := q.funcs
+	q.funcs = nil
+	return funcs
+}
+
+// close stops functions being queued and returns the ones still waiting
+func (q *dispatchQueue) close() []func() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.closed = true
+	funcs := q.funcs
+	q.funcs = nil
+	return funcs
+}
+
+// Dispatch runs fn on the UI thread, after the messages already waiting in the message loop, without waiting
+// for it to return. It can be called from any goroutine, including the UI thread.
+// fn isn't run if the message loop has exited.
+func (w *Window) Dispatch(fn func()) {
+	w.dispatch(fn)
+}
+
+// DispatchSync runs fn on the UI thread and waits for it to return. fn is run immediately when DispatchSync is
+// called on the UI thread. DispatchSync returns without running fn if the message loop has exited.
+func (w *Window) DispatchSync(fn func()) {
+	if !w.InvokeRequired() {
+		fn()
+		return
+	}
+	done := make(chan struct{})
+	if !w.dispatch(func() {
+		defer close(done)
+		fn()
+	}) {
+		return
+	}
+	<-done
+}
+
+// dispatch queues fn and posts wmDispatch to the window. It returns false if fn won't be run.
+func (w *Window) dispatch(fn func()) bool {
+	if !w.dispatchQueue.push(fn) {
+		return false
+	}
+	return w32.PostMessage(w.Handle(), wmDispatch, 0, 0)
+}
+
+// waitDispatching waits for done to be closed, for up to the timeout, while running the message loop, so goroutines
+// calling DispatchSync aren't blocked, and dialogs shown by them respond, while the UI thread waits for them.
+// It returns false if the timeout expired.
+func (w *Window) waitDispatching(done <-chan struct{}, timeout time.Duration) bool {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return true
+		case <-deadline.C:
+			return false
+		case <-ticker.C:
+			if !pumpMessages() {
+				return false
+			}
+		}
+	}
+}
+
+// pumpMessages runs the messages waiting in the message loop of the thread, including the wmDispatch ones.
+// It returns false if WM_QUIT was received, which is posted again for the message loop.
+func pumpMessages() bool {
+	var msg w32.MSG
+	for w32.PeekMessage(&msg, 0, 0, 0, w32.PM_REMOVE) {
+		if msg.Message == w32.WM_QUIT {
+			w32.PostQuitMessage(int(msg.WParam))
+			return false
+		}
+		if !winc.PreTranslateMessage(&msg) {
+			w32.TranslateMessage(&msg)
+			w32.DispatchMessage(&msg)
+		}
+	}
+	return true
+}
+
+// runDispatched runs the functions queued with Dispatch. It's called by WndProc on the UI thread.
+func (w *Window) runDispatched() {
+	for _, fn := range w.dispatchQueue.take() {
+		fn()
+	}
+}
@@ -0,0 +1,38 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDispatchQueue(t *testing.T) {
+	var q dispatchQueue
+	var got []int
+	for i := 1; i <= 3; i++ {
+		i := i
+		q.push(func() { got = append(got, i) })
+	}
+	for _, fn := range q.take() {
+		fn()
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("expected the functions to run in the order they were queued, got %v", got)
+	}
+	if funcs := q.take(); len(funcs) != 0 {
+		t.Errorf("expected the queue to be empty, got %d functions", len(funcs))
+	}
+}
+
+func TestDispatchQueueClose(t *testing.T) {
+	var q dispatchQueue
+	q.push(func() {})
+	if funcs := q.close(); len(funcs) != 1 {
+		t.Errorf("expected close to return the waiting function, got %d functions", len(funcs))
+	}
+	if q.push(func() {}) {
+		t.Error("expected functions not to be queued once the queue is closed")
+	}
+}
@@ -0,0 +1,226 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/com"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/fs"
+)
+
+const (
+	iidICoreWebView2_4 = "{20D02D59-6DF2-42DC-BD06-F98A694B1302}"
+	// ICoreWebView2_4
+	webviewAddDownloadStarting = 75
+	// ICoreWebView2DownloadStartingEventArgs
+	downloadStartingGetDownloadOperation = 3
+	downloadStartingGetResultFilePath    = 6
+	downloadStartingPutResultFilePath    = 7
+	downloadStartingPutHandled           = 9
+	// ICoreWebView2DownloadOperation
+	downloadAddBytesReceivedChanged    = 3
+	downloadRemoveBytesReceivedChanged = 4
+	downloadAddStateChanged            = 7
+	downloadRemoveStateChanged         = 8
+	downloadGetURI                     = 9
+	downloadGetTotalBytesToReceive     = 12
+	downloadGetBytesReceived           = 13
+	downloadGetResultFilePath          = 15
+	downloadGetState                   = 16
+	downloadCancel                     = 18
+	// COREWEBVIEW2_DOWNLOAD_STATE
+	downloadStateInProgress = 0
+	downloadStateCompleted  = 2
+)
+
+// The minimum time between the progress events of a download
+const downloadProgressInterval = 100 * time.Millisecond
+
+// download is a file being downloaded by the webview. It is only used on the main thread.
+type download struct {
+	id        string
+	operation *com.Object
+
+	bytesReceivedChanged      *com.EventHandler
+	bytesReceivedChangedToken int64
+	stateChanged              *com.EventHandler
+	stateChangedToken         int64
+
+	lastProgress time.Time
+}
+
+// setupDownloads applies the download options and emits the download events
+func (f *Frontend) setupDownloads() error {
+	webview, err := f.coreWebView()
+	if err != nil {
+		return err
+	}
+	webview4, err := webview.QueryInterface(iidICoreWebView2_4)
+	if err != nil {
+		return err
+	}
+	defer webview4.Release()
+
+	f.downloads = map[string]*download{}
+	f.downloadStartingHandler = com.NewEventHandler(f.downloadStarting)
+	_, err = webview4.AddEventHandler(webviewAddDownloadStarting, f.downloadStartingHandler)
+	return err
+}
+
+func (f *Frontend) DownloadCancel(id string) error {
+	runtime.LockOSThread()
+	var err error
+	f.mainWindow.DispatchSync(func() {
+		d, exists := f.downloads[id]
+		if !exists {
+			err = fmt.Errorf("download '%s' not found", id)
+			return
+		}
+		err = d.operation.Call(downloadCancel)
+	})
+	return err
+}
+
+func (f *Frontend) downloadStarting(_ *com.Object, args *com.Object) error {
+	if opts := f.frontendOptions.Windows; opts != nil {
+		if opts.WebviewDownloadDirectory != "" {
+			err := setDownloadDirectory(args, opts.WebviewDownloadDirectory)
+			if err != nil {
+				f.logger.Error("Unable to save the download to %s: %s", opts.WebviewDownloadDirectory, err.Error())
+			}
+		}
+		if opts.WebviewHideDownloadUI {
+			err := args.Call(downloadStartingPutHandled, 1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var operation *com.Object
+	err := args.Call(downloadStartingGetDownloadOperation, uintptr(unsafe.Pointer(&operation)))
+	if err != nil {
+		return err
+	}
+	f.lastDownloadID++
+	d := &download{
+		id:        strconv.Itoa(f.lastDownloadID),
+		operation: operation,
+	}
+	d.bytesReceivedChanged = com.NewEventHandler(func(_ *com.Object, _ *com.Object) error {
+		f.downloadProgress(d)
+		return nil
+	})
+	d.bytesReceivedChangedToken, err = operation.AddEventHandler(downloadAddBytesReceivedChanged, d.bytesReceivedChanged)
+	if err != nil {
+		operation.Release()
+		return err
+	}
+	d.stateChanged = com.NewEventHandler(func(_ *com.Object, _ *com.Object) error {
+		f.downloadStateChanged(d)
+		return nil
+	})
+	d.stateChangedToken, err = operation.AddEventHandler(downloadAddStateChanged, d.stateChanged)
+	if err != nil {
+		_ = operation.RemoveEventHandler(downloadRemoveBytesReceivedChanged, d.bytesReceivedChangedToken)
+		operation.Release()
+		return err
+	}
+
+	f.downloads[d.id] = d
+	f.emit(frontend.DownloadStartedEvent, d.info())
+	return nil
+}
+
+func (f *Frontend) downloadProgress(d *download) {
+	if time.Since(d.lastProgress) < downloadProgressInterval {
+		return
+	}
+	d.lastProgress = time.Now()
+	f.emit(frontend.DownloadProgressEvent, d.info())
+}
+
+func (f *Frontend) downloadStateChanged(d *download) {
+	info := d.info()
+	if info.State == frontend.DownloadInProgress {
+		return
+	}
+	d.release()
+	delete(f.downloads, d.id)
+	f.emit(frontend.DownloadCompletedEvent, info)
+}
+
+// releaseDownloads releases the downloads still in progress when the application quits
+func (f *Frontend) releaseDownloads() {
+	for id, d := range f.downloads {
+		d.release()
+		delete(f.downloads, id)
+	}
+}
+
+func (d *download) release() {
+	_ = d.operation.RemoveEventHandler(downloadRemoveBytesReceivedChanged, d.bytesReceivedChangedToken)
+	_ = d.operation.RemoveEventHandler(downloadRemoveStateChanged, d.stateChangedToken)
+	d.operation.Release()
+}
+
+// info returns the current state of the download
+func (d *download) info() frontend.Download {
+	result := frontend.Download{
+		ID:    d.id,
+		State: frontend.DownloadInterrupted,
+	}
+	result.URL, _ = d.operation.GetString(downloadGetURI)
+	result.Path, _ = d.operation.GetString(downloadGetResultFilePath)
+	_ = d.operation.Call(downloadGetTotalBytesToReceive, uintptr(unsafe.Pointer(&result.TotalBytes)))
+	_ = d.operation.Call(downloadGetBytesReceived, uintptr(unsafe.Pointer(&result.BytesReceived)))
+	var state int32
+	if d.operation.Call(downloadGetState, uintptr(unsafe.Pointer(&state))) == nil {
+		switch state {
+		case downloadStateInProgress:
+			result.State = frontend.DownloadInProgress
+		case downloadStateCompleted:
+			result.State = frontend.DownloadCompleted
+		}
+	}
+	return result
+}
+
+// setDownloadDirectory saves the download to the given directory, keeping the filename chosen by the webview
+func setDownloadDirectory(args *com.Object, directory string) error {
+	defaultPath, err := args.GetString(downloadStartingGetResultFilePath)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(directory, 0755)
+	if err != nil {
+		return err
+	}
+	path, err := syscall.UTF16PtrFromString(uniqueFilePath(filepath.Join(directory, filepath.Base(defaultPath))))
+	if err != nil {
+		return err
+	}
+	return args.Call(downloadStartingPutResultFilePath, uintptr(unsafe.Pointer(path)))
+}
+
+// uniqueFilePath returns the path, or the path with a number added to the filename if the file exists,
+// EG: "report (1).pdf"
+func uniqueFilePath(path string) string {
+	extension := filepath.Ext(path)
+	base := strings.TrimSuffix(path, extension)
+	for index := 1; fs.FileExists(path); index++ {
+		path = fmt.Sprintf("%s (%d)%s", base, index, extension)
+	}
+	return path
+}
@@ -0,0 +1,114 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	"github.com/leaanthony/winc/w32"
+	winoptions "github.com/wailsapp/wails/v2/pkg/options/windows"
+)
+
+var procAdjustWindowRectExForDpi = moduser32.NewProc("AdjustWindowRectExForDpi")
+
+func (w *Window) dpiChangePolicy() winoptions.DPIChangePolicy {
+	if w.frontendOptions.Windows == nil {
+		return winoptions.DPIChangeFollowSuggestion
+	}
+	return w.frontendOptions.Windows.DPIChangePolicy
+}
+
+// currentDPI returns the DPI of the monitor the window is on, or 0 if it isn't known
+func (w *Window) currentDPI() uint {
+	if !w32.HasGetDpiForWindowFunc() {
+		return 0
+	}
+	return w32.GetDpiForWindow(w.Handle())
+}
+
+// dpiChanged resizes the window for WM_DPICHANGED, which is sent when the window is moved to a monitor with a
+// different DPI
+func (w *Window) dpiChanged(wparam, lparam uintptr) {
+	newDPI := uint(w32.LOWORD(uint32(wparam)))
+	suggested := *(*w32.RECT)(unsafe.Pointer(lparam))
+	current := w32.GetWindowRect(w.Handle())
+	client := w32.GetClientRect(w.Handle())
+	frame := w.frameAtDPI(*current, *client, w.dpi, newDPI)
+
+	rect := dpiChangedRect(w.dpiChangePolicy(), *current, suggested, *client, frame, w.dpi, newDPI)
+	w.dpi = newDPI
+
+	w32.SetWindowPos(w.Handle(),
+		uintptr(0),
+		int(rect.Left),
+		int(rect.Top),
+		int(rect.Right-rect.Left),
+		int(rect.Bottom-rect.Top),
+		w32.SWP_NOZORDER|w32.SWP_NOACTIVATE)
+}
+
+// frameAtDPI returns the widths of the borders around the client area of the window at the new DPI, given its
+// current bounds and client area. A frameless window has no borders.
+func (w *Window) frameAtDPI(current, client w32.RECT, oldDPI, newDPI uint) w32.RECT {
+	x, y := w32.ClientToScreen(w.Handle(), 0, 0)
+	frame := w32.RECT{
+		Left:   int32(x) - current.Left,
+		Top:    int32(y) - current.Top,
+		Right:  current.Right - int32(x) - client.Right,
+		Bottom: current.Bottom - int32(y) - client.Bottom,
+	}
+	if frame == (w32.RECT{}) || newDPI == 0 {
+		return frame
+	}
+	if procAdjustWindowRectExForDpi.Find() == nil {
+		var rect w32.RECT
+		style := uint32(w32.GetWindowLong(w.Handle(), w32.GWL_STYLE))
+		exStyle := uint32(w32.GetWindowLong(w.Handle(), w32.GWL_EXSTYLE))
+		var hasMenu uintptr
+		if w.applicationMenu != nil {
+			hasMenu = 1
+		}
+		ret, _, _ := procAdjustWindowRectExForDpi.Call(uintptr(unsafe.Pointer(&rect)), uintptr(style), hasMenu, uintptr(exStyle), uintptr(newDPI))
+		if ret != 0 {
+			return w32.RECT{Left: -rect.Left, Top: -rect.Top, Right: rect.Right, Bottom: rect.Bottom}
+		}
+	}
+	if oldDPI == 0 {
+		return frame
+	}
+	return w32.RECT{
+		Left:   scaleDPI(frame.Left, oldDPI, newDPI),
+		Top:    scaleDPI(frame.Top, oldDPI, newDPI),
+		Right:  scaleDPI(frame.Right, oldDPI, newDPI),
+		Bottom: scaleDPI(frame.Bottom, oldDPI, newDPI),
+	}
+}
+
+// dpiChangedRect returns the bounds of the window after the DPI changed from oldDPI to newDPI, given its current
+// bounds and client area, the widths of its borders at the new DPI and the bounds suggested by Windows.
+// The window is placed at the suggested position, which is on the new monitor. The suggestion is followed if the
+// previous DPI isn't known.
+func dpiChangedRect(policy winoptions.DPIChangePolicy, current, suggested, client, frame w32.RECT, oldDPI, newDPI uint) w32.RECT {
+	if oldDPI == 0 || newDPI == 0 {
+		return suggested
+	}
+	var width, height int32
+	switch policy {
+	case winoptions.DPIChangeKeepLogicalSize:
+		// The client area is scaled exactly, so the page keeps its size in CSS pixels
+		width = scaleDPI(client.Right-client.Left, oldDPI, newDPI) + frame.Left + frame.Right
+		height = scaleDPI(client.Bottom-client.Top, oldDPI, newDPI) + frame.Top + frame.Bottom
+	case winoptions.DPIChangeKeepPhysicalSize:
+		width = current.Right - current.Left
+		height = current.Bottom - current.Top
+	default:
+		return suggested
+	}
+	return w32.RECT{Left: suggested.Left, Top: suggested.Top, Right: suggested.Left + width, Bottom: suggested.Top + height}
+}
+
+// scaleDPI scales the pixels from one DPI to another, rounding to the nearest pixel
+func scaleDPI(pixels int32, from, to uint) int32 {
+	return int32((int64(pixels)*int64(to) + int64(from)/2) / int64(from))
+}
@@ -0,0 +1,47 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/leaanthony/winc/w32"
+	winoptions "github.com/wailsapp/wails/v2/pkg/options/windows"
+)
+
+func TestDPIChangedRect(t *testing.T) {
+	// A window with a 800x600 client area at 96 DPI moved onto a monitor at 144 DPI, where its borders are wider
+	current := w32.RECT{Left: 1800, Top: 100, Right: 2616, Bottom: 739}
+	client := w32.RECT{Right: 800, Bottom: 600}
+	frame := w32.RECT{Left: 12, Top: 45, Right: 12, Bottom: 12}
+	suggested := w32.RECT{Left: 1700, Top: 80, Right: 2924, Bottom: 1038}
+	tests := []struct {
+		name   string
+		policy winoptions.DPIChangePolicy
+		oldDPI uint
+		want   w32.RECT
+	}{
+		{"follow suggestion", winoptions.DPIChangeFollowSuggestion, 96, suggested},
+		{"keep logical size", winoptions.DPIChangeKeepLogicalSize, 96, w32.RECT{Left: 1700, Top: 80, Right: 2924, Bottom: 1037}},
+		{"keep physical size", winoptions.DPIChangeKeepPhysicalSize, 96, w32.RECT{Left: 1700, Top: 80, Right: 2516, Bottom: 719}},
+		{"unknown DPI", winoptions.DPIChangeKeepPhysicalSize, 0, suggested},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dpiChangedRect(tt.policy, current, suggested, client, frame, tt.oldDPI, 144); got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDPIChangedRectKeepLogicalSizeRounds(t *testing.T) {
+	// A frameless 801x601 window at 120 DPI moved onto a monitor at 96 DPI is 640.8x480.8 pixels
+	current := w32.RECT{Left: 0, Top: 0, Right: 801, Bottom: 601}
+	got := dpiChangedRect(winoptions.DPIChangeKeepLogicalSize, current, w32.RECT{}, current, w32.RECT{}, 120, 96)
+	want := w32.RECT{Left: 0, Top: 0, Right: 641, Bottom: 481}
+	if got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
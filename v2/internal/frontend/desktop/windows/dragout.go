@@ -0,0 +1,252 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/com"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modole32            = syscall.NewLazyDLL("ole32.dll")
+	procOleInitialize   = modole32.NewProc("OleInitialize")
+	procOleUninitialize = modole32.NewProc("OleUninitialize")
+	procDoDragDrop      = modole32.NewProc("DoDragDrop")
+
+	procILCreateFromPathW  = modshell32.NewProc("ILCreateFromPathW")
+	procILFree             = modshell32.NewProc("ILFree")
+	procSHCreateDataObject = modshell32.NewProc("SHCreateDataObject")
+
+	modkernel32       = syscall.NewLazyDLL("kernel32.dll")
+	procGlobalAlloc   = modkernel32.NewProc("GlobalAlloc")
+	procGlobalFree    = modkernel32.NewProc("GlobalFree")
+	procGlobalLock    = modkernel32.NewProc("GlobalLock")
+	procGlobalUnlock  = modkernel32.NewProc("GlobalUnlock")
+	procRtlMoveMemory = modkernel32.NewProc("RtlMoveMemory")
+)
+
+const (
+	iidIDataObject = "{0000010E-0000-0000-C000-000000000046}"
+	// IDataObject
+	dataObjectSetData = 7
+
+	cfHDrop                    = 15
+	dvaspectContent            = 1
+	tymedHGlobal               = 1
+	dropEffectCopy             = 1
+	mkLButton                  = 0x0001
+	gmemMoveable               = 0x0002
+	dragDropSDrop              = 0x00040100
+	dragDropSCancel            = 0x00040101
+	dragDropSUseDefaultCursors = 0x00040102
+	// The size of DROPFILES, which is followed by the paths of the files
+	dropFilesSize = 20
+)
+
+type formatEtc struct {
+	format uint16
+	device uintptr
+	aspect uint32
+	index  int32
+	tymed  uint32
+}
+
+type stgMedium struct {
+	tymed            uint32
+	handle           uintptr
+	unknownToRelease uintptr
+}
+
+// dropSource implements IDropSource, which ends the drag when the mouse button is released
+type dropSource struct {
+	vtbl *dropSourceVtbl
+}
+
+type dropSourceVtbl struct {
+	QueryInterface    uintptr
+	AddRef            uintptr
+	Release           uintptr
+	QueryContinueDrag uintptr
+	GiveFeedback      uintptr
+}
+
+var dropSourceFns = dropSourceVtbl{
+	QueryInterface: windows.NewCallback(func(this *dropSource, _ uintptr, object *uintptr) uintptr {
+		*object = uintptr(unsafe.Pointer(this))
+		return uintptr(windows.S_OK)
+	}),
+	// The lifetime of the drop source is managed by Go
+	AddRef: windows.NewCallback(func(this *dropSource) uintptr {
+		return 1
+	}),
+	Release: windows.NewCallback(func(this *dropSource) uintptr {
+		return 1
+	}),
+	QueryContinueDrag: windows.NewCallback(func(this *dropSource, escapePressed uintptr, keyState uintptr) uintptr {
+		if escapePressed != 0 {
+			return dragDropSCancel
+		}
+		if keyState&mkLButton == 0 {
+			return dragDropSDrop
+		}
+		return uintptr(windows.S_OK)
+	}),
+	GiveFeedback: windows.NewCallback(func(this *dropSource, effect uintptr) uintptr {
+		return dragDropSUseDefaultCursors
+	}),
+}
+
+func (f *Frontend) StartDrag(files []string) error {
+	if len(files) == 0 {
+		return errors.New("no files to drag")
+	}
+	for _, file := range files {
+		if !filepath.IsAbs(file) {
+			return fmt.Errorf("dragged files require an absolute path: %s", file)
+		}
+		if _, err := os.Stat(file); err != nil {
+			return err
+		}
+	}
+
+	runtime.LockOSThread()
+	var err error
+	f.mainWindow.DispatchSync(func() {
+		err = dragFiles(files)
+	})
+	return err
+}
+
+// dragFiles drags the files from the window, returning once they have been dropped or the drag has been cancelled.
+// It is called on the main thread while the left mouse button is down, otherwise the files are dropped straight away.
+func dragFiles(files []string) error {
+	hr, _, _ := procOleInitialize.Call(0)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	defer procOleUninitialize.Call()
+
+	dataObject, err := createDataObject(files)
+	if err != nil {
+		return err
+	}
+	defer dataObject.Release()
+
+	source := &dropSource{vtbl: &dropSourceFns}
+	var effect uint32
+	hr, _, _ = procDoDragDrop.Call(
+		uintptr(unsafe.Pointer(dataObject)),
+		uintptr(unsafe.Pointer(source)),
+		dropEffectCopy,
+		uintptr(unsafe.Pointer(&effect)))
+	runtime.KeepAlive(source)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+// createDataObject returns a shell data object of the files, which also has the CF_HDROP format used by
+// applications other than Explorer
+func createDataObject(files []string) (*com.Object, error) {
+	pidls := make([]uintptr, len(files))
+	defer func() {
+		for _, pidl := range pidls {
+			if pidl != 0 {
+				procILFree.Call(pidl)
+			}
+		}
+	}()
+	for index, file := range files {
+		path, err := windows.UTF16PtrFromString(file)
+		if err != nil {
+			return nil, err
+		}
+		pidls[index], _, _ = procILCreateFromPathW.Call(uintptr(unsafe.Pointer(path)))
+		if pidls[index] == 0 {
+			return nil, fmt.Errorf("unable to drag %s", file)
+		}
+	}
+
+	iid, err := windows.GUIDFromString(iidIDataObject)
+	if err != nil {
+		return nil, err
+	}
+	var dataObject *com.Object
+	hr, _, _ := procSHCreateDataObject.Call(
+		0,
+		uintptr(len(pidls)),
+		uintptr(unsafe.Pointer(&pidls[0])),
+		0,
+		uintptr(unsafe.Pointer(&iid)),
+		uintptr(unsafe.Pointer(&dataObject)))
+	if hr != 0 {
+		return nil, syscall.Errno(hr)
+	}
+
+	err = setHDrop(dataObject, files)
+	if err != nil {
+		dataObject.Release()
+		return nil, err
+	}
+	return dataObject, nil
+}
+
+// setHDrop adds the files to the data object in the CF_HDROP format
+func setHDrop(dataObject *com.Object, files []string) error {
+	// DROPFILES is followed by the paths as a list of null terminated strings, ending with an empty string
+	var paths []uint16
+	for _, file := range files {
+		path, err := windows.UTF16FromString(file)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, path...)
+	}
+	paths = append(paths, 0)
+	data := make([]byte, dropFilesSize+len(paths)*2)
+	binary.LittleEndian.PutUint32(data[0:], dropFilesSize) // pFiles
+	binary.LittleEndian.PutUint32(data[16:], 1)            // fWide
+	for index, char := range paths {
+		binary.LittleEndian.PutUint16(data[dropFilesSize+index*2:], char)
+	}
+
+	handle, err := globalAlloc(data)
+	if err != nil {
+		return err
+	}
+	format := formatEtc{format: cfHDrop, aspect: dvaspectContent, index: -1, tymed: tymedHGlobal}
+	medium := stgMedium{tymed: tymedHGlobal, handle: handle}
+	// The data object frees the memory once it has been set
+	err = dataObject.Call(dataObjectSetData, uintptr(unsafe.Pointer(&format)), uintptr(unsafe.Pointer(&medium)), 1)
+	if err != nil {
+		procGlobalFree.Call(handle)
+	}
+	return err
+}
+
+// globalAlloc returns a copy of the data in global memory, as used to exchange data with other applications
+func globalAlloc(data []byte) (uintptr, error) {
+	handle, _, err := procGlobalAlloc.Call(gmemMoveable, uintptr(len(data)))
+	if handle == 0 {
+		return 0, err
+	}
+	memory, _, err := procGlobalLock.Call(handle)
+	if memory == 0 {
+		procGlobalFree.Call(handle)
+		return 0, err
+	}
+	procRtlMoveMemory.Call(memory, uintptr(unsafe.Pointer(&data[0])), uintptr(len(data)))
+	procGlobalUnlock.Call(handle)
+	return handle, nil
+}
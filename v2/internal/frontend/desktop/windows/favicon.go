@@ -0,0 +1,173 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/com"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/common"
+)
+
+const (
+	iidICoreWebView2_15 = "{517B2D1D-7DAE-4A66-A4F4-10352FFB9518}"
+	// ICoreWebView2_15
+	webviewAddFaviconChanged = 109
+	webviewGetFaviconUri     = 111
+)
+
+// The maximum size of a favicon downloaded from a server
+const maxFaviconSize = 1 << 20
+
+// setupFavicon sets the icon of the window to the favicon of the page whenever it changes
+func (f *Frontend) setupFavicon() error {
+	webview, err := f.coreWebView()
+	if err != nil {
+		return err
+	}
+	webview15, err := webview.QueryInterface(iidICoreWebView2_15)
+	if err != nil {
+		return err
+	}
+	defer webview15.Release()
+
+	f.faviconChangedHandler = com.NewEventHandler(f.faviconChanged)
+	_, err = webview15.AddEventHandler(webviewAddFaviconChanged, f.faviconChangedHandler)
+	return err
+}
+
+func (f *Frontend) faviconChanged(webview *com.Object, _ *com.Object) error {
+	webview15, err := webview.QueryInterface(iidICoreWebView2_15)
+	if err != nil {
+		return err
+	}
+	defer webview15.Release()
+
+	href, err := webview15.GetString(webviewGetFaviconUri)
+	if err != nil {
+		return err
+	}
+	pageURL, err := webview.GetString(webviewGetSource)
+	if err != nil {
+		return err
+	}
+	f.updateFavicon(href, pageURL)
+	return nil
+}
+
+// updateFavicon sets the icon of the window to the favicon with the given URL, or restores the icon of the
+// application if the URL is empty. It is called on the main thread.
+func (f *Frontend) updateFavicon(href string, pageURL string) {
+	f.faviconRequest++
+	request := f.faviconRequest
+	go func() {
+		var icon []byte
+		if href != "" {
+			var err error
+			icon, err = f.loadFavicon(href, pageURL)
+			if err != nil {
+				f.logger.Warning("Unable to load the favicon %s: %s", href, err.Error())
+				return
+			}
+		}
+		f.mainWindow.Dispatch(func() {
+			// The favicon is out of date if the page changed it while it was loading
+			if request != f.faviconRequest {
+				return
+			}
+			err := f.mainWindow.SetIcon(icon)
+			if err != nil {
+				f.logger.Warning("Unable to set the favicon %s as the window icon: %s", href, err.Error())
+			}
+		})
+	}()
+}
+
+// loadFavicon returns the data of the favicon from the assets, a data URL or the server of the page, EG: the
+// dev server. Favicons from other origins aren't loaded, so pages can't make the application request other sites.
+func (f *Frontend) loadFavicon(href string, pageURL string) ([]byte, error) {
+	if strings.HasPrefix(href, "data:") {
+		return decodeDataURL(href)
+	}
+
+	file, match, err := common.TranslateUriToFile(href, "file", "wails")
+	if err != nil {
+		return nil, err
+	}
+	if match {
+		if f.assets == nil {
+			return nil, errors.New("the assets are not available")
+		}
+		response, err := f.assets.Serve(file, http.Header{})
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d", response.StatusCode)
+		}
+		return response.Body, nil
+	}
+
+	if !isServedBy(href, pageURL) {
+		return nil, errors.New("the favicon is not served by the origin of the page")
+	}
+	client := http.Client{
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(req *http.Request, _ []*http.Request) error {
+			if !isServedBy(req.URL.String(), pageURL) {
+				return errors.New("the favicon redirects to another origin")
+			}
+			return nil
+		},
+	}
+	response, err := client.Get(href)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return ioutil.ReadAll(io.LimitReader(response.Body, maxFaviconSize))
+}
+
+// isServedBy returns true if the URL is served over HTTP by the origin of the page
+func isServedBy(href string, pageURL string) bool {
+	target, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+	page, err := url.Parse(pageURL)
+	if err != nil {
+		return false
+	}
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return false
+	}
+	return target.Scheme == page.Scheme && strings.EqualFold(target.Host, page.Host)
+}
+
+// decodeDataURL returns the data of a URL with the form `data:[<mediatype>][;base64],<data>`
+func decodeDataURL(href string) ([]byte, error) {
+	comma := strings.Index(href, ",")
+	if comma == -1 {
+		return nil, errors.New("invalid data URL")
+	}
+	data, err := url.PathUnescape(href[comma+1:])
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(href[:comma], ";base64") {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	return []byte(data), nil
+}
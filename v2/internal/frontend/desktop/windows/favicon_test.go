@@ -0,0 +1,29 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import "testing"
+
+func TestIsServedBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		href    string
+		pageURL string
+		want    bool
+	}{
+		{"same origin", "http://localhost:34115/favicon.ico", "http://localhost:34115/", true},
+		{"case of the host", "http://LOCALHOST:34115/favicon.ico", "http://localhost:34115/page", true},
+		{"other port", "http://localhost:8080/favicon.ico", "http://localhost:34115/", false},
+		{"other host", "https://example.com/favicon.ico", "http://localhost:34115/", false},
+		{"other scheme", "https://localhost:34115/favicon.ico", "http://localhost:34115/", false},
+		{"not http", "file:///C:/favicon.ico", "file:///C:/index.html", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isServedBy(tt.href, tt.pageURL); got != tt.want {
+				t.Errorf("isServedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,20 +8,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"runtime"
 	"strconv"
 	"strings"
 	"text/template"
+	"unsafe"
 
 	"github.com/leaanthony/go-webview2/pkg/edge"
 	"github.com/leaanthony/winc"
 	"github.com/leaanthony/winc/w32"
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/com"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/assetserver"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/common"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/internal/startup"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
@@ -35,6 +39,31 @@ type Frontend struct {
 	chromium        *edge.Chromium
 	debug           bool
 
+	// The ICoreWebView2 of chromium, for the events it doesn't provide
+	webview                   *com.Object
+	navigationStartingHandler *com.EventHandler
+	newWindowRequestedHandler *com.EventHandler
+
+	documentTitleChangedHandler *com.EventHandler
+	// The URL of the current navigation
+	navigationURL string
+	// Set when the next navigation was requested with WindowLoadURL
+	loadURLRequested bool
+
+	// The downloads in progress, by ID
+	downloads               map[string]*download
+	downloadStartingHandler *com.EventHandler
+	lastDownloadID          int
+
+	permissionRequestedHandler *com.EventHandler
+
+	// The state restored when presentation mode is left, only used on the UI thread
+	presentationMode presentationMode
+
+	faviconChangedHandler *com.EventHandler
+	// Incremented for each favicon, so only the latest one is set as the window icon
+	faviconRequest int
+
 	// Assets
 	assets   *assetserver.DesktopAssetServer
 	startURL string
@@ -67,7 +96,8 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 	_devServerURL := ctx.Value("devserverurl")
 	if _devServerURL != nil {
 		devServerURL := _devServerURL.(string)
-		if len(devServerURL) > 0 && devServerURL != "http://localhost:34115" {
+		devServer, _ := ctx.Value("devserver").(string)
+		if len(devServerURL) > 0 && devServerURL != "http://"+devServer {
 			result.startURL = devServerURL
 			return result
 		}
@@ -83,11 +113,12 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		result.servingFromDisk = true
 	}
 
-	assets, err := assetserver.NewDesktopAssetServer(ctx, appoptions.Assets, bindingsJSON)
+	assets, err := assetserver.NewDesktopAssetServer(ctx, appoptions, bindingsJSON)
 	if err != nil {
 		log.Fatal(err)
 	}
 	result.assets = assets
+	result.startURL = "file://wails" + assets.BasePath()
 
 	return result
 }
@@ -96,22 +127,51 @@ func (f *Frontend) WindowReload() {
 	f.ExecJS("runtime.WindowReload();")
 }
 
+func (f *Frontend) WindowLoadURL(url string) error {
+	target, err := frontend.ResolveURL(f.startURL, url, f.frontendOptions.RestrictWindowLoadURL)
+	if err != nil {
+		return err
+	}
+	f.mainWindow.Dispatch(func() {
+		f.loadURLRequested = true
+		f.chromium.Navigate(target)
+	})
+	return nil
+}
+
 func (f *Frontend) Run(ctx context.Context) error {
 
 	f.ctx = context.WithValue(ctx, "frontend", f)
 
+	f.setAppUserModelID()
+
 	mainWindow := NewWindow(nil, f.frontendOptions)
 	f.mainWindow = mainWindow
+	f.setAutomationProperties()
+	startup.Record(f.ctx, startup.WindowCreated)
 
 	var _debug = ctx.Value("debug")
 	if _debug != nil {
 		f.debug = _debug.(bool)
 	}
 
-	f.WindowCenter()
+	if !f.rememberWindowPlacement() || !f.restoreWindowPlacement() {
+		f.WindowCenter()
+	}
 	f.setupChromium()
+	startup.Record(f.ctx, startup.WebviewReady)
 
 	f.mainWindow.notifyParentWindowPositionChanged = f.chromium.NotifyParentWindowPositionChanged
+	f.mainWindow.onEscapeInFullscreen = f.escapeInFullscreen
+	f.mainWindow.onThumbnailButtonClick = f.thumbnailButtonClicked
+	f.mainWindow.onInputEvent = f.inputEvent
+	f.mainWindow.onQueryEndSession = f.queryEndSession
+	f.mainWindow.onEndSession = f.endSession
+	f.mainWindow.onEnabled = f.focusWebview
+	mainWindow.OnCleanup(f.releaseDownloads)
+	if f.rememberWindowPlacement() {
+		mainWindow.OnCleanup(f.saveWindowPlacement)
+	}
 
 	mainWindow.OnSize().Bind(func(arg *winc.Event) {
 		f.chromium.Resize()
@@ -130,6 +190,7 @@ func (f *Frontend) Run(ctx context.Context) error {
 			f.frontendOptions.OnStartup(f.ctx)
 		}
 	}()
+	// The cleanup functions are run when the message loop exits, then OnShutdown is called once Run returns
 	mainWindow.Run()
 	mainWindow.Close()
 	return nil
@@ -137,92 +198,178 @@ func (f *Frontend) Run(ctx context.Context) error {
 
 func (f *Frontend) WindowCenter() {
 	runtime.LockOSThread()
-	f.mainWindow.Center()
+	f.mainWindow.Dispatch(f.mainWindow.Center)
 }
 
 func (f *Frontend) WindowSetPosition(x, y int) {
 	runtime.LockOSThread()
-	f.mainWindow.SetPos(x, y)
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetPos(x, y)
+	})
 }
 func (f *Frontend) WindowGetPosition() (int, int) {
 	runtime.LockOSThread()
-	return f.mainWindow.Pos()
+	var x, y int
+	f.mainWindow.DispatchSync(func() {
+		x, y = f.mainWindow.Pos()
+	})
+	return x, y
 }
 
 func (f *Frontend) WindowSetSize(width, height int) {
 	runtime.LockOSThread()
-	f.mainWindow.SetSize(width, height)
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetSize(width, height)
+	})
 }
 
 func (f *Frontend) WindowGetSize() (int, int) {
 	runtime.LockOSThread()
-	return f.mainWindow.Size()
+	var width, height int
+	f.mainWindow.DispatchSync(func() {
+		width, height = f.mainWindow.Size()
+	})
+	return width, height
 }
 
 func (f *Frontend) WindowSetTitle(title string) {
 	runtime.LockOSThread()
-	f.mainWindow.SetText(title)
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetText(title)
+	})
 }
 
 func (f *Frontend) WindowFullscreen() {
 	runtime.LockOSThread()
-	if f.frontendOptions.Frameless && f.frontendOptions.DisableResize == false {
+	if f.frontendOptions.Frameless && f.mainWindow.edgeResizeEnabled() {
 		f.ExecJS("window.wails.flags.enableResize = false;")
 	}
-	f.mainWindow.Fullscreen()
+	f.mainWindow.Dispatch(f.mainWindow.Fullscreen)
 }
 
 func (f *Frontend) WindowUnFullscreen() {
 	runtime.LockOSThread()
-	if f.frontendOptions.Frameless && f.frontendOptions.DisableResize == false {
+	if f.frontendOptions.Frameless && f.mainWindow.edgeResizeEnabled() {
 		f.ExecJS("window.wails.flags.enableResize = true;")
 	}
-	f.mainWindow.UnFullscreen()
+	f.mainWindow.Dispatch(f.mainWindow.UnFullscreen)
 }
 
 func (f *Frontend) WindowShow() {
 	runtime.LockOSThread()
-	f.mainWindow.Show()
+	f.mainWindow.Dispatch(f.mainWindow.Show)
+}
+
+func (f *Frontend) WindowShowNoActivate() {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(f.mainWindow.ShowNoActivate)
 }
 
 func (f *Frontend) WindowHide() {
 	runtime.LockOSThread()
-	f.mainWindow.Hide()
+	f.mainWindow.Dispatch(f.mainWindow.Hide)
 }
 func (f *Frontend) WindowMaximise() {
 	runtime.LockOSThread()
-	if f.hasStarted {
-		if !f.frontendOptions.DisableResize {
-			f.mainWindow.Maximise()
+	f.mainWindow.Dispatch(func() {
+		if f.hasStarted {
+			if !f.frontendOptions.DisableResize {
+				f.mainWindow.Maximise()
+			}
+		} else {
+			f.frontendOptions.WindowStartState = options.Maximised
 		}
-	} else {
-		f.frontendOptions.WindowStartState = options.Maximised
-	}
+	})
 }
 func (f *Frontend) WindowUnmaximise() {
 	runtime.LockOSThread()
-	f.mainWindow.Restore()
+	f.mainWindow.Dispatch(f.mainWindow.Restore)
 }
 func (f *Frontend) WindowMinimise() {
 	runtime.LockOSThread()
-	if f.hasStarted {
-		f.mainWindow.Minimise()
-	} else {
-		f.frontendOptions.WindowStartState = options.Minimised
-	}
+	f.mainWindow.Dispatch(func() {
+		if f.hasStarted {
+			f.mainWindow.Minimise()
+		} else {
+			f.frontendOptions.WindowStartState = options.Minimised
+		}
+	})
 }
 func (f *Frontend) WindowUnminimise() {
 	runtime.LockOSThread()
-	f.mainWindow.Restore()
+	f.mainWindow.Dispatch(f.mainWindow.Restore)
 }
 
 func (f *Frontend) WindowSetMinSize(width int, height int) {
 	runtime.LockOSThread()
-	f.mainWindow.SetMinSize(width, height)
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetMinSize(width, height)
+	})
 }
 func (f *Frontend) WindowSetMaxSize(width int, height int) {
 	runtime.LockOSThread()
-	f.mainWindow.SetMaxSize(width, height)
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetMaxSize(width, height)
+	})
+}
+
+func (f *Frontend) WindowSetIgnoreMouseEvents(ignore bool) {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetIgnoreMouseEvents(ignore)
+	})
+}
+
+func (f *Frontend) WindowSetAlwaysOnBottom(bottom bool) {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetAlwaysOnBottom(bottom)
+	})
+}
+
+func (f *Frontend) WindowSetEnabled(enabled bool) {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetEnabled(enabled)
+	})
+}
+
+func (f *Frontend) WindowIsEnabled() bool {
+	runtime.LockOSThread()
+	var enabled bool
+	f.mainWindow.DispatchSync(func() {
+		enabled = f.mainWindow.IsEnabled()
+	})
+	return enabled
+}
+
+// focusWebview gives the focus back to the webview when the window is enabled again while it is active,
+// as it was taken from the webview when the window was disabled
+func (f *Frontend) focusWebview() {
+	if getActiveWindow() != f.mainWindow.Handle() {
+		return
+	}
+	controller := (*com.Object)(unsafe.Pointer(f.chromium.GetController()))
+	err := controller.Call(controllerMoveFocus, moveFocusReasonProgrammatic)
+	if err != nil {
+		f.logger.Warning("Unable to focus the webview: %s", err.Error())
+	}
+}
+
+func (f *Frontend) WindowSetThumbnailButtons(buttons []frontend.ThumbnailButton) error {
+	runtime.LockOSThread()
+	var err error
+	f.mainWindow.DispatchSync(func() {
+		err = f.mainWindow.SetThumbnailButtons(buttons)
+	})
+	return err
+}
+
+func (f *Frontend) thumbnailButtonClicked(button frontend.ThumbnailButton) {
+	if button.OnClick != nil {
+		go button.OnClick()
+	}
+	f.Notify(frontend.ThumbnailButtonClickEvent, button.ID)
 }
 
 func (f *Frontend) WindowSetRGBA(col *options.RGBA) {
@@ -231,7 +378,7 @@ func (f *Frontend) WindowSetRGBA(col *options.RGBA) {
 		return
 	}
 
-	f.mainWindow.Invoke(func() {
+	f.mainWindow.Dispatch(func() {
 		controller := f.chromium.GetController()
 		controller2 := controller.GetICoreWebView2Controller2()
 
@@ -264,10 +411,11 @@ func (f *Frontend) Quit() {
 	}
 	// Exit must be called on the Main-Thread. It calls PostQuitMessage which sends the WM_QUIT message to the thread's
 	// message queue and our message queue runs on the Main-Thread.
-	f.mainWindow.Invoke(winc.Exit)
+	f.mainWindow.Dispatch(winc.Exit)
 }
 
 func (f *Frontend) setupChromium() {
+	f.configureGPU()
 	chromium := edge.NewChromium()
 	f.chromium = chromium
 	if opts := f.frontendOptions.Windows; opts != nil && opts.WebviewUserDataPath != "" {
@@ -278,6 +426,10 @@ func (f *Frontend) setupChromium() {
 	chromium.NavigationCompletedCallback = f.navigationCompleted
 	chromium.AcceleratorKeyCallback = func(vkey uint) bool {
 		w32.PostMessage(f.mainWindow.Handle(), w32.WM_KEYDOWN, uintptr(vkey), 0)
+		// Escape is only swallowed when it is going to be used to leave fullscreen
+		if vkey == w32.VK_ESCAPE && f.mainWindow.exitFullscreenOnEscape() && f.mainWindow.IsFullScreen() {
+			return true
+		}
 		return false
 	}
 	chromium.Embed(f.mainWindow.Handle())
@@ -314,8 +466,36 @@ func (f *Frontend) setupChromium() {
 	// Set background colour
 	f.WindowSetRGBA(f.frontendOptions.RGBA)
 
-	chromium.SetGlobalPermission(edge.CoreWebView2PermissionStateAllow)
+	if f.frontendOptions.OnPermissionRequest == nil {
+		chromium.SetGlobalPermission(edge.CoreWebView2PermissionStateAllow)
+	} else {
+		err = f.setupPermissionRequests()
+		if err != nil {
+			f.logger.Error("Unable to setup the permission requests: %s", err.Error())
+		}
+	}
 	chromium.AddWebResourceRequestedFilter("*", edge.COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL)
+	f.addDocumentCreatedScripts()
+	if opts := f.frontendOptions.Windows; opts != nil && opts.WindowIconFromFavicon {
+		err = f.setupFavicon()
+		if err != nil {
+			f.logger.Warning("The favicon is not supported by this version of WebView2: %s", err.Error())
+		}
+	}
+	err = f.setupNavigationEvents()
+	if err != nil {
+		f.logger.Error("Unable to setup the navigation events: %s", err.Error())
+	}
+	if opts := f.frontendOptions.Windows; opts != nil && opts.WindowTitleFromDocument {
+		err = f.setupDocumentTitle()
+		if err != nil {
+			f.logger.Error("Unable to setup the document title: %s", err.Error())
+		}
+	}
+	err = f.setupDownloads()
+	if err != nil {
+		f.logger.Warning("Download events are not supported by this version of WebView2: %s", err.Error())
+	}
 	chromium.Navigate(f.startURL)
 }
 
@@ -341,8 +521,7 @@ func (f *Frontend) processRequest(req *edge.ICoreWebView2WebResourceRequest, arg
 	//Get the request
 	uri, _ := req.GetUri()
 
-	var content []byte
-	var mimeType string
+	var assetResponse *assetserver.Response
 
 	// Translate URI to file
 	file, match, err := common.TranslateUriToFile(uri, "file", "wails")
@@ -353,27 +532,31 @@ func (f *Frontend) processRequest(req *edge.ICoreWebView2WebResourceRequest, arg
 		}
 
 		// Load file from asset store
-		content, mimeType, err = f.assets.Load(file)
+		assetResponse, err = f.assets.Serve(file, requestHeaders(req))
 	}
 
+	var content []byte
 	statusCode := 200
-	reasonPhrase := "OK"
+	headers := []string{}
 	if err != nil {
 		if os.IsNotExist(err) {
 			statusCode = 404
-			reasonPhrase = "Not Found"
 		} else {
 			err = fmt.Errorf("Error processing request %s: %w", uri, err)
 			f.logger.Error(err.Error())
 			statusCode = 500
-			reasonPhrase = "Internal Server Error"
+		}
+	} else {
+		content = assetResponse.Body
+		statusCode = assetResponse.StatusCode
+		for name, values := range assetResponse.Header {
+			for _, value := range values {
+				headers = append(headers, name+": "+value)
+			}
 		}
 	}
+	reasonPhrase := http.StatusText(statusCode)
 
-	headers := []string{}
-	if mimeType != "" {
-		headers = append(headers, "Content-Type: "+mimeType)
-	}
 	if content != nil && f.servingFromDisk {
 		headers = append(headers, "Pragma: no-cache")
 	}
@@ -405,7 +588,7 @@ var edgeMap = map[string]uintptr{
 
 func (f *Frontend) processMessage(message string) {
 	if message == "drag" {
-		if !f.mainWindow.IsFullScreen() {
+		if !f.mainWindow.IsFullScreen() && f.mainWindow.dragEnabled() {
 			err := f.startDrag()
 			if err != nil {
 				f.logger.Error(err.Error())
@@ -414,7 +597,7 @@ func (f *Frontend) processMessage(message string) {
 		return
 	}
 	if strings.HasPrefix(message, "resize:") {
-		if !f.mainWindow.IsFullScreen() {
+		if !f.mainWindow.IsFullScreen() && f.mainWindow.edgeResizeEnabled() {
 			sl := strings.Split(message, ":")
 			if len(sl) != 2 {
 				f.logger.Info("Unknown message returned from dispatcher: %+v", message)
@@ -451,7 +634,7 @@ func (f *Frontend) processMessage(message string) {
 }
 
 func (f *Frontend) Callback(message string) {
-	f.mainWindow.Invoke(func() {
+	f.mainWindow.Dispatch(func() {
 		f.chromium.Eval(`window.wails.Callback(` + strconv.Quote(message) + `);`)
 	})
 }
@@ -475,17 +658,20 @@ func (f *Frontend) startResize(border uintptr) error {
 }
 
 func (f *Frontend) ExecJS(js string) {
-	f.mainWindow.Invoke(func() {
+	f.mainWindow.Dispatch(func() {
 		f.chromium.Eval(js)
 	})
 }
 
 func (f *Frontend) navigationCompleted(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationCompletedEventArgs) {
+	f.emitNavigationComplete(args)
+	startup.Record(f.ctx, startup.DomReady)
+
 	if f.frontendOptions.OnDomReady != nil {
 		go f.frontendOptions.OnDomReady(f.ctx)
 	}
 
-	if f.frontendOptions.Frameless && f.frontendOptions.DisableResize == false {
+	if f.frontendOptions.Frameless && f.mainWindow.edgeResizeEnabled() {
 		f.ExecJS("window.wails.flags.enableResize = true;")
 	}
 
@@ -0,0 +1,40 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"os"
+	"strings"
+
+	"github.com/leaanthony/slicer"
+	"github.com/leaanthony/winc/w32"
+)
+
+// The environment variable WebView2 reads additional browser arguments from
+const webview2BrowserArgumentsEnv = "WEBVIEW2_ADDITIONAL_BROWSER_ARGUMENTS"
+
+// disableGPUArguments turn off hardware acceleration in WebView2
+var disableGPUArguments = []string{"--disable-gpu", "--disable-gpu-compositing"}
+
+// configureGPU disables hardware acceleration in the webview if requested, or warns
+// when running in a remote session where it often causes a black window.
+// It must be called before the webview is created.
+func (f *Frontend) configureGPU() {
+	if f.frontendOptions.Windows == nil || !f.frontendOptions.Windows.WebviewDisableGPU {
+		if w32.GetSystemMetrics(w32.SM_REMOTESESSION) != 0 {
+			f.logger.Warning("Running in a remote desktop session. If the window is black, set `Windows.WebviewDisableGPU` to disable hardware acceleration.")
+		}
+		return
+	}
+
+	// Keep any arguments given by the user
+	arguments := slicer.String(strings.Fields(os.Getenv(webview2BrowserArgumentsEnv)))
+	for _, argument := range disableGPUArguments {
+		arguments.AddUnique(argument)
+	}
+	err := os.Setenv(webview2BrowserArgumentsEnv, arguments.Join(" "))
+	if err != nil {
+		f.logger.Error("Unable to disable hardware acceleration: %s", err.Error())
+	}
+}
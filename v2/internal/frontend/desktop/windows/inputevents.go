@@ -0,0 +1,249 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/leaanthony/winc/w32"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/common"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+var (
+	procSetWindowsHookExW     = moduser32.NewProc("SetWindowsHookExW")
+	procWindowFromPoint       = moduser32.NewProc("WindowFromPoint")
+	procGetAncestor           = moduser32.NewProc("GetAncestor")
+	procSetTimer              = moduser32.NewProc("SetTimer")
+	procKillTimer             = moduser32.NewProc("KillTimer")
+	procSystemParametersInfoW = moduser32.NewProc("SystemParametersInfoW")
+)
+
+const (
+	whMouseLL     = 14
+	hcAction      = 0
+	gaRoot        = 2
+	wmMouseHWheel = 0x020E
+
+	wheelDelta             = 120
+	wheelPageScroll        = 0xFFFFFFFF
+	spiGetWheelScrollLines = 0x0068
+	spiGetWheelScrollChars = 0x006C
+
+	// The deltaMode of the wheel events, as in the page
+	domDeltaLine = 1
+	domDeltaPage = 2
+
+	// The wheel events are forwarded at most once per frame, like the events forwarded from the page
+	wheelEventTimerID       = 1
+	wheelEventTimerInterval = 16
+)
+
+// msllHookStruct is the MSLLHOOKSTRUCT structure
+type msllHookStruct struct {
+	pt          w32.POINT
+	mouseData   uint32
+	flags       uint32
+	time        uint32
+	dwExtraInfo uintptr
+}
+
+// The webview handles the wheel in a window of the browser process, so the wheel messages over it never reach the
+// window. They are read with a low level mouse hook, which posts them to the window forwarding the wheel events.
+var (
+	wheelHook       w32.HHOOK
+	wheelHookWindow *Window
+	// The callback is only created once, as a process can only create a limited number of callbacks
+	wheelHookCallback = syscall.NewCallback(wheelHookProc)
+)
+
+// wheelEvent is a wheel event waiting to be forwarded, with the deltas of the frame added together
+type wheelEvent struct {
+	deltaX, deltaY                     float64
+	deltaMode                          int
+	x, y                               float64
+	ctrlKey, shiftKey, altKey, metaKey bool
+}
+
+// wheelEvents is the state of the forwarding of the wheel events
+type wheelEvents struct {
+	enabled bool
+	// The event sent at the end of the frame, or nil
+	pending *wheelEvent
+}
+
+func (f *Frontend) WindowSetInputEvents(events options.InputEvents) {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		err := f.mainWindow.SetWheelEventsForwarded(events.Wheel)
+		if err != nil {
+			f.logger.Error("Unable to forward the wheel events: %s", err.Error())
+		}
+	})
+	// The wheel events are read natively, so the page only forwards the touch events
+	events.Wheel = false
+	f.ExecJS(common.PageInputEventsScript(events))
+}
+
+func (f *Frontend) inputEvent(name string, data interface{}) {
+	go f.emit(name, data)
+}
+
+// SetWheelEventsForwarded sets whether the wheel events over the window are forwarded with onInputEvent
+func (w *Window) SetWheelEventsForwarded(forwarded bool) error {
+	if forwarded == w.wheelEvents.enabled {
+		return nil
+	}
+	if !forwarded {
+		w.flushWheelEvent()
+		w.removeWheelHook()
+		return nil
+	}
+	// The hook is called on this thread, by its message loop
+	hook, _, err := procSetWindowsHookExW.Call(whMouseLL, wheelHookCallback, uintptr(w32.GetModuleHandle("")), 0)
+	if hook == 0 {
+		return err
+	}
+	wheelHook = w32.HHOOK(hook)
+	wheelHookWindow = w
+	w.wheelEvents.enabled = true
+	return nil
+}
+
+// removeWheelHook stops the forwarding of the wheel events, dropping any pending event
+func (w *Window) removeWheelHook() {
+	if !w.wheelEvents.enabled {
+		return
+	}
+	w32.UnhookWindowsHookEx(wheelHook)
+	wheelHook = 0
+	wheelHookWindow = nil
+	procKillTimer.Call(uintptr(w.Handle()), wheelEventTimerID)
+	w.wheelEvents = wheelEvents{}
+}
+
+func wheelHookProc(code int, wparam uintptr, lparam uintptr) uintptr {
+	if code == hcAction && (wparam == w32.WM_MOUSEWHEEL || wparam == wmMouseHWheel) && wheelHookWindow != nil {
+		wheelHookWindow.postWheelOverWebview(uint32(wparam), (*msllHookStruct)(unsafe.Pointer(lparam)))
+	}
+	return uintptr(w32.CallNextHookEx(wheelHook, code, w32.WPARAM(wparam), w32.LPARAM(lparam)))
+}
+
+// postWheelOverWebview posts the wheel message seen by the hook to the window if it is over the webview. The wheel
+// messages over the windows of this process are received by the window itself.
+func (w *Window) postWheelOverWebview(msg uint32, info *msllHookStruct) {
+	hwnd := windowFromPoint(info.pt)
+	if hwnd == 0 {
+		return
+	}
+	root, _, _ := procGetAncestor.Call(uintptr(hwnd), gaRoot)
+	if w32.HWND(root) != w.Handle() {
+		return
+	}
+	if _, pid := w32.GetWindowThreadProcessId(hwnd); pid == os.Getpid() {
+		return
+	}
+	// Like WM_MOUSEWHEEL, the delta is in the high word of wparam and the screen position is in lparam
+	lparam := uintptr(uint16(info.pt.X)) | uintptr(uint16(info.pt.Y))<<16
+	w32.PostMessage(w.Handle(), msg, uintptr(info.mouseData&0xFFFF0000), lparam)
+}
+
+// addWheelEvent adds the WM_MOUSEWHEEL or WM_MOUSEHWHEEL message to the wheel event sent at the end of the frame
+func (w *Window) addWheelEvent(msg uint32, wparam uintptr, lparam uintptr) {
+	notches := float64(int16(w32.HIWORD(uint32(wparam)))) / wheelDelta
+	deltaMode := domDeltaLine
+	var deltaX, deltaY float64
+	if msg == wmMouseHWheel {
+		deltaX = notches * float64(systemParameter(spiGetWheelScrollChars, 3))
+	} else {
+		// Scrolling up is a positive delta on Windows and a negative one in the page
+		lines := systemParameter(spiGetWheelScrollLines, 3)
+		if lines == wheelPageScroll {
+			deltaMode = domDeltaPage
+			deltaY = -notches
+		} else {
+			deltaY = -notches * float64(lines)
+		}
+	}
+
+	if w.wheelEvents.pending != nil && w.wheelEvents.pending.deltaMode != deltaMode {
+		w.flushWheelEvent()
+	}
+	event := w.wheelEvents.pending
+	if event == nil {
+		event = &wheelEvent{deltaMode: deltaMode}
+		w.wheelEvents.pending = event
+		procSetTimer.Call(uintptr(w.Handle()), wheelEventTimerID, wheelEventTimerInterval, 0)
+	}
+	event.deltaX += deltaX
+	event.deltaY += deltaY
+
+	// The position is in the CSS pixels of the page
+	x, y, _ := w32.ScreenToClient(w.Handle(), int(int16(w32.LOWORD(uint32(lparam)))), int(int16(w32.HIWORD(uint32(lparam)))))
+	scale := 1.0
+	if w.dpi != 0 {
+		scale = float64(w.dpi) / 96
+	}
+	event.x = float64(x) / scale
+	event.y = float64(y) / scale
+	event.ctrlKey = keyPressed(w32.VK_CONTROL)
+	event.shiftKey = keyPressed(w32.VK_SHIFT)
+	event.altKey = keyPressed(w32.VK_MENU)
+	event.metaKey = keyPressed(w32.VK_LWIN) || keyPressed(w32.VK_RWIN)
+}
+
+// flushWheelEvent sends the pending wheel event
+func (w *Window) flushWheelEvent() {
+	procKillTimer.Call(uintptr(w.Handle()), wheelEventTimerID)
+	event := w.wheelEvents.pending
+	if event == nil {
+		return
+	}
+	w.wheelEvents.pending = nil
+	if w.onInputEvent == nil {
+		return
+	}
+	// The data has the same fields and types as the events forwarded from the page
+	w.onInputEvent(frontend.InputWheelEvent, map[string]interface{}{
+		"deltaX":    event.deltaX,
+		"deltaY":    event.deltaY,
+		"deltaZ":    0.0,
+		"deltaMode": float64(event.deltaMode),
+		"x":         event.x,
+		"y":         event.y,
+		"ctrlKey":   event.ctrlKey,
+		"shiftKey":  event.shiftKey,
+		"altKey":    event.altKey,
+		"metaKey":   event.metaKey,
+	})
+}
+
+func keyPressed(key int32) bool {
+	return w32.GetKeyState(key) < 0
+}
+
+// windowFromPoint calls WindowFromPoint, which takes the POINT structure by value
+func windowFromPoint(pt w32.POINT) w32.HWND {
+	var hwnd uintptr
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		hwnd, _, _ = procWindowFromPoint.Call(uintptr(uint32(pt.X)) | uintptr(uint32(pt.Y))<<32)
+	} else {
+		hwnd, _, _ = procWindowFromPoint.Call(uintptr(pt.X), uintptr(pt.Y))
+	}
+	return w32.HWND(hwnd)
+}
+
+// systemParameter returns the value of the SystemParametersInfo setting, or the fallback if it can't be read
+func systemParameter(action uint32, fallback uint32) uint32 {
+	var value uint32
+	ok, _, _ := procSystemParametersInfoW.Call(uintptr(action), 0, uintptr(unsafe.Pointer(&value)), 0)
+	if ok == 0 {
+		return fallback
+	}
+	return value
+}
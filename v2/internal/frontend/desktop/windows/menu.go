@@ -45,9 +45,10 @@ func (w *Window) SetApplicationMenu(menu *menu.Menu) {
 }
 
 func processMenu(window *Window, menu *menu.Menu) {
+	menu.ResolveLabels()
 	mainMenu := window.NewMenu()
 	for _, menuItem := range menu.Items {
-		submenu := mainMenu.AddSubMenu(menuItem.Label)
+		submenu := mainMenu.AddSubMenu(menuItem.DisplayLabel())
 		for _, menuItem := range menuItem.SubMenu.Items {
 			processMenuItem(submenu, menuItem)
 		}
@@ -64,7 +65,7 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 		parent.AddSeparator()
 	case menu.TextType:
 		shortcut := acceleratorToWincShortcut(menuItem.Accelerator)
-		newItem := parent.AddItem(menuItem.Label, shortcut)
+		newItem := parent.AddItem(menuItem.DisplayLabel(), shortcut)
 		//if menuItem.Tooltip != "" {
 		//	newItem.SetToolTip(menuItem.Tooltip)
 		//}
@@ -79,7 +80,7 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 
 	case menu.CheckboxType:
 		shortcut := acceleratorToWincShortcut(menuItem.Accelerator)
-		newItem := parent.AddItem(menuItem.Label, shortcut)
+		newItem := parent.AddItem(menuItem.DisplayLabel(), shortcut)
 		newItem.SetCheckable(true)
 		newItem.SetChecked(menuItem.Checked)
 		//if menuItem.Tooltip != "" {
@@ -97,7 +98,7 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 		addCheckBoxToMap(menuItem, newItem)
 	case menu.RadioType:
 		shortcut := acceleratorToWincShortcut(menuItem.Accelerator)
-		newItem := parent.AddItemRadio(menuItem.Label, shortcut)
+		newItem := parent.AddItemRadio(menuItem.DisplayLabel(), shortcut)
 		newItem.SetCheckable(true)
 		newItem.SetChecked(menuItem.Checked)
 		//if menuItem.Tooltip != "" {
@@ -114,7 +115,7 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 		newItem.SetEnabled(!menuItem.Disabled)
 		addRadioItemToMap(menuItem, newItem)
 	case menu.SubmenuType:
-		submenu := parent.AddSubMenu(menuItem.Label)
+		submenu := parent.AddSubMenu(menuItem.DisplayLabel())
 		for _, menuItem := range menuItem.SubMenu.Items {
 			processMenuItem(submenu, menuItem)
 		}
@@ -122,9 +123,13 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 }
 
 func (f *Frontend) MenuSetApplicationMenu(menu *menu.Menu) {
-	f.mainWindow.SetApplicationMenu(menu)
+	f.mainWindow.Dispatch(func() {
+		f.mainWindow.SetApplicationMenu(menu)
+	})
 }
 
 func (f *Frontend) MenuUpdateApplicationMenu() {
-	processMenu(f.mainWindow, f.mainWindow.applicationMenu)
+	f.mainWindow.Dispatch(func() {
+		processMenu(f.mainWindow, f.mainWindow.applicationMenu)
+	})
 }
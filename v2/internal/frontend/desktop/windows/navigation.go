@@ -0,0 +1,138 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	"github.com/leaanthony/go-webview2/pkg/edge"
+	"github.com/wailsapp/wails/v2/internal/com"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+const (
+	// ICoreWebView2Controller
+	controllerMoveFocus       = 12
+	controllerGetCoreWebView2 = 25
+	// ICoreWebView2
+	webviewGetSource             = 4
+	webviewAddNavigationStarting = 7
+	webviewAddNewWindowRequested = 44
+	// ICoreWebView2NavigationStartingEventArgs
+	navigationStartingGetURI          = 3
+	navigationStartingGetIsRedirected = 5
+	navigationStartingPutCancel       = 8
+	// ICoreWebView2NewWindowRequestedEventArgs
+	newWindowRequestedGetURI     = 3
+	newWindowRequestedPutHandled = 6
+	// ICoreWebView2NavigationCompletedEventArgs
+	navigationCompletedGetIsSuccess = 3
+
+	// COREWEBVIEW2_MOVE_FOCUS_REASON
+	moveFocusReasonProgrammatic = 0
+)
+
+// coreWebView returns the ICoreWebView2 interface of the webview, for the events that
+// aren't provided by the chromium package
+func (f *Frontend) coreWebView() (*com.Object, error) {
+	if f.webview != nil {
+		return f.webview, nil
+	}
+	controller := (*com.Object)(unsafe.Pointer(f.chromium.GetController()))
+	var webview *com.Object
+	err := controller.Call(controllerGetCoreWebView2, uintptr(unsafe.Pointer(&webview)))
+	if err != nil {
+		return nil, err
+	}
+	f.webview = webview
+	return webview, nil
+}
+
+// setupNavigationEvents applies the navigation policy and emits the navigation events when the top level
+// document of the webview changes
+func (f *Frontend) setupNavigationEvents() error {
+	webview, err := f.coreWebView()
+	if err != nil {
+		return err
+	}
+	f.navigationStartingHandler = com.NewEventHandler(f.navigationStarting)
+	_, err = webview.AddEventHandler(webviewAddNavigationStarting, f.navigationStartingHandler)
+	if err != nil {
+		return err
+	}
+	f.newWindowRequestedHandler = com.NewEventHandler(f.newWindowRequested)
+	_, err = webview.AddEventHandler(webviewAddNewWindowRequested, f.newWindowRequestedHandler)
+	return err
+}
+
+func (f *Frontend) navigationStarting(webview *com.Object, args *com.Object) error {
+	uri, err := args.GetString(navigationStartingGetURI)
+	if err != nil {
+		return err
+	}
+	redirected, err := args.GetBool(navigationStartingGetIsRedirected)
+	if err != nil {
+		return err
+	}
+	current, err := webview.GetString(webviewGetSource)
+	if err != nil {
+		return err
+	}
+
+	// Navigations requested with WindowLoadURL have already been checked, and redirects
+	// follow the decision for the URL they were redirected from
+	exempt := f.loadURLRequested || redirected
+	f.loadURLRequested = false
+	action := frontend.DecideNavigation(f.ctx, f.frontendOptions, f.startURL, current, uri, exempt)
+	switch action {
+	case options.NavigationCancel, options.NavigationOpenInBrowser:
+		if action == options.NavigationOpenInBrowser {
+			go f.BrowserOpenURL(uri)
+		}
+		return args.Call(navigationStartingPutCancel, 1)
+	}
+
+	f.navigationURL = uri
+	f.emit(frontend.NavigationStartEvent, uri)
+	return nil
+}
+
+// newWindowRequested applies the navigation policy to links opening a new window, EG: with `target="_blank"`
+func (f *Frontend) newWindowRequested(webview *com.Object, args *com.Object) error {
+	uri, err := args.GetString(newWindowRequestedGetURI)
+	if err != nil {
+		return err
+	}
+	current, err := webview.GetString(webviewGetSource)
+	if err != nil {
+		return err
+	}
+
+	action := frontend.DecideNavigation(f.ctx, f.frontendOptions, f.startURL, current, uri, false)
+	switch action {
+	case options.NavigationCancel, options.NavigationOpenInBrowser:
+		if action == options.NavigationOpenInBrowser {
+			go f.BrowserOpenURL(uri)
+		}
+		return args.Call(newWindowRequestedPutHandled, 1)
+	}
+	return nil
+}
+
+func (f *Frontend) emitNavigationComplete(args *edge.ICoreWebView2NavigationCompletedEventArgs) {
+	success, err := (*com.Object)(unsafe.Pointer(args)).GetBool(navigationCompletedGetIsSuccess)
+	if err != nil {
+		f.logger.Error("Unable to get the result of the navigation: %s", err.Error())
+		return
+	}
+	f.emit(frontend.NavigationCompleteEvent, f.navigationURL, success)
+}
+
+// emit emits the event to the listeners in Go and the frontend
+func (f *Frontend) emit(name string, data ...interface{}) {
+	if events, ok := f.ctx.Value("events").(frontend.Events); ok {
+		events.Emit(name, data...)
+	}
+}
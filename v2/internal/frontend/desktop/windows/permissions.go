@@ -0,0 +1,87 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"net/url"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/com"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+const (
+	// ICoreWebView2
+	webviewAddPermissionRequested = 23
+	// ICoreWebView2PermissionRequestedEventArgs
+	permissionRequestedGetURI             = 3
+	permissionRequestedGetPermissionKind  = 4
+	permissionRequestedGetIsUserInitiated = 5
+	permissionRequestedPutState           = 7
+	permissionRequestedGetDeferral        = 8
+	// ICoreWebView2Deferral
+	deferralComplete = 3
+)
+
+// setupPermissionRequests consults OnPermissionRequest when a page requests a permission
+func (f *Frontend) setupPermissionRequests() error {
+	webview, err := f.coreWebView()
+	if err != nil {
+		return err
+	}
+	f.permissionRequestedHandler = com.NewEventHandler(f.permissionRequested)
+	_, err = webview.AddEventHandler(webviewAddPermissionRequested, f.permissionRequestedHandler)
+	return err
+}
+
+func (f *Frontend) permissionRequested(_ *com.Object, args *com.Object) error {
+	uri, err := args.GetString(permissionRequestedGetURI)
+	if err != nil {
+		return err
+	}
+	var kind int32
+	err = args.Call(permissionRequestedGetPermissionKind, uintptr(unsafe.Pointer(&kind)))
+	if err != nil {
+		return err
+	}
+	userInitiated, err := args.GetBool(permissionRequestedGetIsUserInitiated)
+	if err != nil {
+		return err
+	}
+	request := options.PermissionRequest{
+		Origin:        origin(uri),
+		Kind:          options.PermissionKind(kind),
+		UserInitiated: userInitiated,
+	}
+
+	// The request is decided asynchronously, so the callback can prompt the user
+	var deferral *com.Object
+	err = args.Call(permissionRequestedGetDeferral, uintptr(unsafe.Pointer(&deferral)))
+	if err != nil {
+		return err
+	}
+	args.AddRef()
+	go func() {
+		state := f.frontendOptions.OnPermissionRequest(f.ctx, request)
+		f.mainWindow.Dispatch(func() {
+			defer args.Release()
+			defer deferral.Release()
+			err := args.Call(permissionRequestedPutState, uintptr(state))
+			if err != nil {
+				f.logger.Error("Unable to set the permission state: %s", err.Error())
+			}
+			_ = deferral.Call(deferralComplete)
+		})
+	}()
+	return nil
+}
+
+// origin returns the origin of the URL, EG: "https://example.com"
+func origin(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	return parsed.Scheme + "://" + parsed.Host
+}
@@ -0,0 +1,137 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"encoding/json"
+	"unsafe"
+
+	"github.com/leaanthony/winc/w32"
+	"github.com/wailsapp/wails/v2/internal/settings"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// placementSetting is the key of the window placement in the settings store
+const placementSetting = "wails:window:placement"
+
+// wpfRestoreToMaximized is set in the placement of a minimised window that is maximised when it is restored
+const wpfRestoreToMaximized = 0x0002
+
+// windowPlacement is the placement of the window saved by RememberWindowPlacement.
+// The bounds are those of the window when it isn't maximised, so un-maximising the restored window returns it to
+// the size it had before it was maximised.
+type windowPlacement struct {
+	X         int  `json:"x"`
+	Y         int  `json:"y"`
+	Width     int  `json:"width"`
+	Height    int  `json:"height"`
+	Maximised bool `json:"maximised"`
+}
+
+func (f *Frontend) rememberWindowPlacement() bool {
+	return f.frontendOptions.Windows != nil && f.frontendOptions.Windows.RememberWindowPlacement
+}
+
+func (f *Frontend) settingsStore() *settings.Store {
+	store, _ := f.ctx.Value("settings").(*settings.Store)
+	return store
+}
+
+// restoreWindowPlacement moves the hidden window to the bounds it had when the application last quit, and maximises
+// it when it is shown if it was maximised then. Returns false if there is no saved placement on a connected monitor.
+func (f *Frontend) restoreWindowPlacement() bool {
+	store := f.settingsStore()
+	if store == nil {
+		return false
+	}
+	value, exists, err := store.Get(placementSetting)
+	if err != nil {
+		f.logger.Warning("Unable to read the window placement: %s", err.Error())
+		return false
+	}
+	if !exists {
+		return false
+	}
+	var placement windowPlacement
+	err = json.Unmarshal(value, &placement)
+	if err != nil || placement.Width <= 0 || placement.Height <= 0 {
+		return false
+	}
+
+	wp := placement.toWindowPlacement()
+	// Monitors may have been disconnected since the placement was saved
+	bounds := workspaceToScreen(wp.RcNormalPosition)
+	if w32.MonitorFromRect(&bounds, w32.MONITOR_DEFAULTTONULL) == 0 {
+		return false
+	}
+	// The window is left hidden, so it is shown in the start state once the page has loaded
+	wp.ShowCmd = w32.SW_HIDE
+	if !w32.SetWindowPlacement(f.mainWindow.Handle(), &wp) {
+		return false
+	}
+	if placement.Maximised && f.frontendOptions.WindowStartState == options.Normal && !f.frontendOptions.Fullscreen {
+		f.frontendOptions.WindowStartState = options.Maximised
+	}
+	return true
+}
+
+// saveWindowPlacement saves the placement of the window, to be restored the next time the application starts.
+// The placement isn't saved while the window is fullscreen, so the last placement before it went fullscreen is kept.
+func (f *Frontend) saveWindowPlacement() {
+	store := f.settingsStore()
+	if store == nil || f.mainWindow.IsFullScreen() {
+		return
+	}
+	var wp w32.WINDOWPLACEMENT
+	wp.Length = uint32(unsafe.Sizeof(wp))
+	if !w32.GetWindowPlacement(f.mainWindow.Handle(), &wp) {
+		return
+	}
+	err := store.Set(placementSetting, placementFromWindowPlacement(wp))
+	if err != nil {
+		f.logger.Warning("Unable to save the window placement: %s", err.Error())
+	}
+}
+
+func placementFromWindowPlacement(wp w32.WINDOWPLACEMENT) windowPlacement {
+	rect := wp.RcNormalPosition
+	return windowPlacement{
+		X:         int(rect.Left),
+		Y:         int(rect.Top),
+		Width:     int(rect.Right - rect.Left),
+		Height:    int(rect.Bottom - rect.Top),
+		Maximised: wp.ShowCmd == w32.SW_SHOWMAXIMIZED || (wp.ShowCmd == w32.SW_SHOWMINIMIZED && wp.Flags&wpfRestoreToMaximized != 0),
+	}
+}
+
+func (p windowPlacement) toWindowPlacement() w32.WINDOWPLACEMENT {
+	wp := w32.WINDOWPLACEMENT{
+		ShowCmd: w32.SW_SHOWNORMAL,
+		RcNormalPosition: w32.RECT{
+			Left:   int32(p.X),
+			Top:    int32(p.Y),
+			Right:  int32(p.X + p.Width),
+			Bottom: int32(p.Y + p.Height),
+		},
+	}
+	wp.Length = uint32(unsafe.Sizeof(wp))
+	if p.Maximised {
+		wp.ShowCmd = w32.SW_SHOWMAXIMIZED
+	}
+	return wp
+}
+
+// workspaceToScreen converts the bounds of a WINDOWPLACEMENT, which are relative to the work area of the primary
+// monitor, to screen coordinates
+func workspaceToScreen(rect w32.RECT) w32.RECT {
+	monitor := w32.MonitorFromPoint(0, 0, w32.MONITOR_DEFAULTTOPRIMARY)
+	var monitorInfo w32.MONITORINFO
+	monitorInfo.CbSize = uint32(unsafe.Sizeof(monitorInfo))
+	if !w32.GetMonitorInfo(monitor, &monitorInfo) {
+		return rect
+	}
+	dx := monitorInfo.RcWork.Left - monitorInfo.RcMonitor.Left
+	dy := monitorInfo.RcWork.Top - monitorInfo.RcMonitor.Top
+	return w32.RECT{Left: rect.Left + dx, Top: rect.Top + dy, Right: rect.Right + dx, Bottom: rect.Bottom + dy}
+}
@@ -0,0 +1,41 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/leaanthony/winc/w32"
+)
+
+func TestWindowPlacement(t *testing.T) {
+	normal := w32.RECT{Left: 100, Top: 50, Right: 900, Bottom: 650}
+	tests := []struct {
+		name    string
+		showCmd uint32
+		flags   uint32
+		want    windowPlacement
+	}{
+		{"normal", w32.SW_SHOWNORMAL, 0, windowPlacement{X: 100, Y: 50, Width: 800, Height: 600}},
+		{"maximised", w32.SW_SHOWMAXIMIZED, 0, windowPlacement{X: 100, Y: 50, Width: 800, Height: 600, Maximised: true}},
+		{"minimised", w32.SW_SHOWMINIMIZED, 0, windowPlacement{X: 100, Y: 50, Width: 800, Height: 600}},
+		{"minimised from maximised", w32.SW_SHOWMINIMIZED, wpfRestoreToMaximized, windowPlacement{X: 100, Y: 50, Width: 800, Height: 600, Maximised: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := placementFromWindowPlacement(w32.WINDOWPLACEMENT{ShowCmd: tt.showCmd, Flags: tt.flags, RcNormalPosition: normal})
+			if got != tt.want {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+			// The normal bounds are restored whether or not the window was maximised
+			wp := got.toWindowPlacement()
+			if wp.RcNormalPosition != normal {
+				t.Errorf("expected the normal bounds %+v, got %+v", normal, wp.RcNormalPosition)
+			}
+			if maximised := wp.ShowCmd == w32.SW_SHOWMAXIMIZED; maximised != tt.want.Maximised {
+				t.Errorf("expected maximised to be %v, got %v", tt.want.Maximised, maximised)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"runtime"
+)
+
+var (
+	procSetThreadExecutionState = modkernel32.NewProc("SetThreadExecutionState")
+)
+
+const (
+	esSystemRequired  = 0x00000001
+	esDisplayRequired = 0x00000002
+	esContinuous      = 0x80000000
+)
+
+// presentationMode is the state of the window restored when presentation mode is left
+type presentationMode struct {
+	active bool
+	// Set if the window was already fullscreen, so it is left fullscreen
+	wasFullscreen bool
+	// The execution state of the UI thread before it was set to keep the display on
+	executionState uintptr
+}
+
+// WindowEnterPresentationMode makes the window fullscreen, stops the display turning off and the computer sleeping,
+// and hides the cursor while it is over the window
+func (f *Frontend) WindowEnterPresentationMode() {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		presentation := &f.presentationMode
+		if presentation.active {
+			return
+		}
+		presentation.active = true
+		presentation.wasFullscreen = f.mainWindow.IsFullScreen()
+		if !presentation.wasFullscreen {
+			f.WindowFullscreen()
+		}
+		// The execution state belongs to the UI thread, which runs until the application quits
+		presentation.executionState = setThreadExecutionState(esContinuous | esSystemRequired | esDisplayRequired)
+		f.mainWindow.cursor.presentationHidden = true
+		f.mainWindow.refreshCursor()
+	})
+}
+
+// WindowExitPresentationMode restores the state of the window from before presentation mode was entered
+func (f *Frontend) WindowExitPresentationMode() {
+	runtime.LockOSThread()
+	f.mainWindow.Dispatch(func() {
+		presentation := &f.presentationMode
+		if !presentation.active {
+			return
+		}
+		presentation.active = false
+		if !presentation.wasFullscreen {
+			f.WindowUnFullscreen()
+		}
+		state := presentation.executionState
+		if state == 0 {
+			// The previous state isn't known, so the display and computer are allowed to sleep again
+			state = esContinuous
+		}
+		setThreadExecutionState(state)
+		f.mainWindow.cursor.presentationHidden = false
+		f.mainWindow.refreshCursor()
+	})
+}
+
+// escapeInFullscreen leaves presentation mode, or fullscreen if not presenting, when Escape is pressed
+func (f *Frontend) escapeInFullscreen() {
+	if f.presentationMode.active {
+		f.WindowExitPresentationMode()
+		return
+	}
+	f.WindowUnFullscreen()
+}
+
+// setThreadExecutionState sets the execution state of the calling thread, returning the previous state, or 0 if
+// it failed
+func setThreadExecutionState(state uintptr) uintptr {
+	previous, _, _ := procSetThreadExecutionState.Call(state)
+	return previous
+}
@@ -0,0 +1,86 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"net/http"
+	"syscall"
+	"unsafe"
+
+	"github.com/leaanthony/go-webview2/pkg/edge"
+	"golang.org/x/sys/windows"
+)
+
+// forwardedRequestHeaders are the request headers passed on to the asset server
+var forwardedRequestHeaders = []string{"Accept-Encoding", "If-None-Match", "Range", "If-Range"}
+
+// The webview2 package doesn't expose the headers of a request, so the interfaces
+// used to read them are declared here in the same way as the package declares them.
+
+type webResourceRequestVtbl struct {
+	QueryInterface edge.ComProc
+	AddRef         edge.ComProc
+	Release        edge.ComProc
+	GetUri         edge.ComProc
+	PutUri         edge.ComProc
+	GetMethod      edge.ComProc
+	PutMethod      edge.ComProc
+	GetContent     edge.ComProc
+	PutContent     edge.ComProc
+	GetHeaders     edge.ComProc
+}
+
+type webResourceRequest struct {
+	vtbl *webResourceRequestVtbl
+}
+
+type httpRequestHeadersVtbl struct {
+	QueryInterface edge.ComProc
+	AddRef         edge.ComProc
+	Release        edge.ComProc
+	GetHeader      edge.ComProc
+	Contains       edge.ComProc
+	SetHeader      edge.ComProc
+	RemoveHeader   edge.ComProc
+	GetIterator    edge.ComProc
+}
+
+type httpRequestHeaders struct {
+	vtbl *httpRequestHeadersVtbl
+}
+
+// requestHeaders returns the headers of the request that are forwarded to the asset server
+func requestHeaders(req *edge.ICoreWebView2WebResourceRequest) http.Header {
+	result := http.Header{}
+	request := (*webResourceRequest)(unsafe.Pointer(req))
+	var headers *httpRequestHeaders
+	hr, _, _ := request.vtbl.GetHeaders.Call(uintptr(unsafe.Pointer(request)), uintptr(unsafe.Pointer(&headers)))
+	if failed(hr) {
+		return result
+	}
+	defer headers.vtbl.Release.Call(uintptr(unsafe.Pointer(headers)))
+
+	for _, name := range forwardedRequestHeaders {
+		namePtr, err := syscall.UTF16PtrFromString(name)
+		if err != nil {
+			continue
+		}
+		var value *uint16
+		hr, _, _ := headers.vtbl.GetHeader.Call(uintptr(unsafe.Pointer(headers)), uintptr(unsafe.Pointer(namePtr)), uintptr(unsafe.Pointer(&value)))
+		// GetHeader fails if the request doesn't have the header
+		if failed(hr) {
+			continue
+		}
+		if header := windows.UTF16PtrToString(value); header != "" {
+			result.Set(name, header)
+		}
+		windows.CoTaskMemFree(unsafe.Pointer(value))
+	}
+	return result
+}
+
+// failed returns true if the HRESULT is a failure
+func failed(hr uintptr) bool {
+	return int32(hr) < 0
+}
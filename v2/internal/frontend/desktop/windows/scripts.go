@@ -0,0 +1,45 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"encoding/json"
+)
+
+// addDocumentCreatedScripts registers the stylesheets and scripts given in the options to be run
+// when each document is created, before any of its own scripts, including the Wails runtime.
+// Stylesheets are added first, then the scripts are run in the order given.
+func (f *Frontend) addDocumentCreatedScripts() {
+	opts := f.frontendOptions.Windows
+	if opts == nil {
+		return
+	}
+	for _, css := range opts.WebviewStylesOnLoad {
+		f.chromium.Init(styleInjectionScript(css))
+	}
+	for _, script := range opts.WebviewScriptsOnLoad {
+		f.chromium.Init(script)
+	}
+}
+
+// styleInjectionScript returns a script that adds the given CSS to the document as a stylesheet.
+// The document is empty when the script runs, so the style element is added as soon as the root element exists.
+func styleInjectionScript(css string) string {
+	cssString, _ := json.Marshal(css)
+	return `(function() {
+	var style = document.createElement('style');
+	style.textContent = ` + string(cssString) + `;
+	var add = function() { (document.head || document.documentElement).appendChild(style); };
+	if (document.documentElement) {
+		add();
+		return;
+	}
+	new MutationObserver(function(mutations, observer) {
+		if (document.documentElement) {
+			observer.disconnect();
+			add();
+		}
+	}).observe(document, {childList: true});
+})();`
+}
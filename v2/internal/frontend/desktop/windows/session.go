@@ -0,0 +1,64 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/leaanthony/winc"
+	"github.com/leaanthony/winc/w32"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+var (
+	procShutdownBlockReasonCreate = moduser32.NewProc("ShutdownBlockReasonCreate")
+)
+
+// endSessionTimeout is how long OnBeforeClose is given to save the state of the application when the session ends
+const endSessionTimeout = 5 * time.Second
+
+// queryEndSession is called on the UI thread when the user logs off or shuts down the computer.
+// The frontend is told, so it can save its work while Windows asks the other applications.
+func (f *Frontend) queryEndSession() {
+	if f.frontendOptions.Windows != nil && f.frontendOptions.Windows.EndSessionBlockReason != "" {
+		// Windows shows the reason if the application is still saving when it wants to end the session.
+		// It is removed when the window is destroyed.
+		err := shutdownBlockReasonCreate(f.mainWindow.Handle(), f.frontendOptions.Windows.EndSessionBlockReason)
+		if err != nil {
+			f.logger.Warning("Unable to set the shutdown block reason: %s", err.Error())
+		}
+	}
+	f.emit(frontend.EndSessionEvent)
+}
+
+// endSession is called on the UI thread when the session is ending. Windows may end the process once it returns,
+// so OnBeforeClose is called, and waited for, before quitting. The session can't be kept alive, so the value
+// returned by OnBeforeClose is ignored.
+func (f *Frontend) endSession() {
+	if f.frontendOptions.OnBeforeClose != nil {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			f.frontendOptions.OnBeforeClose(f.ctx)
+		}()
+		if !f.mainWindow.waitDispatching(done, endSessionTimeout) {
+			f.logger.Warning("OnBeforeClose didn't return before the session ended")
+		}
+	}
+	winc.Exit()
+}
+
+func shutdownBlockReasonCreate(hwnd w32.HWND, reason string) error {
+	reasonPtr, err := syscall.UTF16PtrFromString(reason)
+	if err != nil {
+		return err
+	}
+	ret, _, err := procShutdownBlockReasonCreate.Call(uintptr(hwnd), uintptr(unsafe.Pointer(reasonPtr)))
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"github.com/leaanthony/winc"
+	"github.com/leaanthony/winc/w32"
+)
+
+// The size constraints are applied by the window rather than the form, so they are scaled with the DPI of the window
+// consistently, or not at all if SizeConstraintsInPixels is set
+
+func (w *Window) sizeConstraintsInPixels() bool {
+	return w.frontendOptions.Windows != nil && w.frontendOptions.Windows.SizeConstraintsInPixels
+}
+
+func (w *Window) SetMinSize(minWidth int, minHeight int) {
+	w.minWidth = minWidth
+	w.minHeight = minHeight
+	// The maximum size can't be less than the minimum size
+	if w.maxWidth > 0 && w.maxWidth < w.minWidth {
+		w.maxWidth = w.minWidth
+	}
+	if w.maxHeight > 0 && w.maxHeight < w.minHeight {
+		w.maxHeight = w.minHeight
+	}
+	w.applySizeConstraints()
+}
+
+func (w *Window) SetMaxSize(maxWidth int, maxHeight int) {
+	w.maxWidth = maxWidth
+	w.maxHeight = maxHeight
+	// The minimum size can't be more than the maximum size
+	if w.maxWidth > 0 && w.minWidth > w.maxWidth {
+		w.minWidth = w.maxWidth
+	}
+	if w.maxHeight > 0 && w.minHeight > w.maxHeight {
+		w.minHeight = w.maxHeight
+	}
+	w.applySizeConstraints()
+}
+
+// SetSize sets the size of the window, given at 96 DPI, within the size constraints
+func (w *Window) SetSize(width, height int) {
+	dpiX, dpiY := w.GetWindowDPI()
+	width, height = winc.ScaleWithDPI(width, dpiX), winc.ScaleWithDPI(height, dpiY)
+	width, height = w.clampSize(width, height)
+	x, y := w.Pos()
+	w32.MoveWindow(w.Handle(), x, y, width, height, true)
+}
+
+// applySizeConstraints resizes the window if it is outside the size constraints
+func (w *Window) applySizeConstraints() {
+	width, height := w.Size()
+	clampedWidth, clampedHeight := w.clampSize(width, height)
+	if clampedWidth != width || clampedHeight != height {
+		x, y := w.Pos()
+		w32.MoveWindow(w.Handle(), x, y, clampedWidth, clampedHeight, true)
+	}
+}
+
+// clampSize clamps a size in pixels to the size constraints
+func (w *Window) clampSize(width, height int) (int, int) {
+	minSize, maxSize := w.trackSizes()
+	return clampToTrackSizes(width, minSize.X, maxSize.X), clampToTrackSizes(height, minSize.Y, maxSize.Y)
+}
+
+// trackSizes returns the size constraints of the window in pixels. The constraints are lifted while the window is
+// fullscreen.
+func (w *Window) trackSizes() (minSize, maxSize w32.POINT) {
+	if w.fullscreen {
+		return w32.POINT{}, w32.POINT{}
+	}
+	dpiX, dpiY := w.GetWindowDPI()
+	return scaleSizeConstraints(w.minWidth, w.minHeight, w.maxWidth, w.maxHeight, dpiX, dpiY, w.sizeConstraintsInPixels())
+}
+
+// getMinMaxInfo applies the size constraints for WM_GETMINMAXINFO. Returns false if the window has none.
+func (w *Window) getMinMaxInfo(info *w32.MINMAXINFO) bool {
+	minSize, maxSize := w.trackSizes()
+	if minSize.X > 0 {
+		info.PtMinTrackSize.X = minSize.X
+	}
+	if minSize.Y > 0 {
+		info.PtMinTrackSize.Y = minSize.Y
+	}
+	if maxSize.X > 0 {
+		info.PtMaxTrackSize.X = maxSize.X
+	}
+	if maxSize.Y > 0 {
+		info.PtMaxTrackSize.Y = maxSize.Y
+	}
+	return minSize != w32.POINT{} || maxSize != w32.POINT{}
+}
+
+// scaleSizeConstraints returns the size constraints in pixels for the given DPI. Unless inPixels is set, the
+// constraints are given at 96 DPI and scaled. A constraint of 0 isn't applied.
+func scaleSizeConstraints(minWidth, minHeight, maxWidth, maxHeight int, dpiX, dpiY uint, inPixels bool) (minSize, maxSize w32.POINT) {
+	if !inPixels {
+		minWidth, maxWidth = winc.ScaleWithDPI(minWidth, dpiX), winc.ScaleWithDPI(maxWidth, dpiX)
+		minHeight, maxHeight = winc.ScaleWithDPI(minHeight, dpiY), winc.ScaleWithDPI(maxHeight, dpiY)
+	}
+	return w32.POINT{X: int32(minWidth), Y: int32(minHeight)}, w32.POINT{X: int32(maxWidth), Y: int32(maxHeight)}
+}
+
+func clampToTrackSizes(size int, min, max int32) int {
+	if min > 0 && size < int(min) {
+		size = int(min)
+	}
+	if max > 0 && size > int(max) {
+		size = int(max)
+	}
+	return size
+}
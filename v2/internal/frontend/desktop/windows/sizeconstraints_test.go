@@ -0,0 +1,54 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/leaanthony/winc/w32"
+)
+
+func TestScaleSizeConstraints(t *testing.T) {
+	tests := []struct {
+		name     string
+		dpi      uint
+		inPixels bool
+		wantMin  w32.POINT
+		wantMax  w32.POINT
+	}{
+		{"scaled at 100%", 96, false, w32.POINT{X: 400, Y: 300}, w32.POINT{X: 1024, Y: 0}},
+		{"scaled at 150%", 144, false, w32.POINT{X: 600, Y: 450}, w32.POINT{X: 1536, Y: 0}},
+		{"scaled at 200%", 192, false, w32.POINT{X: 800, Y: 600}, w32.POINT{X: 2048, Y: 0}},
+		{"pixels at 100%", 96, true, w32.POINT{X: 400, Y: 300}, w32.POINT{X: 1024, Y: 0}},
+		{"pixels at 150%", 144, true, w32.POINT{X: 400, Y: 300}, w32.POINT{X: 1024, Y: 0}},
+		{"pixels at 200%", 192, true, w32.POINT{X: 400, Y: 300}, w32.POINT{X: 1024, Y: 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// No maximum height
+			gotMin, gotMax := scaleSizeConstraints(400, 300, 1024, 0, tt.dpi, tt.dpi, tt.inPixels)
+			if gotMin != tt.wantMin || gotMax != tt.wantMax {
+				t.Errorf("expected min %+v and max %+v, got min %+v and max %+v", tt.wantMin, tt.wantMax, gotMin, gotMax)
+			}
+		})
+	}
+}
+
+func TestClampToTrackSizes(t *testing.T) {
+	tests := []struct {
+		size, min, max int
+		want           int
+	}{
+		{500, 400, 1024, 500},
+		{300, 400, 1024, 400},
+		{2000, 400, 1024, 1024},
+		{2000, 400, 0, 2000},
+		{100, 0, 0, 100},
+	}
+	for _, tt := range tests {
+		if got := clampToTrackSizes(tt.size, int32(tt.min), int32(tt.max)); got != tt.want {
+			t.Errorf("clampToTrackSizes(%d, %d, %d): expected %d, got %d", tt.size, tt.min, tt.max, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"github.com/leaanthony/winc/w32"
+	"github.com/wailsapp/wails/v2/internal/com"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+var (
+	procCreateIconFromResourceEx = moduser32.NewProc("CreateIconFromResourceEx")
+
+	// The message sent when the taskbar button of the window is created, EG: after Explorer restarts
+	wmTaskbarButtonCreated = registerWindowMessage("TaskbarButtonCreated")
+)
+
+const (
+	clsidTaskbarList  = "{56FDF344-FD6D-11D0-958A-006097C9A090}"
+	iidITaskbarList3  = "{EA1AFB91-9E28-4B86-90E9-9E9F8A5EEFAF}"
+	taskbarListHrInit = 3
+	// ITaskbarList3
+	taskbarListThumbBarAddButtons    = 15
+	taskbarListThumbBarUpdateButtons = 16
+)
+
+const (
+	_THB_ICON      = 0x2
+	_THB_TOOLTIP   = 0x4
+	_THB_FLAGS     = 0x8
+	_THBF_ENABLED  = 0x0
+	_THBF_DISABLED = 0x1
+	_THBF_HIDDEN   = 0x8
+	_THBN_CLICKED  = 0x1800
+)
+
+type _THUMBBUTTON struct {
+	dwMask  uint32
+	iId     uint32
+	iBitmap uint32
+	hIcon   w32.HICON
+	szTip   [260]uint16
+	dwFlags uint32
+}
+
+// thumbnailToolbar holds the buttons of the taskbar thumbnail of a window.
+// It is only used on the main thread.
+type thumbnailToolbar struct {
+	taskbar *com.Object
+	buttons []frontend.ThumbnailButton
+	icons   []w32.HICON
+	// Buttons can only be added once to a taskbar button, after that they are updated
+	added bool
+}
+
+func registerWindowMessage(name string) uint32 {
+	namePtr, _ := syscall.UTF16PtrFromString(name)
+	return w32.RegisterWindowMessage(namePtr)
+}
+
+// SetThumbnailButtons replaces the buttons in the toolbar of the taskbar thumbnail.
+// If the taskbar button hasn't been created yet, they are added when it is.
+func (w *Window) SetThumbnailButtons(buttons []frontend.ThumbnailButton) error {
+	if len(buttons) > frontend.MaxThumbnailButtons {
+		return fmt.Errorf("the thumbnail toolbar has a maximum of %d buttons", frontend.MaxThumbnailButtons)
+	}
+	seen := map[string]bool{}
+	for _, button := range buttons {
+		if button.ID == "" {
+			return errors.New("thumbnail buttons require an ID")
+		}
+		if seen[button.ID] {
+			return fmt.Errorf("duplicate thumbnail button ID '%s'", button.ID)
+		}
+		seen[button.ID] = true
+	}
+
+	w.thumbnailToolbar.buttons = buttons
+	if w.thumbnailToolbar.taskbar == nil {
+		return nil
+	}
+	return w.applyThumbnailButtons()
+}
+
+// taskbarButtonCreated adds the thumbnail buttons to a newly created taskbar button
+func (w *Window) taskbarButtonCreated() {
+	toolbar := &w.thumbnailToolbar
+	toolbar.added = false
+	if toolbar.taskbar == nil {
+		taskbar, err := com.CreateInstance(clsidTaskbarList, iidITaskbarList3)
+		if err != nil {
+			return
+		}
+		if taskbar.Call(taskbarListHrInit) != nil {
+			taskbar.Release()
+			return
+		}
+		toolbar.taskbar = taskbar
+	}
+	if len(toolbar.buttons) > 0 {
+		_ = w.applyThumbnailButtons()
+	}
+}
+
+func (w *Window) applyThumbnailButtons() error {
+	toolbar := &w.thumbnailToolbar
+
+	// All buttons are added the first time, as buttons can't be added later. Unused ones are hidden.
+	var thumbButtons [frontend.MaxThumbnailButtons]_THUMBBUTTON
+	var icons []w32.HICON
+	for index := range thumbButtons {
+		thumbButton := &thumbButtons[index]
+		thumbButton.iId = uint32(index)
+		thumbButton.dwMask = _THB_FLAGS | _THB_TOOLTIP | _THB_ICON
+		if index >= len(toolbar.buttons) {
+			thumbButton.dwFlags = _THBF_HIDDEN
+			continue
+		}
+
+		button := toolbar.buttons[index]
+		thumbButton.dwFlags = _THBF_ENABLED
+		if button.Disabled {
+			thumbButton.dwFlags = _THBF_DISABLED
+		}
+		tooltip, err := syscall.UTF16FromString(button.Tooltip)
+		if err != nil {
+			destroyIcons(icons)
+			return err
+		}
+		copy(thumbButton.szTip[:len(thumbButton.szTip)-1], tooltip)
+		if len(button.Icon) > 0 {
+			icon, err := createIcon(button.Icon, w32.GetSystemMetrics(w32.SM_CXSMICON))
+			if err != nil {
+				destroyIcons(icons)
+				return fmt.Errorf("invalid icon for thumbnail button '%s': %s", button.ID, err.Error())
+			}
+			thumbButton.hIcon = icon
+			icons = append(icons, icon)
+		}
+	}
+
+	method := taskbarListThumbBarUpdateButtons
+	if !toolbar.added {
+		method = taskbarListThumbBarAddButtons
+	}
+	err := toolbar.taskbar.Call(method, uintptr(w.Handle()), uintptr(len(thumbButtons)), uintptr(unsafe.Pointer(&thumbButtons[0])))
+	if err != nil {
+		destroyIcons(icons)
+		return err
+	}
+	toolbar.added = true
+
+	destroyIcons(toolbar.icons)
+	toolbar.icons = icons
+	return nil
+}
+
+// thumbnailButtonClicked returns the button for the WM_COMMAND sent when a thumbnail button is clicked
+func (w *Window) thumbnailButtonClicked(wparam uintptr) (frontend.ThumbnailButton, bool) {
+	if w32.HIWORD(uint32(wparam)) != _THBN_CLICKED {
+		return frontend.ThumbnailButton{}, false
+	}
+	index := int(w32.LOWORD(uint32(wparam)))
+	if index >= len(w.thumbnailToolbar.buttons) {
+		return frontend.ThumbnailButton{}, false
+	}
+	return w.thumbnailToolbar.buttons[index], true
+}
+
+// createIcon creates an icon of the given size from the data of an .ico or .png file.
+// The image in an .ico file closest to the size is used.
+func createIcon(data []byte, size int) (w32.HICON, error) {
+	image, err := iconImage(data, size)
+	if err != nil {
+		return 0, err
+	}
+	icon, _, err := procCreateIconFromResourceEx.Call(
+		uintptr(unsafe.Pointer(&image[0])),
+		uintptr(len(image)),
+		1,          // fIcon
+		0x00030000, // dwVer
+		uintptr(size),
+		uintptr(size),
+		0)
+	if icon == 0 {
+		return 0, err
+	}
+	return w32.HICON(icon), nil
+}
+
+// iconImage returns the image in an .ico file closest to the given size, or the data itself if it isn't an .ico file
+func iconImage(data []byte, size int) ([]byte, error) {
+	const headerSize, entrySize = 6, 16
+	if len(data) < headerSize || binary.LittleEndian.Uint16(data[0:]) != 0 || binary.LittleEndian.Uint16(data[2:]) != 1 {
+		// PNG data can be used directly
+		return data, nil
+	}
+	count := int(binary.LittleEndian.Uint16(data[4:]))
+	if count == 0 || len(data) < headerSize+count*entrySize {
+		return nil, errors.New("invalid .ico file")
+	}
+
+	best := -1
+	bestWidth := 0
+	for index := 0; index < count; index++ {
+		width := int(data[headerSize+index*entrySize])
+		if width == 0 {
+			width = 256
+		}
+		// Prefer the smallest image that is at least the size, otherwise the largest image
+		if best == -1 ||
+			(width >= size && (bestWidth < size || width < bestWidth)) ||
+			(width < size && bestWidth < size && width > bestWidth) {
+			best = index
+			bestWidth = width
+		}
+	}
+
+	entry := data[headerSize+best*entrySize:]
+	imageSize := int(binary.LittleEndian.Uint32(entry[8:]))
+	offset := int(binary.LittleEndian.Uint32(entry[12:]))
+	if imageSize == 0 || offset < 0 || offset+imageSize > len(data) {
+		return nil, errors.New("invalid .ico file")
+	}
+	return data[offset : offset+imageSize], nil
+}
+
+func destroyIcons(icons []w32.HICON) {
+	for _, icon := range icons {
+		w32.DestroyIcon(icon)
+	}
+}
@@ -0,0 +1,38 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/com"
+)
+
+const (
+	// ICoreWebView2
+	webviewAddDocumentTitleChanged = 46
+	webviewGetDocumentTitle        = 48
+)
+
+// setupDocumentTitle sets the title of the window to the title of the document whenever it changes
+func (f *Frontend) setupDocumentTitle() error {
+	webview, err := f.coreWebView()
+	if err != nil {
+		return err
+	}
+	f.documentTitleChangedHandler = com.NewEventHandler(f.documentTitleChanged)
+	_, err = webview.AddEventHandler(webviewAddDocumentTitleChanged, f.documentTitleChangedHandler)
+	return err
+}
+
+func (f *Frontend) documentTitleChanged(webview *com.Object, _ *com.Object) error {
+	title, err := webview.GetString(webviewGetDocumentTitle)
+	if err != nil {
+		return err
+	}
+	// Pages without a title use the title of the application
+	if title == "" {
+		title = f.frontendOptions.Title
+	}
+	f.mainWindow.SetText(title)
+	return nil
+}
@@ -8,16 +8,59 @@ import (
 
 	"github.com/leaanthony/winc"
 	"github.com/leaanthony/winc/w32"
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
+// Window is the main window of the application.
+//
+// Window isn't safe for concurrent use: its methods and fields, like the size constraints, are used by WndProc on
+// the UI thread, so they must only be used on the UI thread too. Code running on other goroutines, such as the
+// runtime methods of the Frontend, posts its calls to the message loop with Dispatch or DispatchSync.
 type Window struct {
 	winc.Form
-	frontendOptions                          *options.App
-	applicationMenu                          *menu.Menu
-	notifyParentWindowPositionChanged        func() error
+	frontendOptions                   *options.App
+	applicationMenu                   *menu.Menu
+	notifyParentWindowPositionChanged func() error
+	onEscapeInFullscreen              func()
+	onQueryEndSession                 func()
+	onEndSession                      func()
+	onEnabled                         func()
+
+	// The size constraints, which are lifted while the window is fullscreen
 	minWidth, minHeight, maxWidth, maxHeight int
+	fullscreen                               bool
+
+	// wasLayered is set if the window was layered before mouse events were ignored
+	wasLayered bool
+
+	// alwaysOnBottom keeps the window beneath all other windows
+	alwaysOnBottom bool
+
+	// The DPI of the monitor the window is on, used to resize it when it moves to a monitor with a different DPI
+	dpi uint
+
+	// activateOnClick is set while the window was shown without being activated, until it is clicked
+	activateOnClick bool
+
+	thumbnailToolbar       thumbnailToolbar
+	onThumbnailButtonClick func(button frontend.ThumbnailButton)
+
+	// The icons set with SetIcon, which are destroyed when they are replaced
+	icons []w32.HICON
+
+	// The cursor shown over the client area, only used on the UI thread
+	cursor cursorState
+
+	wheelEvents  wheelEvents
+	onInputEvent func(name string, data interface{})
+
+	// The functions waiting to be run on the UI thread by Dispatch
+	dispatchQueue dispatchQueue
+
+	// The functions run when the message loop exits, registered with OnCleanup
+	cleanups []func()
 }
 
 func NewWindow(parent winc.Controller, appoptions *options.App) *Window {
@@ -36,6 +79,13 @@ func NewWindow(parent winc.Controller, appoptions *options.App) *Window {
 		if appoptions.Windows.WindowIsTranslucent {
 			exStyle |= w32.WS_EX_NOREDIRECTIONBITMAP
 		}
+		if appoptions.Windows.ToolWindow {
+			exStyle = exStyle&^w32.WS_EX_APPWINDOW | w32.WS_EX_TOOLWINDOW
+		}
+		// Translucent windows have no redirection bitmap to buffer
+		if appoptions.Frameless && appoptions.Windows.DoubleBufferFrameless && !appoptions.Windows.WindowIsTranslucent {
+			exStyle |= wsExComposited
+		}
 	}
 	if appoptions.AlwaysOnTop {
 		exStyle |= w32.WS_EX_TOPMOST
@@ -47,6 +97,7 @@ func NewWindow(parent winc.Controller, appoptions *options.App) *Window {
 	result.SetHandle(winc.CreateWindow("wailsWindow", parent, uint(exStyle), uint(dwStyle)))
 	winc.RegMsgHandler(result)
 	result.SetParent(parent)
+	result.dpi = result.currentDPI()
 
 	loadIcon := true
 	if appoptions.Windows != nil && appoptions.Windows.DisableWindowIcon == true {
@@ -54,7 +105,7 @@ func NewWindow(parent winc.Controller, appoptions *options.App) *Window {
 	}
 	if loadIcon {
 		if ico, err := winc.NewIconFromResource(winc.GetAppInstance(), uint16(winc.AppIconID)); err == nil {
-			result.SetIcon(0, ico)
+			result.Form.SetIcon(w32.ICON_SMALL, ico)
 		}
 	}
 
@@ -75,6 +126,10 @@ func NewWindow(parent winc.Controller, appoptions *options.App) *Window {
 		if appoptions.Windows.DisableWindowIcon {
 			result.DisableIcon()
 		}
+
+		if appoptions.Windows.DisableWindowAnimations {
+			result.disableTransitions()
+		}
 	}
 
 	// Dlg forces display of focus rectangles, as soon as the user starts to type.
@@ -86,17 +141,65 @@ func NewWindow(parent winc.Controller, appoptions *options.App) *Window {
 		result.SetApplicationMenu(appoptions.Menu)
 	}
 
+	// Registered first, so the resources of the window are released last
+	result.OnCleanup(result.releaseResources)
+
 	return result
 }
 
+// Run runs the message loop until the application quits, then shuts the window down.
+// The window still exists when Run returns, so it can be destroyed by the caller.
 func (w *Window) Run() int {
-	return winc.RunMainLoop()
+	exitCode := winc.RunMainLoop()
+	w.shutdown()
+	return exitCode
+}
+
+// OnCleanup registers fn to be run on the UI thread when the message loop exits, before the window is destroyed.
+// The functions are run in the reverse order they were registered, like deferred calls, so resources are released
+// before the ones they were created from.
+func (w *Window) OnCleanup(fn func()) {
+	w.cleanups = append(w.cleanups, fn)
+}
+
+// shutdown runs the functions dispatched while the message loop was exiting, so callers of DispatchSync aren't
+// left waiting, then the cleanup functions
+func (w *Window) shutdown() {
+	for _, fn := range w.dispatchQueue.close() {
+		fn()
+	}
+	for i := len(w.cleanups) - 1; i >= 0; i-- {
+		w.cleanups[i]()
+	}
+	w.cleanups = nil
+}
+
+// releaseResources releases the icons, cursor, mouse hook and COM objects owned by the window
+func (w *Window) releaseResources() {
+	w.removeWheelHook()
+	w32.SendMessage(w.Handle(), w32.WM_SETICON, w32.ICON_SMALL, 0)
+	w32.SendMessage(w.Handle(), w32.WM_SETICON, w32.ICON_BIG, 0)
+	destroyIcons(w.icons)
+	w.icons = nil
+	if w.cursor.custom {
+		w32.DestroyIcon(w.cursor.cursor)
+		w.cursor = cursorState{}
+	}
+
+	toolbar := &w.thumbnailToolbar
+	destroyIcons(toolbar.icons)
+	toolbar.icons = nil
+	if toolbar.taskbar != nil {
+		toolbar.taskbar.Release()
+		toolbar.taskbar = nil
+	}
 }
 
 func (w *Window) Fullscreen() {
-	w.Form.SetMaxSize(0, 0)
-	w.Form.SetMinSize(0, 0)
+	// The constraints are lifted before the window is resized to fill the monitor
+	w.fullscreen = true
 	w.Form.Fullscreen()
+	w.fullscreen = w.IsFullScreen()
 }
 
 func (w *Window) UnFullscreen() {
@@ -104,20 +207,163 @@ func (w *Window) UnFullscreen() {
 		return
 	}
 	w.Form.UnFullscreen()
-	w.SetMinSize(w.minWidth, w.minHeight)
-	w.SetMaxSize(w.maxWidth, w.maxHeight)
+	w.fullscreen = false
+	w.applySizeConstraints()
+}
+
+// SetIcon sets the icon of the window and its taskbar button from the data of an .ico or .png file.
+// Empty data restores the icon of the application.
+func (w *Window) SetIcon(data []byte) error {
+	var small, big w32.HICON
+	if len(data) > 0 {
+		var err error
+		small, err = createIcon(data, w32.GetSystemMetrics(w32.SM_CXSMICON))
+		if err != nil {
+			return err
+		}
+		big, err = createIcon(data, w32.GetSystemMetrics(w32.SM_CXICON))
+		if err != nil {
+			w32.DestroyIcon(small)
+			return err
+		}
+	} else if w.frontendOptions.Windows == nil || !w.frontendOptions.Windows.DisableWindowIcon {
+		small = w32.LoadIcon(winc.GetAppInstance(), w32.MakeIntResource(uint16(winc.AppIconID)))
+	}
+	w32.SendMessage(w.Handle(), w32.WM_SETICON, w32.ICON_SMALL, uintptr(small))
+	w32.SendMessage(w.Handle(), w32.WM_SETICON, w32.ICON_BIG, uintptr(big))
+
+	destroyIcons(w.icons)
+	w.icons = nil
+	if len(data) > 0 {
+		w.icons = []w32.HICON{small, big}
+	}
+	return nil
+}
+
+// SetIgnoreMouseEvents makes mouse events pass through the window to the windows beneath it.
+// The window remains visible.
+func (w *Window) SetIgnoreMouseEvents(ignore bool) {
+	exStyle := uint32(w32.GetWindowLong(w.Handle(), w32.GWL_EXSTYLE))
+	if ignore {
+		if exStyle&w32.WS_EX_TRANSPARENT != 0 {
+			return
+		}
+		w.wasLayered = exStyle&w32.WS_EX_LAYERED != 0
+		w32.SetWindowLong(w.Handle(), w32.GWL_EXSTYLE, exStyle|w32.WS_EX_TRANSPARENT|w32.WS_EX_LAYERED)
+		if !w.wasLayered {
+			// A newly layered window isn't drawn until its attributes are set
+			setLayeredWindowAttributes(w.Handle(), 0, 255, lwaAlpha)
+		}
+		return
+	}
+
+	if exStyle&w32.WS_EX_TRANSPARENT == 0 {
+		return
+	}
+	exStyle &^= w32.WS_EX_TRANSPARENT
+	if !w.wasLayered {
+		exStyle &^= w32.WS_EX_LAYERED
+	}
+	w32.SetWindowLong(w.Handle(), w32.GWL_EXSTYLE, exStyle)
+}
+
+// SetAlwaysOnBottom keeps the window beneath all other windows, EG: for desktop widgets.
+// The window isn't activated when it is clicked, so it doesn't take the focus from other windows.
+func (w *Window) SetAlwaysOnBottom(bottom bool) {
+	if w.alwaysOnBottom == bottom {
+		return
+	}
+	w.alwaysOnBottom = bottom
+	w.activateOnClick = false
+
+	exStyle := uint32(w32.GetWindowLong(w.Handle(), w32.GWL_EXSTYLE))
+	insertAfter := w32.HWND_BOTTOM
+	if bottom {
+		exStyle |= w32.WS_EX_NOACTIVATE
+	} else {
+		exStyle &^= w32.WS_EX_NOACTIVATE
+		insertAfter = w32.HWND_TOP
+		if w.frontendOptions.AlwaysOnTop {
+			insertAfter = w32.HWND_TOPMOST
+		}
+	}
+	w32.SetWindowLong(w.Handle(), w32.GWL_EXSTYLE, exStyle)
+	// Moving a topmost window to the bottom also removes it from the topmost windows
+	w32.SetWindowPos(w.Handle(), insertAfter, 0, 0, 0, 0, w32.SWP_NOMOVE|w32.SWP_NOSIZE|w32.SWP_NOACTIVATE)
+}
+
+// SetEnabled enables or disables mouse and keyboard input to the window, EG: while a native dialog is shown.
+// The focus is taken from the webview when it is disabled, so key presses aren't sent to the page.
+func (w *Window) SetEnabled(enabled bool) {
+	if enabled == w32.IsWindowEnabled(w.Handle()) {
+		return
+	}
+	if !enabled {
+		w32.SetFocus(w.Handle())
+	}
+	w32.EnableWindow(w.Handle(), enabled)
+	if enabled && w.onEnabled != nil {
+		w.onEnabled()
+	}
 }
 
-func (w *Window) SetMinSize(minWidth int, minHeight int) {
-	w.minWidth = minWidth
-	w.minHeight = minHeight
-	w.Form.SetMinSize(minWidth, minHeight)
+// IsEnabled reports whether the window accepts mouse and keyboard input
+func (w *Window) IsEnabled() bool {
+	return w32.IsWindowEnabled(w.Handle())
 }
 
-func (w *Window) SetMaxSize(maxWidth int, maxHeight int) {
-	w.maxWidth = maxWidth
-	w.maxHeight = maxHeight
-	w.Form.SetMaxSize(maxWidth, maxHeight)
+func (w *Window) Show() {
+	w.allowActivation()
+	w.Form.Show()
+}
+
+// ShowNoActivate shows the window without activating it, so it doesn't take the focus from the foreground window.
+// The window is activated as normal once it is clicked.
+func (w *Window) ShowNoActivate() {
+	exStyle := uint32(w32.GetWindowLong(w.Handle(), w32.GWL_EXSTYLE))
+	if exStyle&w32.WS_EX_NOACTIVATE == 0 {
+		// Stop the webview activating the window when it gets the focus
+		w32.SetWindowLong(w.Handle(), w32.GWL_EXSTYLE, exStyle|w32.WS_EX_NOACTIVATE)
+		w.activateOnClick = true
+	}
+	w32.ShowWindow(w.Handle(), w32.SW_SHOWNOACTIVATE)
+}
+
+// allowActivation lets the window be activated again after it was shown with ShowNoActivate
+func (w *Window) allowActivation() {
+	if !w.activateOnClick {
+		return
+	}
+	w.activateOnClick = false
+	exStyle := uint32(w32.GetWindowLong(w.Handle(), w32.GWL_EXSTYLE))
+	w32.SetWindowLong(w.Handle(), w32.GWL_EXSTYLE, exStyle&^w32.WS_EX_NOACTIVATE)
+}
+
+// disableTransitions stops DWM animating the window when it is shown, hidden, minimised or restored
+func (w *Window) disableTransitions() {
+	disable := int32(1)
+	_ = dwmSetWindowAttribute(w.Handle(), dwmwaTransitionsForceDisabled, unsafe.Pointer(&disable), uint32(unsafe.Sizeof(disable)))
+}
+
+func (w *Window) isTranslucent() bool {
+	return w.frontendOptions.Windows != nil && w.frontendOptions.Windows.WindowIsTranslucent
+}
+
+// edgeResizeEnabled reports whether the edges of a frameless window resize it
+func (w *Window) edgeResizeEnabled() bool {
+	if w.frontendOptions.DisableResize {
+		return false
+	}
+	return w.frontendOptions.Windows == nil || !w.frontendOptions.Windows.DisableFramelessResize
+}
+
+// dragEnabled reports whether the elements with the `data-wails-drag` attribute move the window
+func (w *Window) dragEnabled() bool {
+	return w.frontendOptions.Windows == nil || !w.frontendOptions.Windows.DisableFramelessDrag
+}
+
+func (w *Window) exitFullscreenOnEscape() bool {
+	return w.frontendOptions.Windows != nil && w.frontendOptions.Windows.ExitFullscreenOnEscape
 }
 
 type NCCALCSIZE_PARAMS struct {
@@ -125,26 +371,101 @@ type NCCALCSIZE_PARAMS struct {
 	lppos uintptr /* WINDOWPOS */
 }
 
+type WINDOWPOS struct {
+	hwnd            w32.HWND
+	hwndInsertAfter w32.HWND
+	x, y, cx, cy    int32
+	flags           uint32
+}
+
 func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 
+	if msg == wmTaskbarButtonCreated {
+		w.taskbarButtonCreated()
+	}
+
 	switch msg {
+	case wmDispatch:
+		w.runDispatched()
+		return 0
+	case w32.WM_QUERYENDSESSION:
+		// The session can end, as the application saves its state when it receives WM_ENDSESSION
+		if w.onQueryEndSession != nil {
+			w.onQueryEndSession()
+		}
+		return 1
+	case w32.WM_ENDSESSION:
+		if wparam != 0 && w.onEndSession != nil {
+			w.onEndSession()
+		}
+		return 0
+	case w32.WM_COMMAND:
+		if button, ok := w.thumbnailButtonClicked(wparam); ok {
+			if w.onThumbnailButtonClick != nil {
+				w.onThumbnailButtonClick(button)
+			}
+			return 0
+		}
 	case w32.WM_NCLBUTTONDOWN:
-		w32.SetFocus(w.Handle())
+		if !w.alwaysOnBottom {
+			w32.SetFocus(w.Handle())
+		}
+	case w32.WM_MOUSEACTIVATE:
+		w.allowActivation()
+	case w32.WM_WINDOWPOSCHANGING:
+		if w.alwaysOnBottom {
+			// Stop the window being brought in front of other windows, EG: when it is shown or clicked
+			pos := (*WINDOWPOS)(unsafe.Pointer(lparam))
+			pos.hwndInsertAfter = w32.HWND_BOTTOM
+			pos.flags &^= w32.SWP_NOZORDER
+		}
+	case w32.WM_KEYDOWN, w32.WM_KEYUP, w32.WM_CHAR, w32.WM_SYSKEYDOWN, w32.WM_SYSKEYUP, w32.WM_SYSCHAR:
+		if !w.IsEnabled() {
+			// The window keeps the focus when it is disabled, so its key presses are dropped
+			return 0
+		}
+		if msg == w32.WM_KEYDOWN && wparam == w32.VK_ESCAPE && w.exitFullscreenOnEscape() && w.IsFullScreen() {
+			if w.onEscapeInFullscreen != nil {
+				w.onEscapeInFullscreen()
+			} else {
+				w.UnFullscreen()
+			}
+			return 0
+		}
+	case w32.WM_GETMINMAXINFO:
+		if w.getMinMaxInfo((*w32.MINMAXINFO)(unsafe.Pointer(lparam))) {
+			return 0
+		}
+	case w32.WM_SETCURSOR:
+		if w.setCursorForHitTest(lparam) {
+			return 1
+		}
+	case w32.WM_MOUSEWHEEL, wmMouseHWheel:
+		if w.wheelEvents.enabled {
+			w.addWheelEvent(msg, wparam, lparam)
+		}
+	case w32.WM_TIMER:
+		if wparam == wheelEventTimerID {
+			w.flushWheelEvent()
+			return 0
+		}
+	case w32.WM_ERASEBKGND:
+		// The webview paints the whole client area. Erasing the background first paints it white,
+		// which flickers when frameless or translucent windows are resized.
+		if w.frontendOptions.Frameless || w.isTranslucent() {
+			return 1
+		}
 	case w32.WM_MOVE, w32.WM_MOVING:
+		if msg == w32.WM_MOVING && w.constrainToWorkArea() {
+			w.constrainDragRect((*w32.RECT)(unsafe.Pointer(lparam)))
+		}
 		if w.notifyParentWindowPositionChanged != nil {
 			w.notifyParentWindowPositionChanged()
 		}
 
 	// TODO move WM_DPICHANGED handling into winc
 	case 0x02E0: //w32.WM_DPICHANGED
-		newWindowSize := (*w32.RECT)(unsafe.Pointer(lparam))
-		w32.SetWindowPos(w.Handle(),
-			uintptr(0),
-			int(newWindowSize.Left),
-			int(newWindowSize.Top),
-			int(newWindowSize.Right-newWindowSize.Left),
-			int(newWindowSize.Bottom-newWindowSize.Top),
-			w32.SWP_NOZORDER|w32.SWP_NOACTIVATE)
+		w.dpiChanged(wparam, lparam)
 	}
 
 	if w.frontendOptions.Frameless {
@@ -163,6 +484,11 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 			break
 		case w32.WM_NCHITTEST:
 			hit := w.Form.WndProc(msg, wparam, lparam)
+			if !w.edgeResizeEnabled() {
+				// The window keeps its sizing frame, so it can still be maximised and resized
+				// by the application, but the edges are treated as part of the webview
+				return w32.HTCLIENT
+			}
 
 			switch hit {
 			case w32.HTNOWHERE:
@@ -253,10 +579,51 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 
 // TODO this should be put into the winc if we are happy with this solution.
 var (
-	modkernel32                      = syscall.NewLazyDLL("dwmapi.dll")
-	procDwmExtendFrameIntoClientArea = modkernel32.NewProc("DwmExtendFrameIntoClientArea")
+	moddwmapi                        = syscall.NewLazyDLL("dwmapi.dll")
+	procDwmExtendFrameIntoClientArea = moddwmapi.NewProc("DwmExtendFrameIntoClientArea")
+	procDwmSetWindowAttribute        = moddwmapi.NewProc("DwmSetWindowAttribute")
+
+	moduser32                      = syscall.NewLazyDLL("user32.dll")
+	procSetLayeredWindowAttributes = moduser32.NewProc("SetLayeredWindowAttributes")
+	procGetActiveWindow            = moduser32.NewProc("GetActiveWindow")
+)
+
+const (
+	dwmwaTransitionsForceDisabled = 3
+
+	lwaAlpha       = 0x00000002
+	wsExComposited = 0x02000000
 )
 
+func dwmSetWindowAttribute(hwnd w32.HWND, attribute uint32, value unsafe.Pointer, size uint32) error {
+	ret, _, _ := procDwmSetWindowAttribute.Call(
+		uintptr(hwnd),
+		uintptr(attribute),
+		uintptr(value),
+		uintptr(size))
+
+	if ret != 0 {
+		return syscall.Errno(ret)
+	}
+
+	return nil
+}
+
+func setLayeredWindowAttributes(hwnd w32.HWND, colorKey uint32, alpha byte, flags uint32) bool {
+	ret, _, _ := procSetLayeredWindowAttributes.Call(
+		uintptr(hwnd),
+		uintptr(colorKey),
+		uintptr(alpha),
+		uintptr(flags))
+
+	return ret != 0
+}
+
+func getActiveWindow() w32.HWND {
+	ret, _, _ := procGetActiveWindow.Call()
+	return w32.HWND(ret)
+}
+
 func dwmExtendFrameIntoClientArea(hwnd w32.HWND, margins w32.MARGINS) error {
 	ret, _, _ := procDwmExtendFrameIntoClientArea.Call(
 		uintptr(hwnd),
@@ -0,0 +1,69 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	"github.com/leaanthony/winc"
+	"github.com/leaanthony/winc/w32"
+)
+
+// minVisibleWidth is the width of the window, in pixels at 96 DPI, kept within the work area by ConstrainToWorkArea
+const minVisibleWidth = 100
+
+func (w *Window) constrainToWorkArea() bool {
+	return w.frontendOptions.Windows != nil && w.frontendOptions.Windows.ConstrainToWorkArea
+}
+
+// constrainDragRect moves the drag rectangle of WM_MOVING so the titlebar stays within the work area of the monitor
+// under the cursor. The monitor under the cursor is used rather than the one nearest the window, so the window can
+// still be dragged between monitors.
+func (w *Window) constrainDragRect(rect *w32.RECT) {
+	x, y, ok := w32.GetCursorPos()
+	if !ok {
+		return
+	}
+	monitor := w32.MonitorFromPoint(x, y, w32.MONITOR_DEFAULTTONEAREST)
+	var monitorInfo w32.MONITORINFO
+	monitorInfo.CbSize = uint32(unsafe.Sizeof(monitorInfo))
+	if !w32.GetMonitorInfo(monitor, &monitorInfo) {
+		return
+	}
+
+	var dpiX, dpiY uint
+	w32.GetDPIForMonitor(monitor, w32.MDT_EFFECTIVE_DPI, &dpiX, &dpiY)
+	titlebarHeight := winc.ScaleWithDPI(w32.GetSystemMetrics(w32.SM_CYCAPTION), dpiY)
+	visibleWidth := winc.ScaleWithDPI(minVisibleWidth, dpiX)
+
+	*rect = constrainRect(*rect, monitorInfo.RcWork, int32(titlebarHeight), int32(visibleWidth))
+}
+
+// constrainRect moves rect, without resizing it, so its top edge and a strip of titlebarHeight beneath it are within
+// work, and at least visibleWidth of it overlaps work horizontally
+func constrainRect(rect, work w32.RECT, titlebarHeight, visibleWidth int32) w32.RECT {
+	width := rect.Right - rect.Left
+	if width < visibleWidth {
+		visibleWidth = width
+	}
+
+	var dx, dy int32
+	if maxTop := work.Bottom - titlebarHeight; rect.Top > maxTop {
+		dy = maxTop - rect.Top
+	}
+	if rect.Top+dy < work.Top {
+		dy = work.Top - rect.Top
+	}
+	if minRight := work.Left + visibleWidth; rect.Right < minRight {
+		dx = minRight - rect.Right
+	} else if maxLeft := work.Right - visibleWidth; rect.Left > maxLeft {
+		dx = maxLeft - rect.Left
+	}
+
+	rect.Left += dx
+	rect.Right += dx
+	rect.Top += dy
+	rect.Bottom += dy
+	return rect
+}
@@ -0,0 +1,36 @@
+//go:build windows
+// +build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/leaanthony/winc/w32"
+)
+
+func TestConstrainRect(t *testing.T) {
+	work := w32.RECT{Left: 0, Top: 0, Right: 1920, Bottom: 1040}
+	tests := []struct {
+		name string
+		rect w32.RECT
+		want w32.RECT
+	}{
+		{"inside", w32.RECT{Left: 100, Top: 100, Right: 900, Bottom: 700}, w32.RECT{Left: 100, Top: 100, Right: 900, Bottom: 700}},
+		{"above", w32.RECT{Left: 100, Top: -50, Right: 900, Bottom: 550}, w32.RECT{Left: 100, Top: 0, Right: 900, Bottom: 600}},
+		{"below", w32.RECT{Left: 100, Top: 1030, Right: 900, Bottom: 1630}, w32.RECT{Left: 100, Top: 1010, Right: 900, Bottom: 1610}},
+		{"partly below", w32.RECT{Left: 100, Top: 900, Right: 900, Bottom: 1500}, w32.RECT{Left: 100, Top: 900, Right: 900, Bottom: 1500}},
+		{"left", w32.RECT{Left: -790, Top: 100, Right: 10, Bottom: 700}, w32.RECT{Left: -700, Top: 100, Right: 100, Bottom: 700}},
+		{"partly left", w32.RECT{Left: -600, Top: 100, Right: 200, Bottom: 700}, w32.RECT{Left: -600, Top: 100, Right: 200, Bottom: 700}},
+		{"right", w32.RECT{Left: 1900, Top: 100, Right: 2700, Bottom: 700}, w32.RECT{Left: 1820, Top: 100, Right: 2620, Bottom: 700}},
+		{"narrow", w32.RECT{Left: -40, Top: 100, Right: 10, Bottom: 700}, w32.RECT{Left: 0, Top: 100, Right: 50, Bottom: 700}},
+		{"corner", w32.RECT{Left: 1950, Top: -200, Right: 2750, Bottom: 400}, w32.RECT{Left: 1820, Top: 0, Right: 2620, Bottom: 600}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := constrainRect(tt.rect, work, 30, 100); got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -64,6 +64,18 @@ func (d *DevWebServer) Run(ctx context.Context) error {
 	d.server.Get("/wails/ipc", websocket.New(func(c *websocket.Conn) {
 		d.newWebsocketSession(c)
 		locker := d.websocketClients[c]
+
+		// Send the current bindings, so that browsers reconnecting after a rebuild
+		// update their bindings without reloading
+		if bindingsJSON, err := d.appBindings.ToJSON(); err != nil {
+			d.logger.Error(err.Error())
+		} else {
+			locker.Lock()
+			if err = c.WriteMessage(websocket.TextMessage, []byte("b"+bindingsJSON)); err != nil {
+				d.logger.Error(err.Error())
+			}
+			locker.Unlock()
+		}
 		// websocket.Conn bindings https://pkg.go.dev/github.com/fasthttp/websocket?tab=doc#pkg-index
 		var (
 			mt  int
@@ -85,6 +97,24 @@ func (d *DevWebServer) Run(ctx context.Context) error {
 				d.notifyExcludingSender(msg, c)
 			}
 
+			// Calls are processed in the background so that they can be cancelled whilst in progress
+			if len(msg) > 0 && msg[0] == 'C' {
+				go func(mt int, msg string) {
+					result, err := d.dispatcher.ProcessMessage(msg, d)
+					if err != nil {
+						d.logger.Error(err.Error())
+					}
+					if result != "" {
+						locker.Lock()
+						if err = c.WriteMessage(mt, []byte(result)); err != nil {
+							d.logger.Error(err.Error())
+						}
+						locker.Unlock()
+					}
+				}(mt, string(msg))
+				continue
+			}
+
 			// Send the message to dispatch to the frontend
 			result, err := d.dispatcher.ProcessMessage(string(msg), d)
 			if err != nil {
@@ -102,15 +132,16 @@ func (d *DevWebServer) Run(ctx context.Context) error {
 		}
 	}))
 
+	devServer, _ := ctx.Value("devserver").(string)
 	_devServerURL := ctx.Value("devserverurl")
-	if _devServerURL == "http://localhost:34115" {
+	if _devServerURL == "http://"+devServer {
 		// Setup internal dev server
 		bindingsJSON, err := d.appBindings.ToJSON()
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		d.assetServer, err = assetserver.NewBrowserAssetServer(ctx, d.appoptions.Assets, bindingsJSON)
+		d.assetServer, err = assetserver.NewBrowserAssetServer(ctx, d.appoptions, bindingsJSON)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -119,14 +150,14 @@ func (d *DevWebServer) Run(ctx context.Context) error {
 
 		// Start server
 		go func(server *fiber.App, log *logger.Logger) {
-			err := server.Listen("localhost:34115")
+			err := server.Listen(devServer)
 			if err != nil {
 				log.Error(err.Error())
 			}
 			d.LogDebug("Shutdown completed")
 		}(d.server, d.logger)
 
-		d.LogDebug("Serving application at http://localhost:34115")
+		d.LogDebug("Serving application at http://%s", devServer)
 
 		defer func() {
 			err := d.server.Shutdown()
@@ -167,6 +198,10 @@ func (d *DevWebServer) MessageDialog(dialogOptions frontend.MessageDialogOptions
 	return d.desktopFrontend.MessageDialog(dialogOptions)
 }
 
+func (d *DevWebServer) ShowAboutDialog(info frontend.AboutInfo) error {
+	return d.desktopFrontend.ShowAboutDialog(info)
+}
+
 func (d *DevWebServer) WindowSetTitle(title string) {
 	d.desktopFrontend.WindowSetTitle(title)
 }
@@ -175,6 +210,10 @@ func (d *DevWebServer) WindowShow() {
 	d.desktopFrontend.WindowShow()
 }
 
+func (d *DevWebServer) WindowShowNoActivate() {
+	d.desktopFrontend.WindowShowNoActivate()
+}
+
 func (d *DevWebServer) WindowHide() {
 	d.desktopFrontend.WindowHide()
 }
@@ -231,6 +270,62 @@ func (d *DevWebServer) WindowUnFullscreen() {
 	d.desktopFrontend.WindowUnFullscreen()
 }
 
+func (d *DevWebServer) WindowSetIgnoreMouseEvents(ignore bool) {
+	d.desktopFrontend.WindowSetIgnoreMouseEvents(ignore)
+}
+
+func (d *DevWebServer) WindowSetAlwaysOnBottom(bottom bool) {
+	d.desktopFrontend.WindowSetAlwaysOnBottom(bottom)
+}
+
+func (d *DevWebServer) WindowEnterPresentationMode() {
+	d.desktopFrontend.WindowEnterPresentationMode()
+}
+
+func (d *DevWebServer) WindowExitPresentationMode() {
+	d.desktopFrontend.WindowExitPresentationMode()
+}
+
+func (d *DevWebServer) WindowSetEnabled(enabled bool) {
+	d.desktopFrontend.WindowSetEnabled(enabled)
+}
+
+func (d *DevWebServer) WindowIsEnabled() bool {
+	return d.desktopFrontend.WindowIsEnabled()
+}
+
+func (d *DevWebServer) WindowSetCursor(cursor string) {
+	d.desktopFrontend.WindowSetCursor(cursor)
+}
+
+func (d *DevWebServer) WindowSetCustomCursor(image []byte, hotspotX int, hotspotY int) {
+	d.desktopFrontend.WindowSetCustomCursor(image, hotspotX, hotspotY)
+}
+
+func (d *DevWebServer) WindowHideCursor() {
+	d.desktopFrontend.WindowHideCursor()
+}
+
+func (d *DevWebServer) WindowShowCursor() {
+	d.desktopFrontend.WindowShowCursor()
+}
+
+func (d *DevWebServer) WindowSetInputEvents(events options.InputEvents) {
+	d.desktopFrontend.WindowSetInputEvents(events)
+}
+
+func (d *DevWebServer) WindowSetThumbnailButtons(buttons []frontend.ThumbnailButton) error {
+	return d.desktopFrontend.WindowSetThumbnailButtons(buttons)
+}
+
+func (d *DevWebServer) DownloadCancel(id string) error {
+	return d.desktopFrontend.DownloadCancel(id)
+}
+
+func (d *DevWebServer) StartDrag(files []string) error {
+	return d.desktopFrontend.StartDrag(files)
+}
+
 func (d *DevWebServer) WindowSetRGBA(col *options.RGBA) {
 	d.desktopFrontend.WindowSetRGBA(col)
 }
@@ -248,6 +343,14 @@ func (d *DevWebServer) BrowserOpenURL(url string) {
 	d.desktopFrontend.BrowserOpenURL(url)
 }
 
+func (d *DevWebServer) WindowLoadURL(url string) error {
+	return d.desktopFrontend.WindowLoadURL(url)
+}
+
+func (d *DevWebServer) ExecJS(js string) {
+	d.desktopFrontend.ExecJS(js)
+}
+
 func (d *DevWebServer) Notify(name string, data ...interface{}) {
 	d.notify(name, data...)
 }
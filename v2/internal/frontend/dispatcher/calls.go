@@ -1,16 +1,23 @@
 package dispatcher
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"github.com/wailsapp/wails/v2/internal/frontend"
+	"reflect"
 	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
 type callMessage struct {
 	Name       string            `json:"name"`
 	Args       []json.RawMessage `json:"args"`
 	CallbackID string            `json:"callbackID"`
+	Timeout    int               `json:"timeout"` // Milliseconds. 0 = no timeout
 }
 
 func (d *Dispatcher) processCallMessage(message string, sender frontend.Frontend) (string, error) {
@@ -42,14 +49,20 @@ func (d *Dispatcher) processCallMessage(message string, sender frontend.Frontend
 			result, _ := d.NewErrorCallback(errmsg.Error(), payload.CallbackID)
 			return result, errmsg
 		}
-		result, err = registeredMethod.Call(args)
+		ctx, done := d.startCall(payload, sender)
+		result, err = registeredMethod.Call(ctx, args)
+		if err == nil && isChannel(result) {
+			err = streamChannel(ctx, reflect.ValueOf(result))
+			result = nil
+		}
+		done()
 	}
 
 	callbackMessage := &CallbackMessage{
 		CallbackID: payload.CallbackID,
 	}
 	if err != nil {
-		callbackMessage.Err = err.Error()
+		callbackMessage.Err = callError(err)
 	} else {
 		callbackMessage.Result = result
 	}
@@ -63,13 +76,108 @@ func (d *Dispatcher) processCallMessage(message string, sender frontend.Frontend
 	return "c" + string(messageData), nil
 }
 
+// startCall creates the context for a bound method call. The context is cancelled when
+// the frontend cancels the call, the call times out or the application shuts down.
+// The returned function must be called once the call has completed.
+func (d *Dispatcher) startCall(payload callMessage, sender frontend.Frontend) (context.Context, func()) {
+	callbackID := payload.CallbackID
+	ctx := context.WithValue(d.ctx, "frontend", sender)
+	ctx = context.WithValue(ctx, "callid", callbackID)
+
+	var cancel context.CancelFunc
+	if payload.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(payload.Timeout)*time.Millisecond)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	d.callsLock.Lock()
+	d.calls[callbackID] = cancel
+	d.callsLock.Unlock()
+
+	return ctx, func() {
+		d.callsLock.Lock()
+		delete(d.calls, callbackID)
+		d.callsLock.Unlock()
+		cancel()
+	}
+}
+
+func isChannel(value interface{}) bool {
+	return value != nil && reflect.TypeOf(value).Kind() == reflect.Chan
+}
+
+// streamChannel sends the values received from a channel returned by a bound method to the
+// frontend as progress of the call. It returns when the channel is closed or the call is cancelled.
+func streamChannel(ctx context.Context, channel reflect.Value) error {
+	if channel.Type().ChanDir()&reflect.RecvDir == 0 {
+		return fmt.Errorf("cannot receive from channel of type %s", channel.Type())
+	}
+	if channel.IsNil() {
+		return nil
+	}
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: channel},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+	}
+	for {
+		chosen, value, ok := reflect.Select(cases)
+		if chosen == 1 {
+			return ctx.Err()
+		}
+		if !ok {
+			return nil
+		}
+		runtime.CallProgress(ctx, value.Interface())
+	}
+}
+
+// processCancelMessage cancels the context of an in-flight call.
+// Format: X<callbackID>
+func (d *Dispatcher) processCancelMessage(message string) (string, error) {
+	callbackID := message[1:]
+
+	d.callsLock.Lock()
+	cancel := d.calls[callbackID]
+	d.callsLock.Unlock()
+
+	// The call may have already completed
+	if cancel == nil {
+		d.log.Trace("Cannot cancel call '%s': not in progress", callbackID)
+		return "", nil
+	}
+	cancel()
+	return "", nil
+}
+
 // CallbackMessage defines a message that contains the result of a call
 type CallbackMessage struct {
 	Result     interface{} `json:"result"`
-	Err        string      `json:"error"`
+	Err        interface{} `json:"error"`
 	CallbackID string      `json:"callbackid"`
 }
 
+// structuredError is sent to the frontend for errors implementing runtime.CallError
+type structuredError struct {
+	Message string                 `json:"message"`
+	Code    string                 `json:"code"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// callError returns the value sent to the frontend for the given error. This is
+// the error message unless an error in the chain implements runtime.CallError.
+func callError(err error) interface{} {
+	var structured runtime.CallError
+	if errors.As(err, &structured) {
+		return &structuredError{
+			Message: err.Error(),
+			Code:    structured.ErrorCode(),
+			Details: structured.ErrorDetails(),
+		}
+	}
+	return err.Error()
+}
+
 func (d *Dispatcher) NewErrorCallback(message string, callbackID string) (string, error) {
 	result := &CallbackMessage{
 		CallbackID: callbackID,
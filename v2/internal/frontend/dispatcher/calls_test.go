@@ -0,0 +1,63 @@
+package dispatcher
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type validationError struct {
+	field string
+}
+
+func (v *validationError) Error() string {
+	return v.field + " is invalid"
+}
+
+func (v *validationError) ErrorCode() string {
+	return "VALIDATION"
+}
+
+func (v *validationError) ErrorDetails() map[string]interface{} {
+	return map[string]interface{}{"field": v.field}
+}
+
+func Test_callError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want interface{}
+	}{
+		{
+			name: "plain error",
+			err:  errors.New("failed"),
+			want: "failed",
+		},
+		{
+			name: "structured error",
+			err:  &validationError{field: "name"},
+			want: &structuredError{
+				Message: "name is invalid",
+				Code:    "VALIDATION",
+				Details: map[string]interface{}{"field": "name"},
+			},
+		},
+		{
+			name: "wrapped structured error",
+			err:  fmt.Errorf("saving: %w", &validationError{field: "name"}),
+			want: &structuredError{
+				Message: "saving: name is invalid",
+				Code:    "VALIDATION",
+				Details: map[string]interface{}{"field": "name"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := callError(tt.err); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("callError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package dispatcher
 
 import (
+	"context"
+	"strconv"
+	"sync"
+
 	"github.com/pkg/errors"
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreport"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/logger"
 )
@@ -12,21 +17,45 @@ type Dispatcher struct {
 	bindings   *binding.Bindings
 	events     frontend.Events
 	bindingsDB *binding.DB
+
+	// Reports panics in bound methods. May be nil
+	crashReporter *crashreport.Reporter
+
+	// The parent context of all bound method calls. Cancelled on shutdown
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// In-flight calls by callback ID
+	calls     map[string]context.CancelFunc
+	callsLock sync.Mutex
 }
 
-func NewDispatcher(log *logger.Logger, bindings *binding.Bindings, events frontend.Events) *Dispatcher {
+func NewDispatcher(ctx context.Context, log *logger.Logger, bindings *binding.Bindings, events frontend.Events, crashReporter *crashreport.Reporter) *Dispatcher {
+	ctx, cancel := context.WithCancel(ctx)
 	return &Dispatcher{
-		log:        log,
-		bindings:   bindings,
-		events:     events,
-		bindingsDB: bindings.DB(),
+		log:           log,
+		bindings:      bindings,
+		events:        events,
+		bindingsDB:    bindings.DB(),
+		crashReporter: crashReporter,
+		ctx:           ctx,
+		cancel:        cancel,
+		calls:         make(map[string]context.CancelFunc),
 	}
 }
 
+// Shutdown cancels the context of all in-flight bound method calls
+func (d *Dispatcher) Shutdown() {
+	d.cancel()
+}
+
 func (d *Dispatcher) ProcessMessage(message string, sender frontend.Frontend) (string, error) {
 	if message == "" {
 		return "", errors.New("No message to process")
 	}
+	if d.crashReporter != nil {
+		defer d.crashReporter.Recover()
+	}
 	switch message[0] {
 	case 'L':
 		return d.processLogMessage(message)
@@ -34,11 +63,23 @@ func (d *Dispatcher) ProcessMessage(message string, sender frontend.Frontend) (s
 		return d.processEventMessage(message, sender)
 	case 'C':
 		return d.processCallMessage(message, sender)
+	case 'X':
+		return d.processCancelMessage(message)
 	case 'W':
 		return d.processWindowMessage(message, sender)
 	case 'B':
 		return d.processBrowserMessage(message, sender)
 	case 'Q':
+		// The exit code is optional
+		if len(message) > 1 {
+			exitCode, err := strconv.Atoi(message[1:])
+			if err != nil {
+				return "", errors.New("Invalid exit code from front end: " + message)
+			}
+			if code, ok := d.ctx.Value("exitcode").(*frontend.ExitCode); ok {
+				code.Set(exitCode)
+			}
+		}
 		sender.Quit()
 		return "", nil
 	default:
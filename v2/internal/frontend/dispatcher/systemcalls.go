@@ -1,10 +1,18 @@
 package dispatcher
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/wailsapp/wails/v2/internal/clipboard"
+	"github.com/wailsapp/wails/v2/internal/elevation"
 	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/jumplist"
+	"github.com/wailsapp/wails/v2/internal/securestorage"
+	"github.com/wailsapp/wails/v2/internal/settings"
+	"github.com/wailsapp/wails/v2/internal/shell"
 )
 
 const systemCallPrefix = ":wails:"
@@ -31,8 +39,135 @@ func (d *Dispatcher) processSystemCall(payload callMessage, sender frontend.Fron
 	case "WindowGetSize":
 		w, h := sender.WindowGetSize()
 		return &size{w, h}, nil
+	case "WindowIsEnabled":
+		return sender.WindowIsEnabled(), nil
+	case "WindowLoadURL":
+		var url string
+		if err := parseArgs(payload, &url); err != nil {
+			return nil, err
+		}
+		return nil, sender.WindowLoadURL(url)
+	case "WindowSetThumbnailButtons":
+		var buttons []frontend.ThumbnailButton
+		if err := parseArgs(payload, &buttons); err != nil {
+			return nil, err
+		}
+		return nil, sender.WindowSetThumbnailButtons(buttons)
+	case "SecureStorageGet":
+		var key string
+		if err := parseArgs(payload, &key); err != nil {
+			return nil, err
+		}
+		return securestorage.Get(key)
+	case "SecureStorageSet":
+		var key, value string
+		if err := parseArgs(payload, &key, &value); err != nil {
+			return nil, err
+		}
+		return nil, securestorage.Set(key, value)
+	case "SecureStorageDelete":
+		var key string
+		if err := parseArgs(payload, &key); err != nil {
+			return nil, err
+		}
+		return nil, securestorage.Delete(key)
+	case "OpenFileInDefaultApp":
+		var path string
+		if err := parseArgs(payload, &path); err != nil {
+			return nil, err
+		}
+		return nil, shell.OpenFile(path)
+	case "SettingsGet":
+		var key string
+		if err := parseArgs(payload, &key); err != nil {
+			return nil, err
+		}
+		value, _, err := d.settings().Get(key)
+		return value, err
+	case "SettingsSet":
+		var key string
+		var value json.RawMessage
+		if err := parseArgs(payload, &key, &value); err != nil {
+			return nil, err
+		}
+		return nil, d.settings().Set(key, value)
+	case "SettingsDelete":
+		var key string
+		if err := parseArgs(payload, &key); err != nil {
+			return nil, err
+		}
+		return nil, d.settings().Delete(key)
+	case "SettingsPath":
+		return d.settings().Path(), nil
+	case "DownloadCancel":
+		var id string
+		if err := parseArgs(payload, &id); err != nil {
+			return nil, err
+		}
+		return nil, sender.DownloadCancel(id)
+	case "StartDrag":
+		var files []string
+		if err := parseArgs(payload, &files); err != nil {
+			return nil, err
+		}
+		return nil, sender.StartDrag(files)
+	case "ClipboardGetImage":
+		return clipboard.GetImage()
+	case "ClipboardSetImage":
+		// The image is sent as base64, which is how []byte is unmarshalled
+		var data []byte
+		if err := parseArgs(payload, &data); err != nil {
+			return nil, err
+		}
+		return nil, clipboard.SetImage(data)
+	case "AssetsHash":
+		assetsHash, ok := d.ctx.Value("assetshash").(func() (string, error))
+		if !ok {
+			return nil, errors.New("the assets hash is not available")
+		}
+		return assetsHash()
+	case "Args":
+		return d.ctx.Value("args"), nil
+	case "IsElevated":
+		return elevation.IsElevated(), nil
+	case "RelaunchElevated":
+		if err := elevation.Relaunch(); err != nil {
+			return nil, err
+		}
+		sender.Quit()
+		return nil, nil
+	case "SetJumpListTasks":
+		var tasks []jumplist.Task
+		if err := parseArgs(payload, &tasks); err != nil {
+			return nil, err
+		}
+		return nil, jumplist.SetTasks(tasks)
+	case "AddRecentDocument":
+		var path string
+		if err := parseArgs(payload, &path); err != nil {
+			return nil, err
+		}
+		return nil, jumplist.AddRecentDocument(path)
 	default:
 		return nil, fmt.Errorf("unknown systemcall message: %s", payload.Name)
 	}
 
 }
+
+// parseArgs unmarshals the arguments of a system call into the given values
+func parseArgs(payload callMessage, values ...interface{}) error {
+	if len(payload.Args) != len(values) {
+		return fmt.Errorf("%s expects %d arguments, got %d", payload.Name, len(values), len(payload.Args))
+	}
+	for index, value := range values {
+		err := json.Unmarshal(payload.Args[index], value)
+		if err != nil {
+			return fmt.Errorf("invalid argument %d for %s: %s", index+1, payload.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) settings() *settings.Store {
+	return d.ctx.Value("settings").(*settings.Store)
+}
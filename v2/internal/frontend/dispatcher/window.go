@@ -46,6 +46,8 @@ func (d *Dispatcher) processWindowMessage(message string, sender frontend.Fronte
 		go sender.WindowHide()
 	case 'S':
 		go sender.WindowShow()
+	case 'N':
+		go sender.WindowShowNoActivate()
 	case 'r':
 		var rgba options.RGBA
 		err := json.Unmarshal([]byte(message[3:]), &rgba)
@@ -71,6 +73,47 @@ func (d *Dispatcher) processWindowMessage(message string, sender frontend.Fronte
 		w := d.mustAtoI(parts[0])
 		h := d.mustAtoI(parts[1])
 		go sender.WindowSetMinSize(w, h)
+	case 'I':
+		ignore := message[2:] == "1"
+		go sender.WindowSetIgnoreMouseEvents(ignore)
+	case 'B':
+		bottom := message[2:] == "1"
+		go sender.WindowSetAlwaysOnBottom(bottom)
+	case 'P':
+		if message[2:] == "1" {
+			go sender.WindowEnterPresentationMode()
+		} else {
+			go sender.WindowExitPresentationMode()
+		}
+	case 'E':
+		enabled := message[2:] == "1"
+		go sender.WindowSetEnabled(enabled)
+	case 'C':
+		go sender.WindowSetCursor(message[2:])
+	case 'X':
+		var cursor struct {
+			Image    []byte `json:"image"`
+			HotspotX int    `json:"hotspotX"`
+			HotspotY int    `json:"hotspotY"`
+		}
+		err := json.Unmarshal([]byte(message[2:]), &cursor)
+		if err != nil {
+			return "", err
+		}
+		go sender.WindowSetCustomCursor(cursor.Image, cursor.HotspotX, cursor.HotspotY)
+	case 'K':
+		if message[2:] == "1" {
+			go sender.WindowHideCursor()
+		} else {
+			go sender.WindowShowCursor()
+		}
+	case 'G':
+		var events options.InputEvents
+		err := json.Unmarshal([]byte(message[2:]), &events)
+		if err != nil {
+			return "", err
+		}
+		go sender.WindowSetInputEvents(events)
 	default:
 		d.log.Error("unknown Window message: %s", message)
 	}
@@ -0,0 +1,27 @@
+package frontend
+
+// The events emitted with a Download as the webview downloads a file
+const (
+	DownloadStartedEvent   = "wails:download:started"
+	DownloadProgressEvent  = "wails:download:progress"
+	DownloadCompletedEvent = "wails:download:completed"
+)
+
+// The states of a Download
+const (
+	DownloadInProgress  = "inprogress"
+	DownloadCompleted   = "completed"
+	DownloadInterrupted = "interrupted"
+)
+
+// Download describes a file being downloaded by the webview
+type Download struct {
+	// ID identifies the download to DownloadCancel
+	ID   string `json:"id"`
+	URL  string `json:"url"`
+	Path string `json:"path"`
+	// TotalBytes is -1 if the size of the file is unknown
+	TotalBytes    int64  `json:"totalBytes"`
+	BytesReceived int64  `json:"bytesReceived"`
+	State         string `json:"state"`
+}
@@ -0,0 +1,31 @@
+package frontend
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// ExitCode is the code the process exits with once the application has shut down, given to QuitWithExitCode.
+// It is stored in the application context as "exitcode".
+type ExitCode struct {
+	code int32
+}
+
+// Set sets the exit code
+func (e *ExitCode) Set(code int) {
+	atomic.StoreInt32(&e.code, int32(code))
+}
+
+// Get returns the exit code
+func (e *ExitCode) Get() int {
+	return int(atomic.LoadInt32(&e.code))
+}
+
+// ExitError is returned by Run when the application quit with a non zero exit code
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("application quit with exit code %d", e.Code)
+}
@@ -2,6 +2,7 @@ package frontend
 
 import (
 	"context"
+	"strings"
 
 	"github.com/wailsapp/wails/v2/pkg/menu"
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -56,6 +57,53 @@ type MessageDialogOptions struct {
 	Icon          []byte
 }
 
+// AboutInfo is the information shown in the about dialog
+type AboutInfo struct {
+	Name      string
+	Version   string
+	Copyright string
+}
+
+// Description returns the version and copyright of the application as a single message
+func (a AboutInfo) Description() string {
+	var lines []string
+	if a.Version != "" {
+		lines = append(lines, "Version "+a.Version)
+	}
+	if a.Copyright != "" {
+		lines = append(lines, a.Copyright)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ThumbnailButtonClickEvent is emitted with the ID of a thumbnail button when it is clicked
+const ThumbnailButtonClickEvent = "wails:thumbnailbutton:click"
+
+// EndSessionEvent is emitted when the user logs off or shuts down the computer, so the application can save its work
+const EndSessionEvent = "wails:window:endsession"
+
+// The events the input events are forwarded as
+const (
+	InputWheelEvent   = "wails:input:wheel"
+	InputTouchEvent   = "wails:input:touch"
+	InputGestureEvent = "wails:input:gesture"
+)
+
+// MaxThumbnailButtons is the maximum number of buttons in the thumbnail toolbar
+const MaxThumbnailButtons = 7
+
+// ThumbnailButton is a button in the toolbar of the taskbar thumbnail of the window on Windows
+type ThumbnailButton struct {
+	// ID is emitted with ThumbnailButtonClickEvent when the button is clicked
+	ID      string `json:"id"`
+	Tooltip string `json:"tooltip"`
+	// Icon is the data of an .ico or .png file
+	Icon     []byte `json:"icon"`
+	Disabled bool   `json:"disabled"`
+	// OnClick is called when the button is clicked
+	OnClick func() `json:"-"`
+}
+
 type Frontend interface {
 	Run(context.Context) error
 	Quit()
@@ -66,10 +114,12 @@ type Frontend interface {
 	OpenDirectoryDialog(dialogOptions OpenDialogOptions) (string, error)
 	SaveFileDialog(dialogOptions SaveDialogOptions) (string, error)
 	MessageDialog(dialogOptions MessageDialogOptions) (string, error)
+	ShowAboutDialog(info AboutInfo) error
 
 	// Window
 	WindowSetTitle(title string)
 	WindowShow()
+	WindowShowNoActivate()
 	WindowHide()
 	WindowCenter()
 	WindowMaximise()
@@ -85,7 +135,20 @@ type Frontend interface {
 	WindowFullscreen()
 	WindowUnFullscreen()
 	WindowSetRGBA(col *options.RGBA)
+	WindowSetIgnoreMouseEvents(ignore bool)
+	WindowSetAlwaysOnBottom(bottom bool)
+	WindowEnterPresentationMode()
+	WindowExitPresentationMode()
+	WindowSetEnabled(enabled bool)
+	WindowIsEnabled() bool
+	WindowSetCursor(cursor string)
+	WindowSetCustomCursor(image []byte, hotspotX int, hotspotY int)
+	WindowHideCursor()
+	WindowShowCursor()
+	WindowSetInputEvents(events options.InputEvents)
+	WindowSetThumbnailButtons(buttons []ThumbnailButton) error
 	WindowReload()
+	WindowLoadURL(url string) error
 
 	// Menus
 	MenuSetApplicationMenu(menu *menu.Menu)
@@ -98,6 +161,15 @@ type Frontend interface {
 	// Events
 	Notify(name string, data ...interface{})
 
+	// ExecJS executes the given Javascript in the window
+	ExecJS(js string)
+
 	// Browser
 	BrowserOpenURL(url string)
+
+	// Downloads
+	DownloadCancel(id string) error
+
+	// Drag and drop
+	StartDrag(files []string) error
 }
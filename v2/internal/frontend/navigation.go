@@ -0,0 +1,76 @@
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// NavigationStartEvent is emitted with the URL the window is navigating to
+const NavigationStartEvent = "wails:navigation:start"
+
+// NavigationCompleteEvent is emitted with the URL of the page and whether it loaded successfully
+// once the navigation of the window has completed
+const NavigationCompleteEvent = "wails:navigation:complete"
+
+// ResolveURL resolves a URL given to WindowLoadURL against the URL the application was started with.
+// Relative URLs, EG: "settings.html", refer to pages in the assets of the application.
+// If appOnly is set, an error is returned for URLs outside the origin of the application.
+func ResolveURL(startURL string, target string, appOnly bool) (string, error) {
+	base, err := url.Parse(startURL)
+	if err != nil {
+		return "", err
+	}
+	reference, err := url.Parse(target)
+	if err != nil {
+		return "", fmt.Errorf("invalid url '%s': %s", target, err.Error())
+	}
+	result := base.ResolveReference(reference)
+	if appOnly && !IsAppURL(base, result) {
+		return "", fmt.Errorf("'%s' is not a url of the application", target)
+	}
+	return result.String(), nil
+}
+
+// IsAppURL returns true if the URL has the same origin as the URL the application was started with
+func IsAppURL(startURL *url.URL, target *url.URL) bool {
+	return strings.EqualFold(startURL.Scheme, target.Scheme) && strings.EqualFold(startURL.Host, target.Host)
+}
+
+// DecideNavigation returns the action for a navigation of the window from the current URL to the target URL.
+// Navigations leaving the application take the ExternalNavigation action, unless they are exempt, EG: when
+// requested with WindowLoadURL. The action returned by OnBeforeNavigate takes precedence, unless it is
+// NavigationDefault.
+func DecideNavigation(ctx context.Context, appoptions *options.App, startURL, currentURL, target string, exempt bool) options.NavigationAction {
+	if appoptions.OnBeforeNavigate != nil {
+		if action := appoptions.OnBeforeNavigate(ctx, target); action != options.NavigationDefault {
+			return action
+		}
+	}
+	if exempt {
+		return options.NavigationAllow
+	}
+	start, err := url.Parse(startURL)
+	if err != nil {
+		return options.NavigationAllow
+	}
+	current, err := url.Parse(currentURL)
+	if err != nil {
+		return options.NavigationAllow
+	}
+	next, err := url.Parse(target)
+	if err != nil {
+		return options.NavigationAllow
+	}
+	// Only navigations leaving the application are external
+	if !IsAppURL(start, current) || IsAppURL(start, next) || next.Scheme == "about" {
+		return options.NavigationAllow
+	}
+	if appoptions.ExternalNavigation == options.NavigationDefault {
+		return options.NavigationOpenInBrowser
+	}
+	return appoptions.ExternalNavigation
+}
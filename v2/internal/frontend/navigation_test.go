@@ -0,0 +1,73 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+func TestResolveURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		startURL string
+		target   string
+		appOnly  bool
+		want     string
+		wantErr  bool
+	}{
+		{"page", "wails://wails/", "settings.html", false, "wails://wails/settings.html", false},
+		{"absolute path", "file://wails/app/", "/settings.html", true, "file://wails/settings.html", false},
+		{"base path", "file://wails/app/", "settings.html", true, "file://wails/app/settings.html", false},
+		{"dev server", "http://localhost:34115", "settings.html", true, "http://localhost:34115/settings.html", false},
+		{"external", "wails://wails/", "https://example.com/login", false, "https://example.com/login", false},
+		{"external restricted", "wails://wails/", "https://example.com/login", true, "", true},
+		{"other scheme restricted", "file://wails/", "https://wails/", true, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveURL(tt.startURL, tt.target, tt.appOnly)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecideNavigation(t *testing.T) {
+	const startURL = "file://wails/"
+	beforeNavigate := func(ctx context.Context, url string) options.NavigationAction {
+		if url == "https://example.com/allowed" {
+			return options.NavigationAllow
+		}
+		return options.NavigationDefault
+	}
+	tests := []struct {
+		name       string
+		appoptions *options.App
+		currentURL string
+		target     string
+		exempt     bool
+		want       options.NavigationAction
+	}{
+		{"app page", &options.App{}, "file://wails/", "file://wails/settings.html", false, options.NavigationAllow},
+		{"start", &options.App{}, "about:blank", "file://wails/", false, options.NavigationAllow},
+		{"external default", &options.App{}, "file://wails/", "https://example.com", false, options.NavigationOpenInBrowser},
+		{"external policy", &options.App{ExternalNavigation: options.NavigationCancel}, "file://wails/", "https://example.com", false, options.NavigationCancel},
+		{"external exempt", &options.App{}, "file://wails/", "https://example.com", true, options.NavigationAllow},
+		{"from external page", &options.App{}, "https://example.com", "https://example.org", false, options.NavigationAllow},
+		{"before navigate", &options.App{OnBeforeNavigate: beforeNavigate}, "file://wails/", "https://example.com/allowed", false, options.NavigationAllow},
+		{"before navigate default", &options.App{OnBeforeNavigate: beforeNavigate}, "file://wails/", "https://example.com", false, options.NavigationOpenInBrowser},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecideNavigation(context.Background(), tt.appoptions, startURL, tt.currentURL, tt.target, tt.exempt)
+			if got != tt.want {
+				t.Errorf("DecideNavigation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Returns the command-line arguments the application was launched with, without the name of the executable
+ *
+ * @export
+ * @return {Promise<string[]>}
+ */
+export function Args() {
+    return Call(":wails:Args");
+}
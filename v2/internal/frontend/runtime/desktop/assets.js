@@ -0,0 +1,23 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Returns the hash of the frontend assets embedded in the application, which changes whenever the assets change
+ *
+ * @export
+ * @return {Promise<string>}
+ */
+export function AssetsHash() {
+    return Call(":wails:AssetsHash");
+}
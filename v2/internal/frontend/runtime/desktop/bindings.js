@@ -14,6 +14,31 @@ import {Call} from './calls';
 // This is where we bind go method wrappers
 window.go = {};
 
+// newBinding creates the wrapper that calls the given bound method or function
+function newBinding(name) {
+
+	// No timeout by default
+	let timeout = 0;
+
+	// Actual function
+	function dynamic() {
+		const args = [].slice.call(arguments);
+		return Call(name, args, timeout);
+	}
+
+	// Allow setting timeout to function
+	dynamic.setTimeout = function (newTimeout) {
+		timeout = newTimeout;
+	};
+
+	// Allow getting timeout to function
+	dynamic.getTimeout = function () {
+		return timeout;
+	};
+
+	return dynamic;
+}
+
 export function SetBindings(bindingsMap) {
 	try {
 		bindingsMap = JSON.parse(bindingsMap);
@@ -21,46 +46,35 @@ export function SetBindings(bindingsMap) {
 		console.error(e);
 	}
 
-	// Initialise the bindings map
-	window.go = window.go || {};
+	// Initialise the bindings map. Any previous bindings are replaced,
+	// so that methods removed after a rebuild are no longer bound.
+	window.go = {};
 
-	// Iterate package names
+	// Iterate package and function names
 	Object.keys(bindingsMap).forEach((packageName) => {
 
-		// Create inner map if it doesn't exist
-		window.go[packageName] = window.go[packageName] || {};
+		// Functions are bound alongside the packages
+		if (typeof bindingsMap[packageName].name === 'string') {
+			window.go[packageName] = newBinding(packageName);
+			return;
+		}
+
+		// Create inner maps if they don't exist.
+		// Packages namespaced by path have multiple parts, EG: 'internal.auth'
+		let packageMap = window.go;
+		packageName.split('.').forEach((part) => {
+			packageMap[part] = packageMap[part] || {};
+			packageMap = packageMap[part];
+		});
 
 		// Iterate struct names
 		Object.keys(bindingsMap[packageName]).forEach((structName) => {
 
 			// Create inner map if it doesn't exist
-			window.go[packageName][structName] = window.go[packageName][structName] || {};
+			packageMap[structName] = packageMap[structName] || {};
 
 			Object.keys(bindingsMap[packageName][structName]).forEach((methodName) => {
-
-				window.go[packageName][structName][methodName] = function () {
-
-					// No timeout by default
-					let timeout = 0;
-
-					// Actual function
-					function dynamic() {
-						const args = [].slice.call(arguments);
-						return Call([packageName, structName, methodName].join('.'), args, timeout);
-					}
-
-					// Allow setting timeout to function
-					dynamic.setTimeout = function (newTimeout) {
-						timeout = newTimeout;
-					};
-
-					// Allow getting timeout to function
-					dynamic.getTimeout = function () {
-						return timeout;
-					};
-
-					return dynamic;
-				}();
+				packageMap[structName][methodName] = newBinding([packageName, structName, methodName].join('.'));
 			});
 		});
 	});
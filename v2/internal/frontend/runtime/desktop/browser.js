@@ -1,8 +1,20 @@
+import {Call} from "./calls";
+
 /**
- * @description: Use the system default browser to open the url
+ * @description: Use the system default browser to open the url. Only http, https and mailto URLs are opened.
  * @param {string} url 
  * @return {void}
  */
 export function BrowserOpenURL(url) {
   window.WailsInvoke('BO:' + url);
-}
\ No newline at end of file
+}
+
+/**
+ * @description: Opens the given file in the application associated with its type.
+ * Executables and directories are not opened.
+ * @param {string} path
+ * @return {Promise<void>}
+ */
+export function OpenFileInDefaultApp(path) {
+  return Call(":wails:OpenFileInDefaultApp", [path]);
+}
@@ -9,8 +9,13 @@ The electron alternative for Go
 */
 /* jshint esversion: 6 */
 
+import {eventListeners, EventsOn} from './events';
+
 export const callbacks = {};
 
+// The progress of a call is sent as an event named with this prefix and the callbackID
+const progressEventPrefix = 'wails:progress:';
+
 /**
  * Returns a number from the native browser random function
  *
@@ -46,7 +51,11 @@ if (window.crypto) {
  * backend responds. This will be resolved when the call was successful
  * or rejected if an error is passed back.
  * There is a timeout mechanism. If the call doesn't respond in the given
- * time (in milliseconds) then the promise is rejected.
+ * time (in milliseconds) then the promise is rejected and the context of
+ * the call in Go is cancelled.
+ * The ID of the request is available as `requestID` on the returned promise
+ * so that the call can be cancelled using `CallCancel`. Progress sent by the
+ * Go method can be received by registering a handler with `onProgress`.
  *
  * @export
  * @param {string} name
@@ -61,14 +70,14 @@ export function Call(name, args, timeout) {
 		timeout = 0;
 	}
 
-	// Create a promise
-	return new Promise(function (resolve, reject) {
+	// Create a unique callbackID
+	var callbackID;
+	do {
+		callbackID = name + '-' + randomFunc();
+	} while (callbacks[callbackID]);
 
-		// Create a unique callbackID
-		var callbackID;
-		do {
-			callbackID = name + '-' + randomFunc();
-		} while (callbacks[callbackID]);
+	// Create a promise
+	const promise = new Promise(function (resolve, reject) {
 
 		var timeoutHandle;
 		// Set timeout
@@ -90,6 +99,7 @@ export function Call(name, args, timeout) {
 				name,
 				args,
 				callbackID,
+				timeout,
 			};
 
 			// Make the call
@@ -99,6 +109,25 @@ export function Call(name, args, timeout) {
 			console.error(e);
 		}
 	});
+	promise.requestID = callbackID;
+	promise.onProgress = function (callback) {
+		EventsOn(progressEventPrefix + callbackID, callback);
+		return promise;
+	};
+
+	return promise;
+}
+
+/**
+ * CallCancel cancels the context of an in-flight call to a bound method.
+ * The call's promise is still settled with the result of the method, which
+ * is usually an error once the method observes the cancellation.
+ *
+ * @export
+ * @param {string} requestID
+ */
+export function CallCancel(requestID) {
+	window.WailsInvoke('X' + requestID);
 }
 
 
@@ -130,6 +159,7 @@ export function Callback(incomingMessage) {
 	clearTimeout(callbackData.timeoutHandle);
 
 	delete callbacks[callbackID];
+	delete eventListeners[progressEventPrefix + callbackID];
 
 	if (message.error) {
 		callbackData.reject(message.error);
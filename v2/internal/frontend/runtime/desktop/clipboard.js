@@ -0,0 +1,36 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Returns the image on the clipboard as base64 encoded PNG data, or null if the clipboard holds no image.
+ * Only supported on Windows.
+ *
+ * @export
+ * @return {Promise<string|null>}
+ */
+export function ClipboardGetImage() {
+    return Call(":wails:ClipboardGetImage", []);
+}
+
+/**
+ * Replaces the contents of the clipboard with an image, given as base64 encoded PNG data.
+ * Only supported on Windows.
+ *
+ * @export
+ * @param {string} data
+ * @return {Promise<void>}
+ */
+export function ClipboardSetImage(data) {
+    return Call(":wails:ClipboardSetImage", [data]);
+}
@@ -0,0 +1,90 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+// The cursor is set by Go, which uses the native cursors where it can. Where the webview draws the cursor itself,
+// Go sets it with a stylesheet that overrides the page.
+let cursorStyle = null;
+
+/**
+ * Sets the cursor shown over the whole window, overriding the cursors set by the page.
+ * Takes the name of a CSS cursor, EG: `crosshair` or `wait`. `arrow`, `hand` and `ibeam` are also accepted.
+ * An empty name restores the cursors of the page.
+ *
+ * @export
+ * @param {string} cursor
+ */
+export function SetCursor(cursor) {
+    window.WailsInvoke('WC' + (cursor || ''));
+}
+
+/**
+ * Sets the cursor shown over the whole window to an .ico or .png image
+ *
+ * @export
+ * @param {string} url - URL of the image, EG: a data URL
+ * @param {number} hotspotX
+ * @param {number} hotspotY
+ * @return {Promise<void>}
+ */
+export function SetCustomCursor(url, hotspotX, hotspotY) {
+    return fetch(url)
+        .then((response) => {
+            if (!response.ok) {
+                throw new Error('Unable to load the cursor ' + url + ': ' + response.status);
+            }
+            return response.blob();
+        })
+        .then((blob) => new Promise((resolve, reject) => {
+            const reader = new FileReader();
+            reader.onload = () => resolve(reader.result.slice(reader.result.indexOf(',') + 1));
+            reader.onerror = () => reject(reader.error);
+            reader.readAsDataURL(blob);
+        }))
+        .then((image) => {
+            window.WailsInvoke('WX' + JSON.stringify({image, hotspotX: hotspotX || 0, hotspotY: hotspotY || 0}));
+        });
+}
+
+/**
+ * Hides the cursor while it is over the window
+ *
+ * @export
+ */
+export function HideCursor() {
+    window.WailsInvoke('WK1');
+}
+
+/**
+ * Shows the cursor again after HideCursor
+ *
+ * @export
+ */
+export function ShowCursor() {
+    window.WailsInvoke('WK0');
+}
+
+/**
+ * Sets the CSS cursor overriding the cursors of the page. Called by Go, so it isn't part of the JS runtime.
+ * An empty cursor restores the cursors of the page.
+ *
+ * @param {string} cursor
+ */
+export function SetCursorStyle(cursor) {
+    if (cursorStyle === null) {
+        if (!cursor) {
+            return;
+        }
+        cursorStyle = document.createElement('style');
+        (document.head || document.documentElement).appendChild(cursorStyle);
+    }
+    cursorStyle.textContent = cursor ? '*, *::before, *::after { cursor: ' + cursor + ' !important; }' : '';
+}
@@ -0,0 +1,24 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Cancels a download of the webview on Windows. The ID of the download is given by the `wails:download:*` events.
+ *
+ * @export
+ * @param {string} id
+ * @return {Promise<void>}
+ */
+export function DownloadCancel(id) {
+    return Call(":wails:DownloadCancel", [id]);
+}
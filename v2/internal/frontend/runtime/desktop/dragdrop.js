@@ -0,0 +1,25 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Drags files from the window to other applications on Windows, EG: to Explorer.
+ * It must be called while the left mouse button is held down, such as from a `mousedown` event handler.
+ *
+ * @export
+ * @param {string[]} files - absolute paths of the files
+ * @return {Promise<void>}
+ */
+export function StartDrag(files) {
+    return Call(":wails:StartDrag", [files]);
+}
@@ -0,0 +1,34 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Returns true if the application is running with administrator rights, or as root on macOS and Linux
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function IsElevated() {
+    return Call(":wails:IsElevated");
+}
+
+/**
+ * Restarts the application with administrator rights and the same arguments, once the user has accepted the UAC prompt.
+ * The promise is rejected, and the application keeps running, if the prompt is cancelled. Only supported on Windows.
+ *
+ * @export
+ * @return {Promise<void>}
+ */
+export function RelaunchElevated() {
+    return Call(":wails:RelaunchElevated");
+}
@@ -0,0 +1,134 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {EventsEmit} from './events';
+
+// Input events are forwarded at most once per frame, so moving a finger or spinning a wheel doesn't flood the app
+let enabled = {wheel: false, touch: false, gesture: false};
+let pendingWheel = null;
+let pendingTouchMove = null;
+let frameRequested = false;
+
+function flush() {
+    frameRequested = false;
+    if (pendingWheel !== null) {
+        EventsEmit('wails:input:wheel', pendingWheel);
+        pendingWheel = null;
+    }
+    if (pendingTouchMove !== null) {
+        EventsEmit('wails:input:touch', pendingTouchMove);
+        pendingTouchMove = null;
+    }
+}
+
+function requestFlush() {
+    if (!frameRequested) {
+        frameRequested = true;
+        window.requestAnimationFrame(flush);
+    }
+}
+
+function onWheel(e) {
+    if (pendingWheel === null) {
+        pendingWheel = {deltaX: 0, deltaY: 0, deltaZ: 0};
+    }
+    pendingWheel.deltaX += e.deltaX;
+    pendingWheel.deltaY += e.deltaY;
+    pendingWheel.deltaZ += e.deltaZ;
+    pendingWheel.deltaMode = e.deltaMode;
+    pendingWheel.x = e.clientX;
+    pendingWheel.y = e.clientY;
+    pendingWheel.ctrlKey = e.ctrlKey;
+    pendingWheel.shiftKey = e.shiftKey;
+    pendingWheel.altKey = e.altKey;
+    pendingWheel.metaKey = e.metaKey;
+    requestFlush();
+}
+
+function touchList(touches) {
+    return Array.prototype.map.call(touches, (touch) => ({
+        id: touch.identifier,
+        x: touch.clientX,
+        y: touch.clientY,
+        force: touch.force,
+    }));
+}
+
+function touchData(type, e) {
+    return {
+        type: type,
+        touches: touchList(e.touches),
+        changed: touchList(e.changedTouches),
+    };
+}
+
+function onTouch(e) {
+    const type = e.type.substring('touch'.length);
+    if (type === 'move') {
+        pendingTouchMove = touchData(type, e);
+        requestFlush();
+        return;
+    }
+    // Send any pending move first, so the events stay in order
+    flush();
+    EventsEmit('wails:input:touch', touchData(type, e));
+}
+
+// Gesture events are only sent by WebKit on macOS
+function onGesture(e) {
+    EventsEmit('wails:input:gesture', {
+        type: e.type.substring('gesture'.length),
+        scale: e.scale,
+        rotation: e.rotation,
+        x: e.clientX,
+        y: e.clientY,
+    });
+}
+
+function listen(names, listener, enable) {
+    names.forEach((name) => {
+        if (enable) {
+            window.addEventListener(name, listener, {capture: true, passive: true});
+        } else {
+            window.removeEventListener(name, listener, {capture: true});
+        }
+    });
+}
+
+/**
+ * Sets which input events are forwarded as the `wails:input:wheel`, `wails:input:touch` and
+ * `wails:input:gesture` events, which Go can listen to
+ *
+ * @export
+ * @param {{wheel?: boolean, touch?: boolean, gesture?: boolean}} events
+ */
+export function SetInputEvents(events) {
+    events = events || {};
+    window.WailsInvoke('WG' + JSON.stringify({wheel: !!events.wheel, touch: !!events.touch, gesture: !!events.gesture}));
+}
+
+// SetPageInputEvents sets which input events are forwarded from the page. It is called by Go, which forwards
+// the events it can read natively itself.
+export function SetPageInputEvents(events) {
+    events = events || {};
+    const wheel = !!events.wheel, touch = !!events.touch, gesture = !!events.gesture;
+    if (wheel !== enabled.wheel) {
+        listen(['wheel'], onWheel, wheel);
+    }
+    if (touch !== enabled.touch) {
+        listen(['touchstart', 'touchmove', 'touchend', 'touchcancel'], onTouch, touch);
+    }
+    if (gesture !== enabled.gesture) {
+        listen(['gesturestart', 'gesturechange', 'gestureend'], onGesture, gesture);
+    }
+    enabled = {wheel, touch, gesture};
+}
@@ -0,0 +1,36 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Replaces the tasks shown in the jump list of the application on Windows.
+ * Launching the application from a task emits the `wails:jumplist:task` event with the ID of the task.
+ *
+ * @export
+ * @param {JumpListTask[]} tasks
+ * @return {Promise<void>}
+ */
+export function SetJumpListTasks(tasks) {
+    return Call(":wails:SetJumpListTasks", [tasks]);
+}
+
+/**
+ * Adds a file to the recent documents of the application, shown in its jump list on Windows
+ *
+ * @export
+ * @param {string} path - absolute path of the file
+ * @return {Promise<void>}
+ */
+export function AddRecentDocument(path) {
+    return Call(":wails:AddRecentDocument", [path]);
+}
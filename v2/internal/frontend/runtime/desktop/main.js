@@ -10,26 +10,54 @@ The electron alternative for Go
 /* jshint esversion: 9 */
 import * as Log from './log';
 import {eventListeners, EventsEmit, EventsNotify, EventsOff, EventsOn, EventsOnce, EventsOnMultiple} from './events';
-import {Callback, callbacks} from './calls';
+import {Callback, CallCancel, callbacks} from './calls';
 import {SetBindings} from "./bindings";
 import * as Window from "./window";
 import * as Browser from "./browser";
+import * as SecureStorage from "./securestorage";
+import * as Settings from "./settings";
+import * as JumpList from "./jumplist";
+import * as Cursor from "./cursor";
+import * as Input from "./input";
+import * as Assets from "./assets";
+import * as Args from "./args";
+import * as Elevation from "./elevation";
+import * as Downloads from "./downloads";
+import * as Clipboard from "./clipboard";
+import * as DragDrop from "./dragdrop";
 
 
-export function Quit() {
-    window.WailsInvoke('Q');
+export function Quit(exitCode) {
+    // The process exits with the code once the application has shut down
+    window.WailsInvoke('Q' + (Number.isInteger(exitCode) ? exitCode : ''));
 }
 
+// SetCursorStyle and SetPageInputEvents are internal endpoints
+const {SetCursorStyle, ...CursorRuntime} = Cursor;
+const {SetPageInputEvents, ...InputRuntime} = Input;
+
 // The JS runtime
 window.runtime = {
     ...Log,
     ...Window,
     ...Browser,
+    ...SecureStorage,
+    ...Settings,
+    ...JumpList,
+    ...CursorRuntime,
+    ...InputRuntime,
+    ...Assets,
+    ...Args,
+    ...Elevation,
+    ...Downloads,
+    ...Clipboard,
+    ...DragDrop,
     EventsOn,
     EventsOnce,
     EventsOnMultiple,
     EventsEmit,
     EventsOff,
+    CallCancel,
     Quit
 };
 
@@ -38,6 +66,8 @@ window.wails = {
     Callback,
     EventsNotify,
     SetBindings,
+    SetCursorStyle,
+    SetPageInputEvents,
     eventListeners,
     callbacks,
     flags: {
@@ -51,13 +81,15 @@ window.wails = {
 
 // Set the bindings
 window.wails.SetBindings(window.wailsbindings);
-delete window.wails.SetBindings;
 
 // This is evaluated at build time in package.json
 // const dev = 0;
 // const production = 1;
 if (ENV === 0) {
     delete window.wailsbindings;
+} else {
+    // The bindings are only updated after a rebuild in dev mode
+    delete window.wails.SetBindings;
 }
 
 // Setup drag handler
@@ -0,0 +1,50 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Returns the secret stored with the given key.
+ * The promise is rejected with an error with the code `NotFound` if there is no secret with the key,
+ * or `AccessDenied` if the credential store can't be accessed.
+ *
+ * @export
+ * @param {string} key
+ * @return {Promise<string>}
+ */
+export function SecureStorageGet(key) {
+    return Call(":wails:SecureStorageGet", [key]);
+}
+
+/**
+ * Stores a secret with the given key in the credential store of the operating system,
+ * replacing any existing secret
+ *
+ * @export
+ * @param {string} key
+ * @param {string} value
+ * @return {Promise<void>}
+ */
+export function SecureStorageSet(key, value) {
+    return Call(":wails:SecureStorageSet", [key, value]);
+}
+
+/**
+ * Deletes the secret stored with the given key
+ *
+ * @export
+ * @param {string} key
+ * @return {Promise<void>}
+ */
+export function SecureStorageDelete(key) {
+    return Call(":wails:SecureStorageDelete", [key]);
+}
@@ -0,0 +1,57 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+import {Call} from "./calls";
+
+/**
+ * Returns the setting with the given key, or null if it doesn't exist
+ *
+ * @export
+ * @param {string} key
+ * @return {Promise<any>}
+ */
+export function SettingsGet(key) {
+    return Call(":wails:SettingsGet", [key]);
+}
+
+/**
+ * Sets the setting with the given key. The value must be serialisable to JSON.
+ *
+ * @export
+ * @param {string} key
+ * @param {any} value
+ * @return {Promise<void>}
+ */
+export function SettingsSet(key, value) {
+    return Call(":wails:SettingsSet", [key, value]);
+}
+
+/**
+ * Deletes the setting with the given key
+ *
+ * @export
+ * @param {string} key
+ * @return {Promise<void>}
+ */
+export function SettingsDelete(key) {
+    return Call(":wails:SettingsDelete", [key]);
+}
+
+/**
+ * Returns the path of the file the settings are saved to
+ *
+ * @export
+ * @return {Promise<string>}
+ */
+export function SettingsPath() {
+    return Call(":wails:SettingsPath");
+}
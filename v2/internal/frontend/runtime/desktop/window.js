@@ -17,6 +17,18 @@ export function WindowReload() {
     window.location.reload();
 }
 
+/**
+ * Loads the given URL in the window. Relative URLs, EG: "settings.html",
+ * load the pages in the assets of the application.
+ *
+ * @export
+ * @param {string} url
+ * @return {Promise<void>}
+ */
+export function WindowLoadURL(url) {
+    return Call(":wails:WindowLoadURL", [url]);
+}
+
 /**
  * Place the window in the center of the screen
  *
@@ -137,6 +149,15 @@ export function WindowShow() {
     window.WailsInvoke('WS');
 }
 
+/**
+ * Show the Window without taking the focus from the application the user is in
+ *
+ * @export
+ */
+export function WindowShowNoActivate() {
+    window.WailsInvoke('WN');
+}
+
 /**
  * Maximise the Window
  *
@@ -185,3 +206,75 @@ export function WindowSetRGBA(RGBA) {
     window.WailsInvoke('Wr:' + rgba);
 }
 
+
+/**
+ * Sets whether mouse events pass through the window to the windows beneath it
+ *
+ * @export
+ * @param {boolean} ignore
+ */
+export function WindowSetIgnoreMouseEvents(ignore) {
+    window.WailsInvoke('WI' + (ignore ? '1' : '0'));
+}
+
+/**
+ * Sets whether the window is kept beneath all other windows, EG: for desktop widgets
+ *
+ * @export
+ * @param {boolean} bottom
+ */
+export function WindowSetAlwaysOnBottom(bottom) {
+    window.WailsInvoke('WB' + (bottom ? '1' : '0'));
+}
+
+/**
+ * Makes the window fullscreen and hides the cursor while it is over the window. On Windows, the display is also kept
+ * on and the computer kept awake. ExitPresentationMode restores the previous state.
+ *
+ * @export
+ */
+export function EnterPresentationMode() {
+    window.WailsInvoke('WP1');
+}
+
+/**
+ * Leaves presentation mode, restoring the state of the window from before it was entered
+ *
+ * @export
+ */
+export function ExitPresentationMode() {
+    window.WailsInvoke('WP0');
+}
+
+/**
+ * Enables or disables mouse and keyboard input to the window, EG: while a native dialog is shown.
+ * Not supported on macOS.
+ *
+ * @export
+ * @param {boolean} enabled
+ */
+export function WindowSetEnabled(enabled) {
+    window.WailsInvoke('WE' + (enabled ? '1' : '0'));
+}
+
+/**
+ * Returns true if the window accepts mouse and keyboard input
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WindowIsEnabled() {
+    return Call(":wails:WindowIsEnabled");
+}
+
+/**
+ * Replaces the buttons in the toolbar of the taskbar thumbnail of the window on Windows.
+ * Clicking a button emits the `wails:thumbnailbutton:click` event with the ID of the button.
+ *
+ * @export
+ * @param {ThumbnailButton[]} buttons - a maximum of 7 buttons
+ * @return {Promise<void>}
+ */
+export function WindowSetThumbnailButtons(buttons) {
+    return Call(":wails:WindowSetThumbnailButtons", [buttons]);
+}
@@ -80,7 +80,8 @@ function handleDisconnect() {
 
 function _connect() {
     if (websocket == null) {
-        websocket = new WebSocket('ws://' + window.location.hostname + ':34115/wails/ipc');
+        // The page is served by the dev server, so the websocket uses the same host and port
+        websocket = new WebSocket('ws://' + window.location.host + '/wails/ipc');
         websocket.onopen = handleConnect;
         websocket.onerror = function (e) {
             e.stopImmediatePropagation();
@@ -115,6 +116,10 @@ function handleMessage(message) {
             const callbackData = message.data.slice(1);
             window.wails.Callback(callbackData);
             break;
+        // Bindings, sent on connect so that they are updated after a rebuild
+        case 'b':
+            window.wails.SetBindings(message.data.slice(1));
+            break;
         default:
             log('Unknown message: ' + message.data);
     }
@@ -21,394 +21,730 @@
     SetLogLevel: () => SetLogLevel
   });
   function sendLogMessage(level, message) {
-    window.WailsInvoke("L" + level + message);
+  	window.WailsInvoke('L' + level + message);
   }
   function LogTrace(message) {
-    sendLogMessage("T", message);
+  	sendLogMessage('T', message);
   }
   function LogPrint(message) {
-    sendLogMessage("P", message);
+  	sendLogMessage('P', message);
   }
   function LogDebug(message) {
-    sendLogMessage("D", message);
+  	sendLogMessage('D', message);
   }
   function LogInfo(message) {
-    sendLogMessage("I", message);
+  	sendLogMessage('I', message);
   }
   function LogWarning(message) {
-    sendLogMessage("W", message);
+  	sendLogMessage('W', message);
   }
   function LogError(message) {
-    sendLogMessage("E", message);
+  	sendLogMessage('E', message);
   }
   function LogFatal(message) {
-    sendLogMessage("F", message);
+  	sendLogMessage('F', message);
   }
   function SetLogLevel(loglevel) {
-    sendLogMessage("S", loglevel);
-  }
-  var LogLevel = {
-    TRACE: 1,
-    DEBUG: 2,
-    INFO: 3,
-    WARNING: 4,
-    ERROR: 5
+  	sendLogMessage('S', loglevel);
+  }
+  const LogLevel = {
+  	TRACE: 1,
+  	DEBUG: 2,
+  	INFO: 3,
+  	WARNING: 4,
+  	ERROR: 5,
   };
 
   // desktop/events.js
-  var Listener = class {
-    constructor(callback, maxCallbacks) {
-      maxCallbacks = maxCallbacks || -1;
-      this.Callback = (data) => {
-        callback.apply(null, data);
-        if (maxCallbacks === -1) {
-          return false;
-        }
-        maxCallbacks -= 1;
-        return maxCallbacks === 0;
-      };
-    }
-  };
-  var eventListeners = {};
+  class Listener {
+      constructor(callback, maxCallbacks) {
+          maxCallbacks = maxCallbacks || -1;
+          this.Callback = (data) => {
+              callback.apply(null, data);
+              if (maxCallbacks === -1) {
+                  return false;
+              }
+              maxCallbacks -= 1;
+              return maxCallbacks === 0;
+          };
+      }
+  }
+  const eventListeners = {};
   function EventsOnMultiple(eventName, callback, maxCallbacks) {
-    eventListeners[eventName] = eventListeners[eventName] || [];
-    const thisListener = new Listener(callback, maxCallbacks);
-    eventListeners[eventName].push(thisListener);
+      eventListeners[eventName] = eventListeners[eventName] || [];
+      const thisListener = new Listener(callback, maxCallbacks);
+      eventListeners[eventName].push(thisListener);
   }
   function EventsOn(eventName, callback) {
-    EventsOnMultiple(eventName, callback, -1);
+      EventsOnMultiple(eventName, callback, -1);
   }
   function EventsOnce(eventName, callback) {
-    EventsOnMultiple(eventName, callback, 1);
+      EventsOnMultiple(eventName, callback, 1);
   }
   function notifyListeners(eventData) {
-    let eventName = eventData.name;
-    if (eventListeners[eventName]) {
-      const newEventListenerList = eventListeners[eventName].slice();
-      for (let count = 0; count < eventListeners[eventName].length; count += 1) {
-        const listener = eventListeners[eventName][count];
-        let data = eventData.data;
-        const destroy = listener.Callback(data);
-        if (destroy) {
-          newEventListenerList.splice(count, 1);
-        }
+      let eventName = eventData.name;
+      if (eventListeners[eventName]) {
+          const newEventListenerList = eventListeners[eventName].slice();
+          for (let count = 0; count < eventListeners[eventName].length; count += 1) {
+              const listener = eventListeners[eventName][count];
+              let data = eventData.data;
+              const destroy = listener.Callback(data);
+              if (destroy) {
+                  newEventListenerList.splice(count, 1);
+              }
+          }
+          eventListeners[eventName] = newEventListenerList;
       }
-      eventListeners[eventName] = newEventListenerList;
-    }
   }
   function EventsNotify(notifyMessage) {
-    let message;
-    try {
-      message = JSON.parse(notifyMessage);
-    } catch (e) {
-      const error = "Invalid JSON passed to Notify: " + notifyMessage;
-      throw new Error(error);
-    }
-    notifyListeners(message);
+      let message;
+      try {
+          message = JSON.parse(notifyMessage);
+      } catch (e) {
+          const error = 'Invalid JSON passed to Notify: ' + notifyMessage;
+          throw new Error(error);
+      }
+      notifyListeners(message);
   }
   function EventsEmit(eventName) {
-    const payload = {
-      name: eventName,
-      data: [].slice.apply(arguments).slice(1)
-    };
-    notifyListeners(payload);
-    window.WailsInvoke("EE" + JSON.stringify(payload));
+      const payload = {
+          name: eventName,
+          data: [].slice.apply(arguments).slice(1),
+      };
+      notifyListeners(payload);
+      window.WailsInvoke('EE' + JSON.stringify(payload));
   }
   function EventsOff(eventName) {
-    delete eventListeners[eventName];
-    window.WailsInvoke("EX" + eventName);
+      delete eventListeners[eventName];
+      window.WailsInvoke('EX' + eventName);
   }
 
   // desktop/calls.js
-  var callbacks = {};
+  const callbacks = {};
+  const progressEventPrefix = 'wails:progress:';
   function cryptoRandom() {
-    var array = new Uint32Array(1);
-    return window.crypto.getRandomValues(array)[0];
+  	var array = new Uint32Array(1);
+  	return window.crypto.getRandomValues(array)[0];
   }
   function basicRandom() {
-    return Math.random() * 9007199254740991;
+  	return Math.random() * 9007199254740991;
   }
   var randomFunc;
   if (window.crypto) {
-    randomFunc = cryptoRandom;
+  	randomFunc = cryptoRandom;
   } else {
-    randomFunc = basicRandom;
+  	randomFunc = basicRandom;
   }
   function Call(name, args, timeout) {
-    if (timeout == null) {
-      timeout = 0;
-    }
-    return new Promise(function(resolve, reject) {
-      var callbackID;
-      do {
-        callbackID = name + "-" + randomFunc();
-      } while (callbacks[callbackID]);
-      var timeoutHandle;
-      if (timeout > 0) {
-        timeoutHandle = setTimeout(function() {
-          reject(Error("Call to " + name + " timed out. Request ID: " + callbackID));
-        }, timeout);
-      }
-      callbacks[callbackID] = {
-        timeoutHandle,
-        reject,
-        resolve
-      };
-      try {
-        const payload = {
-          name,
-          args,
-          callbackID
-        };
-        window.WailsInvoke("C" + JSON.stringify(payload));
-      } catch (e) {
-        console.error(e);
-      }
-    });
+  	if (timeout == null) {
+  		timeout = 0;
+  	}
+  	var callbackID;
+  	do {
+  		callbackID = name + '-' + randomFunc();
+  	} while (callbacks[callbackID]);
+  	const promise = new Promise(function (resolve, reject) {
+  		var timeoutHandle;
+  		if (timeout > 0) {
+  			timeoutHandle = setTimeout(function () {
+  				reject(Error('Call to ' + name + ' timed out. Request ID: ' + callbackID));
+  			}, timeout);
+  		}
+  		callbacks[callbackID] = {
+  			timeoutHandle: timeoutHandle,
+  			reject: reject,
+  			resolve: resolve
+  		};
+  		try {
+  			const payload = {
+  				name,
+  				args,
+  				callbackID,
+  				timeout,
+  			};
+  			window.WailsInvoke('C' + JSON.stringify(payload));
+  		} catch (e) {
+  			console.error(e);
+  		}
+  	});
+  	promise.requestID = callbackID;
+  	promise.onProgress = function (callback) {
+  		EventsOn(progressEventPrefix + callbackID, callback);
+  		return promise;
+  	};
+  	return promise;
+  }
+  function CallCancel(requestID) {
+  	window.WailsInvoke('X' + requestID);
   }
   function Callback(incomingMessage) {
-      let message;
-      try {
-          message = JSON.parse(incomingMessage);
-      } catch (e) {
-          const error = `Invalid JSON passed to callback: ${e.message}. Message: ${incomingMessage}`;
-          runtime.LogDebug(error);
-          throw new Error(error);
-      }
-      let callbackID = message.callbackid;
-      let callbackData = callbacks[callbackID];
-      if (!callbackData) {
-          const error = `Callback '${callbackID}' not registered!!!`;
-          console.error(error);
-          throw new Error(error);
-      }
-      clearTimeout(callbackData.timeoutHandle);
-      delete callbacks[callbackID];
-      if (message.error) {
-          callbackData.reject(message.error);
-      } else {
-      callbackData.resolve(message.result);
-    }
+  	let message;
+  	try {
+  		message = JSON.parse(incomingMessage);
+  	} catch (e) {
+  		const error = `Invalid JSON passed to callback: ${e.message}. Message: ${incomingMessage}`;
+  		runtime.LogDebug(error);
+  		throw new Error(error);
+  	}
+  	let callbackID = message.callbackid;
+  	let callbackData = callbacks[callbackID];
+  	if (!callbackData) {
+  		const error = `Callback '${callbackID}' not registered!!!`;
+  		console.error(error);
+  		throw new Error(error);
+  	}
+  	clearTimeout(callbackData.timeoutHandle);
+  	delete callbacks[callbackID];
+  	delete eventListeners[progressEventPrefix + callbackID];
+  	if (message.error) {
+  		callbackData.reject(message.error);
+  	} else {
+  		callbackData.resolve(message.result);
+  	}
   }
 
   // desktop/bindings.js
   window.go = {};
+  function newBinding(name) {
+  	let timeout = 0;
+  	function dynamic() {
+  		const args = [].slice.call(arguments);
+  		return Call(name, args, timeout);
+  	}
+  	dynamic.setTimeout = function (newTimeout) {
+  		timeout = newTimeout;
+  	};
+  	dynamic.getTimeout = function () {
+  		return timeout;
+  	};
+  	return dynamic;
+  }
   function SetBindings(bindingsMap) {
-    try {
-      bindingsMap = JSON.parse(bindingsMap);
-    } catch (e) {
-      console.error(e);
-    }
-    window.go = window.go || {};
-    Object.keys(bindingsMap).forEach((packageName) => {
-      window.go[packageName] = window.go[packageName] || {};
-      Object.keys(bindingsMap[packageName]).forEach((structName) => {
-        window.go[packageName][structName] = window.go[packageName][structName] || {};
-        Object.keys(bindingsMap[packageName][structName]).forEach((methodName) => {
-          window.go[packageName][structName][methodName] = function() {
-            let timeout = 0;
-            function dynamic() {
-              const args = [].slice.call(arguments);
-              return Call([packageName, structName, methodName].join("."), args, timeout);
-            }
-            dynamic.setTimeout = function(newTimeout) {
-              timeout = newTimeout;
-            };
-            dynamic.getTimeout = function() {
-              return timeout;
-            };
-            return dynamic;
-          }();
-        });
-      });
-    });
+  	try {
+  		bindingsMap = JSON.parse(bindingsMap);
+  	} catch (e) {
+  		console.error(e);
+  	}
+  	window.go = {};
+  	Object.keys(bindingsMap).forEach((packageName) => {
+  		if (typeof bindingsMap[packageName].name === 'string') {
+  			window.go[packageName] = newBinding(packageName);
+  			return;
+  		}
+  		let packageMap = window.go;
+  		packageName.split('.').forEach((part) => {
+  			packageMap[part] = packageMap[part] || {};
+  			packageMap = packageMap[part];
+  		});
+  		Object.keys(bindingsMap[packageName]).forEach((structName) => {
+  			packageMap[structName] = packageMap[structName] || {};
+  			Object.keys(bindingsMap[packageName][structName]).forEach((methodName) => {
+  				packageMap[structName][methodName] = newBinding([packageName, structName, methodName].join('.'));
+  			});
+  		});
+  	});
   }
 
   // desktop/window.js
   var window_exports = {};
   __export(window_exports, {
+    EnterPresentationMode: () => EnterPresentationMode,
+    ExitPresentationMode: () => ExitPresentationMode,
     WindowCenter: () => WindowCenter,
     WindowFullscreen: () => WindowFullscreen,
     WindowGetPosition: () => WindowGetPosition,
     WindowGetSize: () => WindowGetSize,
     WindowHide: () => WindowHide,
+    WindowIsEnabled: () => WindowIsEnabled,
+    WindowLoadURL: () => WindowLoadURL,
     WindowMaximise: () => WindowMaximise,
     WindowMinimise: () => WindowMinimise,
     WindowReload: () => WindowReload,
+    WindowSetAlwaysOnBottom: () => WindowSetAlwaysOnBottom,
+    WindowSetEnabled: () => WindowSetEnabled,
+    WindowSetIgnoreMouseEvents: () => WindowSetIgnoreMouseEvents,
     WindowSetMaxSize: () => WindowSetMaxSize,
     WindowSetMinSize: () => WindowSetMinSize,
     WindowSetPosition: () => WindowSetPosition,
     WindowSetRGBA: () => WindowSetRGBA,
     WindowSetSize: () => WindowSetSize,
+    WindowSetThumbnailButtons: () => WindowSetThumbnailButtons,
     WindowSetTitle: () => WindowSetTitle,
     WindowShow: () => WindowShow,
+    WindowShowNoActivate: () => WindowShowNoActivate,
     WindowUnFullscreen: () => WindowUnFullscreen,
     WindowUnmaximise: () => WindowUnmaximise,
     WindowUnminimise: () => WindowUnminimise
   });
   function WindowReload() {
-    window.location.reload();
+      window.location.reload();
+  }
+  function WindowLoadURL(url) {
+      return Call(":wails:WindowLoadURL", [url]);
   }
   function WindowCenter() {
-    window.WailsInvoke("Wc");
+      window.WailsInvoke('Wc');
   }
   function WindowSetTitle(title) {
-    window.WailsInvoke("WT" + title);
+      window.WailsInvoke('WT' + title);
   }
   function WindowFullscreen() {
-    window.WailsInvoke("WF");
+      window.WailsInvoke('WF');
   }
   function WindowUnFullscreen() {
-    window.WailsInvoke("Wf");
+      window.WailsInvoke('Wf');
   }
   function WindowSetSize(width, height) {
-    window.WailsInvoke("Ws:" + width + ":" + height);
+      window.WailsInvoke('Ws:' + width + ':' + height);
   }
   function WindowGetSize() {
-    return Call(":wails:WindowGetSize");
+      return Call(":wails:WindowGetSize");
   }
   function WindowSetMaxSize(width, height) {
-    window.WailsInvoke("WZ:" + width + ":" + height);
+      window.WailsInvoke('WZ:' + width + ':' + height);
   }
   function WindowSetMinSize(width, height) {
-    window.WailsInvoke("Wz:" + width + ":" + height);
+      window.WailsInvoke('Wz:' + width + ':' + height);
   }
   function WindowSetPosition(x, y) {
-    window.WailsInvoke("Wp:" + x + ":" + y);
+      window.WailsInvoke('Wp:' + x + ':' + y);
   }
   function WindowGetPosition() {
-    return Call(":wails:WindowGetPos");
+      return Call(":wails:WindowGetPos");
   }
   function WindowHide() {
-    window.WailsInvoke("WH");
+      window.WailsInvoke('WH');
   }
   function WindowShow() {
-    window.WailsInvoke("WS");
+      window.WailsInvoke('WS');
+  }
+  function WindowShowNoActivate() {
+      window.WailsInvoke('WN');
   }
   function WindowMaximise() {
-    window.WailsInvoke("WM");
+      window.WailsInvoke('WM');
   }
   function WindowUnmaximise() {
-    window.WailsInvoke("WU");
+      window.WailsInvoke('WU');
   }
   function WindowMinimise() {
-    window.WailsInvoke("Wm");
+      window.WailsInvoke('Wm');
   }
   function WindowUnminimise() {
-    window.WailsInvoke("Wu");
+      window.WailsInvoke('Wu');
   }
   function WindowSetRGBA(RGBA) {
-    let rgba = JSON.stringify(RGBA);
-    window.WailsInvoke("Wr:" + rgba);
+      let rgba = JSON.stringify(RGBA);
+      window.WailsInvoke('Wr:' + rgba);
+  }
+  function WindowSetIgnoreMouseEvents(ignore) {
+      window.WailsInvoke('WI' + (ignore ? '1' : '0'));
+  }
+  function WindowSetAlwaysOnBottom(bottom) {
+      window.WailsInvoke('WB' + (bottom ? '1' : '0'));
+  }
+  function EnterPresentationMode() {
+      window.WailsInvoke('WP1');
+  }
+  function ExitPresentationMode() {
+      window.WailsInvoke('WP0');
+  }
+  function WindowSetEnabled(enabled) {
+      window.WailsInvoke('WE' + (enabled ? '1' : '0'));
+  }
+  function WindowIsEnabled() {
+      return Call(":wails:WindowIsEnabled");
+  }
+  function WindowSetThumbnailButtons(buttons) {
+      return Call(":wails:WindowSetThumbnailButtons", [buttons]);
   }
 
   // desktop/browser.js
   var browser_exports = {};
   __export(browser_exports, {
-    BrowserOpenURL: () => BrowserOpenURL
+    BrowserOpenURL: () => BrowserOpenURL,
+    OpenFileInDefaultApp: () => OpenFileInDefaultApp
   });
   function BrowserOpenURL(url) {
-    window.WailsInvoke("BO:" + url);
+    window.WailsInvoke('BO:' + url);
+  }
+  function OpenFileInDefaultApp(path) {
+    return Call(":wails:OpenFileInDefaultApp", [path]);
+  }
+
+  // desktop/securestorage.js
+  var securestorage_exports = {};
+  __export(securestorage_exports, {
+    SecureStorageDelete: () => SecureStorageDelete,
+    SecureStorageGet: () => SecureStorageGet,
+    SecureStorageSet: () => SecureStorageSet
+  });
+  function SecureStorageGet(key) {
+      return Call(":wails:SecureStorageGet", [key]);
+  }
+  function SecureStorageSet(key, value) {
+      return Call(":wails:SecureStorageSet", [key, value]);
+  }
+  function SecureStorageDelete(key) {
+      return Call(":wails:SecureStorageDelete", [key]);
+  }
+
+  // desktop/settings.js
+  var settings_exports = {};
+  __export(settings_exports, {
+    SettingsDelete: () => SettingsDelete,
+    SettingsGet: () => SettingsGet,
+    SettingsPath: () => SettingsPath,
+    SettingsSet: () => SettingsSet
+  });
+  function SettingsGet(key) {
+      return Call(":wails:SettingsGet", [key]);
+  }
+  function SettingsSet(key, value) {
+      return Call(":wails:SettingsSet", [key, value]);
+  }
+  function SettingsDelete(key) {
+      return Call(":wails:SettingsDelete", [key]);
+  }
+  function SettingsPath() {
+      return Call(":wails:SettingsPath");
+  }
+
+  // desktop/jumplist.js
+  var jumplist_exports = {};
+  __export(jumplist_exports, {
+    AddRecentDocument: () => AddRecentDocument,
+    SetJumpListTasks: () => SetJumpListTasks
+  });
+  function SetJumpListTasks(tasks) {
+      return Call(":wails:SetJumpListTasks", [tasks]);
+  }
+  function AddRecentDocument(path) {
+      return Call(":wails:AddRecentDocument", [path]);
+  }
+
+  // desktop/cursor.js
+  var cursor_exports = {};
+  __export(cursor_exports, {
+    HideCursor: () => HideCursor,
+    SetCursor: () => SetCursor,
+    SetCursorStyle: () => SetCursorStyle,
+    SetCustomCursor: () => SetCustomCursor,
+    ShowCursor: () => ShowCursor
+  });
+  let cursorStyle = null;
+  function SetCursor(cursor) {
+      window.WailsInvoke('WC' + (cursor || ''));
+  }
+  function SetCustomCursor(url, hotspotX, hotspotY) {
+      return fetch(url)
+          .then((response) => {
+              if (!response.ok) {
+                  throw new Error('Unable to load the cursor ' + url + ': ' + response.status);
+              }
+              return response.blob();
+          })
+          .then((blob) => new Promise((resolve, reject) => {
+              const reader = new FileReader();
+              reader.onload = () => resolve(reader.result.slice(reader.result.indexOf(',') + 1));
+              reader.onerror = () => reject(reader.error);
+              reader.readAsDataURL(blob);
+          }))
+          .then((image) => {
+              window.WailsInvoke('WX' + JSON.stringify({image, hotspotX: hotspotX || 0, hotspotY: hotspotY || 0}));
+          });
+  }
+  function HideCursor() {
+      window.WailsInvoke('WK1');
+  }
+  function ShowCursor() {
+      window.WailsInvoke('WK0');
+  }
+  function SetCursorStyle(cursor) {
+      if (cursorStyle === null) {
+          if (!cursor) {
+              return;
+          }
+          cursorStyle = document.createElement('style');
+          (document.head || document.documentElement).appendChild(cursorStyle);
+      }
+      cursorStyle.textContent = cursor ? '*, *::before, *::after { cursor: ' + cursor + ' !important; }' : '';
+  }
+
+  // desktop/input.js
+  var input_exports = {};
+  __export(input_exports, {
+    SetInputEvents: () => SetInputEvents,
+    SetPageInputEvents: () => SetPageInputEvents
+  });
+  let enabled = {wheel: false, touch: false, gesture: false};
+  let pendingWheel = null;
+  let pendingTouchMove = null;
+  let frameRequested = false;
+  function flush() {
+      frameRequested = false;
+      if (pendingWheel !== null) {
+          EventsEmit('wails:input:wheel', pendingWheel);
+          pendingWheel = null;
+      }
+      if (pendingTouchMove !== null) {
+          EventsEmit('wails:input:touch', pendingTouchMove);
+          pendingTouchMove = null;
+      }
+  }
+  function requestFlush() {
+      if (!frameRequested) {
+          frameRequested = true;
+          window.requestAnimationFrame(flush);
+      }
+  }
+  function onWheel(e) {
+      if (pendingWheel === null) {
+          pendingWheel = {deltaX: 0, deltaY: 0, deltaZ: 0};
+      }
+      pendingWheel.deltaX += e.deltaX;
+      pendingWheel.deltaY += e.deltaY;
+      pendingWheel.deltaZ += e.deltaZ;
+      pendingWheel.deltaMode = e.deltaMode;
+      pendingWheel.x = e.clientX;
+      pendingWheel.y = e.clientY;
+      pendingWheel.ctrlKey = e.ctrlKey;
+      pendingWheel.shiftKey = e.shiftKey;
+      pendingWheel.altKey = e.altKey;
+      pendingWheel.metaKey = e.metaKey;
+      requestFlush();
+  }
+  function touchList(touches) {
+      return Array.prototype.map.call(touches, (touch) => ({
+          id: touch.identifier,
+          x: touch.clientX,
+          y: touch.clientY,
+          force: touch.force,
+      }));
+  }
+  function touchData(type, e) {
+      return {
+          type: type,
+          touches: touchList(e.touches),
+          changed: touchList(e.changedTouches),
+      };
+  }
+  function onTouch(e) {
+      const type = e.type.substring('touch'.length);
+      if (type === 'move') {
+          pendingTouchMove = touchData(type, e);
+          requestFlush();
+          return;
+      }
+      flush();
+      EventsEmit('wails:input:touch', touchData(type, e));
+  }
+  function onGesture(e) {
+      EventsEmit('wails:input:gesture', {
+          type: e.type.substring('gesture'.length),
+          scale: e.scale,
+          rotation: e.rotation,
+          x: e.clientX,
+          y: e.clientY,
+      });
+  }
+  function listen(names, listener, enable) {
+      names.forEach((name) => {
+          if (enable) {
+              window.addEventListener(name, listener, {capture: true, passive: true});
+          } else {
+              window.removeEventListener(name, listener, {capture: true});
+          }
+      });
+  }
+  function SetInputEvents(events) {
+      events = events || {};
+      window.WailsInvoke('WG' + JSON.stringify({wheel: !!events.wheel, touch: !!events.touch, gesture: !!events.gesture}));
+  }
+  function SetPageInputEvents(events) {
+      events = events || {};
+      const wheel = !!events.wheel, touch = !!events.touch, gesture = !!events.gesture;
+      if (wheel !== enabled.wheel) {
+          listen(['wheel'], onWheel, wheel);
+      }
+      if (touch !== enabled.touch) {
+          listen(['touchstart', 'touchmove', 'touchend', 'touchcancel'], onTouch, touch);
+      }
+      if (gesture !== enabled.gesture) {
+          listen(['gesturestart', 'gesturechange', 'gestureend'], onGesture, gesture);
+      }
+      enabled = {wheel, touch, gesture};
+  }
+
+  // desktop/assets.js
+  var assets_exports = {};
+  __export(assets_exports, {
+    AssetsHash: () => AssetsHash
+  });
+  function AssetsHash() {
+      return Call(":wails:AssetsHash");
+  }
+
+  // desktop/args.js
+  var args_exports = {};
+  __export(args_exports, {
+    Args: () => Args
+  });
+  function Args() {
+      return Call(":wails:Args");
+  }
+
+  // desktop/elevation.js
+  var elevation_exports = {};
+  __export(elevation_exports, {
+    IsElevated: () => IsElevated,
+    RelaunchElevated: () => RelaunchElevated
+  });
+  function IsElevated() {
+      return Call(":wails:IsElevated");
+  }
+  function RelaunchElevated() {
+      return Call(":wails:RelaunchElevated");
+  }
+
+  // desktop/downloads.js
+  var downloads_exports = {};
+  __export(downloads_exports, {
+    DownloadCancel: () => DownloadCancel
+  });
+  function DownloadCancel(id) {
+      return Call(":wails:DownloadCancel", [id]);
+  }
+
+  // desktop/clipboard.js
+  var clipboard_exports = {};
+  __export(clipboard_exports, {
+    ClipboardGetImage: () => ClipboardGetImage,
+    ClipboardSetImage: () => ClipboardSetImage
+  });
+  function ClipboardGetImage() {
+      return Call(":wails:ClipboardGetImage", []);
+  }
+  function ClipboardSetImage(data) {
+      return Call(":wails:ClipboardSetImage", [data]);
+  }
+
+  // desktop/dragdrop.js
+  var dragdrop_exports = {};
+  __export(dragdrop_exports, {
+    StartDrag: () => StartDrag
+  });
+  function StartDrag(files) {
+      return Call(":wails:StartDrag", [files]);
   }
 
   // desktop/main.js
-  function Quit() {
-    window.WailsInvoke("Q");
+  function Quit(exitCode) {
+      window.WailsInvoke('Q' + (Number.isInteger(exitCode) ? exitCode : ''));
   }
+  const {SetCursorStyle: SetCursorStyle2, ...CursorRuntime} = cursor_exports;
+  const {SetPageInputEvents: SetPageInputEvents2, ...InputRuntime} = input_exports;
   window.runtime = {
-    ...log_exports,
-    ...window_exports,
-    ...browser_exports,
-    EventsOn,
-    EventsOnce,
-    EventsOnMultiple,
-    EventsEmit,
-    EventsOff,
-    Quit
+      ...log_exports,
+      ...window_exports,
+      ...browser_exports,
+      ...securestorage_exports,
+      ...settings_exports,
+      ...jumplist_exports,
+      ...CursorRuntime,
+      ...InputRuntime,
+      ...assets_exports,
+      ...args_exports,
+      ...elevation_exports,
+      ...downloads_exports,
+      ...clipboard_exports,
+      ...dragdrop_exports,
+      EventsOn,
+      EventsOnce,
+      EventsOnMultiple,
+      EventsEmit,
+      EventsOff,
+      CallCancel,
+      Quit
   };
   window.wails = {
-    Callback,
-    EventsNotify,
-    SetBindings,
-    eventListeners,
-    callbacks,
-    flags: {
-      disableScrollbarDrag: false,
-      disableWailsDefaultContextMenu: false,
-      enableResize: false,
-      defaultCursor: null,
-      borderThickness: 6
-    }
+      Callback,
+      EventsNotify,
+      SetBindings,
+      SetCursorStyle: SetCursorStyle2,
+      SetPageInputEvents: SetPageInputEvents2,
+      eventListeners,
+      callbacks,
+      flags: {
+          disableScrollbarDrag: false,
+          disableWailsDefaultContextMenu: false,
+          enableResize: false,
+          defaultCursor: null,
+          borderThickness: 6
+      }
   };
   window.wails.SetBindings(window.wailsbindings);
-  delete window.wails.SetBindings;
-  if (true) {
-    delete window.wailsbindings;
-  }
-  window.addEventListener("mousedown", (e) => {
-    if (window.wails.flags.resizeEdge) {
-      window.WailsInvoke("resize:" + window.wails.flags.resizeEdge);
-      e.preventDefault();
-      return;
-    }
-    let currentElement = e.target;
-    while (currentElement != null) {
-      if (currentElement.hasAttribute("data-wails-no-drag")) {
-        break;
-      } else if (currentElement.hasAttribute("data-wails-drag")) {
-        if (window.wails.flags.disableScrollbarDrag) {
-          if (e.offsetX > e.target.clientWidth || e.offsetY > e.target.clientHeight) {
-            break;
+  if (0 === 0) {
+      delete window.wailsbindings;
+  } else {
+      delete window.wails.SetBindings;
+  }
+  window.addEventListener('mousedown', (e) => {
+      if (window.wails.flags.resizeEdge) {
+          window.WailsInvoke("resize:" + window.wails.flags.resizeEdge);
+          e.preventDefault();
+          return;
+      }
+      let currentElement = e.target;
+      while (currentElement != null) {
+          if (currentElement.hasAttribute('data-wails-no-drag')) {
+              break;
+          } else if (currentElement.hasAttribute('data-wails-drag')) {
+              if (window.wails.flags.disableScrollbarDrag) {
+                  if (e.offsetX > e.target.clientWidth || e.offsetY > e.target.clientHeight) {
+                      break;
+                  }
+              }
+              window.WailsInvoke("drag");
+              e.preventDefault();
+              break;
           }
-        }
-        window.WailsInvoke("drag");
-        e.preventDefault();
-        break;
+          currentElement = currentElement.parentElement;
       }
-      currentElement = currentElement.parentElement;
-    }
   });
   function setResize(cursor) {
-    document.body.style.cursor = cursor || window.wails.flags.defaultCursor;
-    window.wails.flags.resizeEdge = cursor;
-  }
-  window.addEventListener("mousemove", function(e) {
-    if (!window.wails.flags.enableResize) {
-      return;
-    }
-    if (window.wails.flags.defaultCursor == null) {
-      window.wails.flags.defaultCursor = document.body.style.cursor;
-    }
-    if (window.outerWidth - e.clientX < window.wails.flags.borderThickness && window.outerHeight - e.clientY < window.wails.flags.borderThickness) {
-      document.body.style.cursor = "se-resize";
-    }
-    let rightBorder = window.outerWidth - e.clientX < window.wails.flags.borderThickness;
-    let leftBorder = e.clientX < window.wails.flags.borderThickness;
-    let topBorder = e.clientY < window.wails.flags.borderThickness;
-    let bottomBorder = window.outerHeight - e.clientY < window.wails.flags.borderThickness;
-    if (!leftBorder && !rightBorder && !topBorder && !bottomBorder && window.wails.flags.resizeEdge !== void 0) {
-      setResize();
-    } else if (rightBorder && bottomBorder)
-      setResize("se-resize");
-    else if (leftBorder && bottomBorder)
-      setResize("sw-resize");
-    else if (leftBorder && topBorder)
-      setResize("nw-resize");
-    else if (topBorder && rightBorder)
-      setResize("ne-resize");
-    else if (leftBorder)
-      setResize("w-resize");
-    else if (topBorder)
-      setResize("n-resize");
-    else if (bottomBorder)
-      setResize("s-resize");
-    else if (rightBorder)
-      setResize("e-resize");
+      document.body.style.cursor = cursor || window.wails.flags.defaultCursor;
+      window.wails.flags.resizeEdge = cursor;
+  }
+  window.addEventListener('mousemove', function (e) {
+      if (!window.wails.flags.enableResize) {
+          return;
+      }
+      if (window.wails.flags.defaultCursor == null) {
+          window.wails.flags.defaultCursor = document.body.style.cursor;
+      }
+      if (window.outerWidth - e.clientX < window.wails.flags.borderThickness && window.outerHeight - e.clientY < window.wails.flags.borderThickness) {
+          document.body.style.cursor = "se-resize";
+      }
+      let rightBorder = window.outerWidth - e.clientX < window.wails.flags.borderThickness;
+      let leftBorder = e.clientX < window.wails.flags.borderThickness;
+      let topBorder = e.clientY < window.wails.flags.borderThickness;
+      let bottomBorder = window.outerHeight - e.clientY < window.wails.flags.borderThickness;
+      if (!leftBorder && !rightBorder && !topBorder && !bottomBorder && window.wails.flags.resizeEdge !== undefined) {
+          setResize();
+      } else if (rightBorder && bottomBorder) setResize("se-resize");
+      else if (leftBorder && bottomBorder) setResize("sw-resize");
+      else if (leftBorder && topBorder) setResize("nw-resize");
+      else if (topBorder && rightBorder) setResize("ne-resize");
+      else if (leftBorder) setResize("w-resize");
+      else if (topBorder) setResize("n-resize");
+      else if (bottomBorder) setResize("s-resize");
+      else if (rightBorder) setResize("e-resize");
   });
-  window.addEventListener("contextmenu", function(e) {
-    if (window.wails.flags.disableWailsDefaultContextMenu) {
-      e.preventDefault();
-    }
+  window.addEventListener('contextmenu', function (e) {
+      if (window.wails.flags.disableWailsDefaultContextMenu) {
+          e.preventDefault();
+      }
   });
 })();
-//# sourceMappingURL=data:application/json;base64,ewogICJ2ZXJzaW9uIjogMywKICAic291cmNlcyI6IFsiZGVza3RvcC9sb2cuanMiLCAiZGVza3RvcC9ldmVudHMuanMiLCAiZGVza3RvcC9jYWxscy5qcyIsICJkZXNrdG9wL2JpbmRpbmdzLmpzIiwgImRlc2t0b3Avd2luZG93LmpzIiwgImRlc2t0b3AvYnJvd3Nlci5qcyIsICJkZXNrdG9wL21haW4uanMiXSwKICAic291cmNlc0NvbnRlbnQiOiBbIi8qXHJcbiBfICAgICAgIF9fICAgICAgXyBfX1xyXG58IHwgICAgIC8gL19fXyBfKF8pIC9fX19fXHJcbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cclxufCB8LyB8LyAvIC9fLyAvIC8gKF9fICApXHJcbnxfXy98X18vXFxfXyxfL18vXy9fX19fL1xyXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXHJcbihjKSBMZWEgQW50aG9ueSAyMDE5LXByZXNlbnRcclxuKi9cclxuXHJcbi8qIGpzaGludCBlc3ZlcnNpb246IDYgKi9cclxuXHJcbi8qKlxyXG4gKiBTZW5kcyBhIGxvZyBtZXNzYWdlIHRvIHRoZSBiYWNrZW5kIHdpdGggdGhlIGdpdmVuIGxldmVsICsgbWVzc2FnZVxyXG4gKlxyXG4gKiBAcGFyYW0ge3N0cmluZ30gbGV2ZWxcclxuICogQHBhcmFtIHtzdHJpbmd9IG1lc3NhZ2VcclxuICovXHJcbmZ1bmN0aW9uIHNlbmRMb2dNZXNzYWdlKGxldmVsLCBtZXNzYWdlKSB7XHJcblxyXG5cdC8vIExvZyBNZXNzYWdlIGZvcm1hdDpcclxuXHQvLyBsW3R5cGVdW21lc3NhZ2VdXHJcblx0d2luZG93LldhaWxzSW52b2tlKCdMJyArIGxldmVsICsgbWVzc2FnZSk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBMb2cgdGhlIGdpdmVuIHRyYWNlIG1lc3NhZ2Ugd2l0aCB0aGUgYmFja2VuZFxyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqIEBwYXJhbSB7c3RyaW5nfSBtZXNzYWdlXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gTG9nVHJhY2UobWVzc2FnZSkge1xyXG5cdHNlbmRMb2dNZXNzYWdlKCdUJywgbWVzc2FnZSk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBMb2cgdGhlIGdpdmVuIG1lc3NhZ2Ugd2l0aCB0aGUgYmFja2VuZFxyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqIEBwYXJhbSB7c3RyaW5nfSBtZXNzYWdlXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gTG9nUHJpbnQobWVzc2FnZSkge1xyXG5cdHNlbmRMb2dNZXNzYWdlKCdQJywgbWVzc2FnZSk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBMb2cgdGhlIGdpdmVuIGRlYnVnIG1lc3NhZ2Ugd2l0aCB0aGUgYmFja2VuZFxyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqIEBwYXJhbSB7c3RyaW5nfSBtZXNzYWdlXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gTG9nRGVidWcobWVzc2FnZSkge1xyXG5cdHNlbmRMb2dNZXNzYWdlKCdEJywgbWVzc2FnZSk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBMb2cgdGhlIGdpdmVuIGluZm8gbWVzc2FnZSB3aXRoIHRoZSBiYWNrZW5kXHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtzdHJpbmd9IG1lc3NhZ2VcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBMb2dJbmZvKG1lc3NhZ2UpIHtcclxuXHRzZW5kTG9nTWVzc2FnZSgnSScsIG1lc3NhZ2UpO1xyXG59XHJcblxyXG4vKipcclxuICogTG9nIHRoZSBnaXZlbiB3YXJuaW5nIG1lc3NhZ2Ugd2l0aCB0aGUgYmFja2VuZFxyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqIEBwYXJhbSB7c3RyaW5nfSBtZXNzYWdlXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gTG9nV2FybmluZyhtZXNzYWdlKSB7XHJcblx0c2VuZExvZ01lc3NhZ2UoJ1cnLCBtZXNzYWdlKTtcclxufVxyXG5cclxuLyoqXHJcbiAqIExvZyB0aGUgZ2l2ZW4gZXJyb3IgbWVzc2FnZSB3aXRoIHRoZSBiYWNrZW5kXHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtzdHJpbmd9IG1lc3NhZ2VcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBMb2dFcnJvcihtZXNzYWdlKSB7XHJcblx0c2VuZExvZ01lc3NhZ2UoJ0UnLCBtZXNzYWdlKTtcclxufVxyXG5cclxuLyoqXHJcbiAqIExvZyB0aGUgZ2l2ZW4gZmF0YWwgbWVzc2FnZSB3aXRoIHRoZSBiYWNrZW5kXHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtzdHJpbmd9IG1lc3NhZ2VcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBMb2dGYXRhbChtZXNzYWdlKSB7XHJcblx0c2VuZExvZ01lc3NhZ2UoJ0YnLCBtZXNzYWdlKTtcclxufVxyXG5cclxuLyoqXHJcbiAqIFNldHMgdGhlIExvZyBsZXZlbCB0byB0aGUgZ2l2ZW4gbG9nIGxldmVsXHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtudW1iZXJ9IGxvZ2xldmVsXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gU2V0TG9nTGV2ZWwobG9nbGV2ZWwpIHtcclxuXHRzZW5kTG9nTWVzc2FnZSgnUycsIGxvZ2xldmVsKTtcclxufVxyXG5cclxuLy8gTG9nIGxldmVsc1xyXG5leHBvcnQgY29uc3QgTG9nTGV2ZWwgPSB7XHJcblx0VFJBQ0U6IDEsXHJcblx0REVCVUc6IDIsXHJcblx0SU5GTzogMyxcclxuXHRXQVJOSU5HOiA0LFxyXG5cdEVSUk9SOiA1LFxyXG59O1xyXG4iLCAiLypcclxuIF8gICAgICAgX18gICAgICBfIF9fXHJcbnwgfCAgICAgLyAvX19fIF8oXykgL19fX19cclxufCB8IC98IC8gLyBfXyBgLyAvIC8gX19fL1xyXG58IHwvIHwvIC8gL18vIC8gLyAoX18gIClcclxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXHJcblRoZSBlbGVjdHJvbiBhbHRlcm5hdGl2ZSBmb3IgR29cclxuKGMpIExlYSBBbnRob255IDIwMTktcHJlc2VudFxyXG4qL1xyXG4vKiBqc2hpbnQgZXN2ZXJzaW9uOiA2ICovXHJcblxyXG4vLyBEZWZpbmVzIGEgc2luZ2xlIGxpc3RlbmVyIHdpdGggYSBtYXhpbXVtIG51bWJlciBvZiB0aW1lcyB0byBjYWxsYmFja1xyXG5cclxuLyoqXHJcbiAqIFRoZSBMaXN0ZW5lciBjbGFzcyBkZWZpbmVzIGEgbGlzdGVuZXIhIDotKVxyXG4gKlxyXG4gKiBAY2xhc3MgTGlzdGVuZXJcclxuICovXHJcbmNsYXNzIExpc3RlbmVyIHtcclxuICAgIC8qKlxyXG4gICAgICogQ3JlYXRlcyBhbiBpbnN0YW5jZSBvZiBMaXN0ZW5lci5cclxuICAgICAqIEBwYXJhbSB7ZnVuY3Rpb259IGNhbGxiYWNrXHJcbiAgICAgKiBAcGFyYW0ge251bWJlcn0gbWF4Q2FsbGJhY2tzXHJcbiAgICAgKiBAbWVtYmVyb2YgTGlzdGVuZXJcclxuICAgICAqL1xyXG4gICAgY29uc3RydWN0b3IoY2FsbGJhY2ssIG1heENhbGxiYWNrcykge1xyXG4gICAgICAgIC8vIERlZmF1bHQgb2YgLTEgbWVhbnMgaW5maW5pdGVcclxuICAgICAgICBtYXhDYWxsYmFja3MgPSBtYXhDYWxsYmFja3MgfHwgLTE7XHJcbiAgICAgICAgLy8gQ2FsbGJhY2sgaW52b2tlcyB0aGUgY2FsbGJhY2sgd2l0aCB0aGUgZ2l2ZW4gZGF0YVxyXG4gICAgICAgIC8vIFJldHVybnMgdHJ1ZSBpZiB0aGlzIGxpc3RlbmVyIHNob3VsZCBiZSBkZXN0cm95ZWRcclxuICAgICAgICB0aGlzLkNhbGxiYWNrID0gKGRhdGEpID0+IHtcclxuICAgICAgICAgICAgY2FsbGJhY2suYXBwbHkobnVsbCwgZGF0YSk7XHJcbiAgICAgICAgICAgIC8vIElmIG1heENhbGxiYWNrcyBpcyBpbmZpbml0ZSwgcmV0dXJuIGZhbHNlIChkbyBub3QgZGVzdHJveSlcclxuICAgICAgICAgICAgaWYgKG1heENhbGxiYWNrcyA9PT0gLTEpIHtcclxuICAgICAgICAgICAgICAgIHJldHVybiBmYWxzZTtcclxuICAgICAgICAgICAgfVxyXG4gICAgICAgICAgICAvLyBEZWNyZW1lbnQgbWF4Q2FsbGJhY2tzLiBSZXR1cm4gdHJ1ZSBpZiBub3cgMCwgb3RoZXJ3aXNlIGZhbHNlXHJcbiAgICAgICAgICAgIG1heENhbGxiYWNrcyAtPSAxO1xyXG4gICAgICAgICAgICByZXR1cm4gbWF4Q2FsbGJhY2tzID09PSAwO1xyXG4gICAgICAgIH07XHJcbiAgICB9XHJcbn1cclxuXHJcbmV4cG9ydCBjb25zdCBldmVudExpc3RlbmVycyA9IHt9O1xyXG5cclxuLyoqXHJcbiAqIFJlZ2lzdGVycyBhbiBldmVudCBsaXN0ZW5lciB0aGF0IHdpbGwgYmUgaW52b2tlZCBgbWF4Q2FsbGJhY2tzYCB0aW1lcyBiZWZvcmUgYmVpbmcgZGVzdHJveWVkXHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtzdHJpbmd9IGV2ZW50TmFtZVxyXG4gKiBAcGFyYW0ge2Z1bmN0aW9ufSBjYWxsYmFja1xyXG4gKiBAcGFyYW0ge251bWJlcn0gbWF4Q2FsbGJhY2tzXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gRXZlbnRzT25NdWx0aXBsZShldmVudE5hbWUsIGNhbGxiYWNrLCBtYXhDYWxsYmFja3MpIHtcclxuICAgIGV2ZW50TGlzdGVuZXJzW2V2ZW50TmFtZV0gPSBldmVudExpc3RlbmVyc1tldmVudE5hbWVdIHx8IFtdO1xyXG4gICAgY29uc3QgdGhpc0xpc3RlbmVyID0gbmV3IExpc3RlbmVyKGNhbGxiYWNrLCBtYXhDYWxsYmFja3MpO1xyXG4gICAgZXZlbnRMaXN0ZW5lcnNbZXZlbnROYW1lXS5wdXNoKHRoaXNMaXN0ZW5lcik7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBSZWdpc3RlcnMgYW4gZXZlbnQgbGlzdGVuZXIgdGhhdCB3aWxsIGJlIGludm9rZWQgZXZlcnkgdGltZSB0aGUgZXZlbnQgaXMgZW1pdHRlZFxyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqIEBwYXJhbSB7c3RyaW5nfSBldmVudE5hbWVcclxuICogQHBhcmFtIHtmdW5jdGlvbn0gY2FsbGJhY2tcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBFdmVudHNPbihldmVudE5hbWUsIGNhbGxiYWNrKSB7XHJcbiAgICBFdmVudHNPbk11bHRpcGxlKGV2ZW50TmFtZSwgY2FsbGJhY2ssIC0xKTtcclxufVxyXG5cclxuLyoqXHJcbiAqIFJlZ2lzdGVycyBhbiBldmVudCBsaXN0ZW5lciB0aGF0IHdpbGwgYmUgaW52b2tlZCBvbmNlIHRoZW4gZGVzdHJveWVkXHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtzdHJpbmd9IGV2ZW50TmFtZVxyXG4gKiBAcGFyYW0ge2Z1bmN0aW9ufSBjYWxsYmFja1xyXG4gKi9cclxuZXhwb3J0IGZ1bmN0aW9uIEV2ZW50c09uY2UoZXZlbnROYW1lLCBjYWxsYmFjaykge1xyXG4gICAgRXZlbnRzT25NdWx0aXBsZShldmVudE5hbWUsIGNhbGxiYWNrLCAxKTtcclxufVxyXG5cclxuZnVuY3Rpb24gbm90aWZ5TGlzdGVuZXJzKGV2ZW50RGF0YSkge1xyXG5cclxuICAgIC8vIEdldCB0aGUgZXZlbnQgbmFtZVxyXG4gICAgbGV0IGV2ZW50TmFtZSA9IGV2ZW50RGF0YS5uYW1lO1xyXG5cclxuICAgIC8vIENoZWNrIGlmIHdlIGhhdmUgYW55IGxpc3RlbmVycyBmb3IgdGhpcyBldmVudFxyXG4gICAgaWYgKGV2ZW50TGlzdGVuZXJzW2V2ZW50TmFtZV0pIHtcclxuXHJcbiAgICAgICAgLy8gS2VlcCBhIGxpc3Qgb2YgbGlzdGVuZXIgaW5kZXhlcyB0byBkZXN0cm95XHJcbiAgICAgICAgY29uc3QgbmV3RXZlbnRMaXN0ZW5lckxpc3QgPSBldmVudExpc3RlbmVyc1tldmVudE5hbWVdLnNsaWNlKCk7XHJcblxyXG4gICAgICAgIC8vIEl0ZXJhdGUgbGlzdGVuZXJzXHJcbiAgICAgICAgZm9yIChsZXQgY291bnQgPSAwOyBjb3VudCA8IGV2ZW50TGlzdGVuZXJzW2V2ZW50TmFtZV0ubGVuZ3RoOyBjb3VudCArPSAxKSB7XHJcblxyXG4gICAgICAgICAgICAvLyBHZXQgbmV4dCBsaXN0ZW5lclxyXG4gICAgICAgICAgICBjb25zdCBsaXN0ZW5lciA9IGV2ZW50TGlzdGVuZXJzW2V2ZW50TmFtZV1bY291bnRdO1xyXG5cclxuICAgICAgICAgICAgbGV0IGRhdGEgPSBldmVudERhdGEuZGF0YTtcclxuXHJcbiAgICAgICAgICAgIC8vIERvIHRoZSBjYWxsYmFja1xyXG4gICAgICAgICAgICBjb25zdCBkZXN0cm95ID0gbGlzdGVuZXIuQ2FsbGJhY2soZGF0YSk7XHJcbiAgICAgICAgICAgIGlmIChkZXN0cm95KSB7XHJcbiAgICAgICAgICAgICAgICAvLyBpZiB0aGUgbGlzdGVuZXIgaW5kaWNhdGVkIHRvIGRlc3Ryb3kgaXRzZWxmLCBhZGQgaXQgdG8gdGhlIGRlc3Ryb3kgbGlzdFxyXG4gICAgICAgICAgICAgICAgbmV3RXZlbnRMaXN0ZW5lckxpc3Quc3BsaWNlKGNvdW50LCAxKTtcclxuICAgICAgICAgICAgfVxyXG4gICAgICAgIH1cclxuXHJcbiAgICAgICAgLy8gVXBkYXRlIGNhbGxiYWNrcyB3aXRoIG5ldyBsaXN0IG9mIGxpc3RlbmVyc1xyXG4gICAgICAgIGV2ZW50TGlzdGVuZXJzW2V2ZW50TmFtZV0gPSBuZXdFdmVudExpc3RlbmVyTGlzdDtcclxuICAgIH1cclxufVxyXG5cclxuLyoqXHJcbiAqIE5vdGlmeSBpbmZvcm1zIGZyb250ZW5kIGxpc3RlbmVycyB0aGF0IGFuIGV2ZW50IHdhcyBlbWl0dGVkIHdpdGggdGhlIGdpdmVuIGRhdGFcclxuICpcclxuICogQGV4cG9ydFxyXG4gKiBAcGFyYW0ge3N0cmluZ30gbm90aWZ5TWVzc2FnZSAtIGVuY29kZWQgbm90aWZpY2F0aW9uIG1lc3NhZ2VcclxuXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gRXZlbnRzTm90aWZ5KG5vdGlmeU1lc3NhZ2UpIHtcclxuICAgIC8vIFBhcnNlIHRoZSBtZXNzYWdlXHJcbiAgICBsZXQgbWVzc2FnZTtcclxuICAgIHRyeSB7XHJcbiAgICAgICAgbWVzc2FnZSA9IEpTT04ucGFyc2Uobm90aWZ5TWVzc2FnZSk7XHJcbiAgICB9IGNhdGNoIChlKSB7XHJcbiAgICAgICAgY29uc3QgZXJyb3IgPSAnSW52YWxpZCBKU09OIHBhc3NlZCB0byBOb3RpZnk6ICcgKyBub3RpZnlNZXNzYWdlO1xyXG4gICAgICAgIHRocm93IG5ldyBFcnJvcihlcnJvcik7XHJcbiAgICB9XHJcbiAgICBub3RpZnlMaXN0ZW5lcnMobWVzc2FnZSk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBFbWl0IGFuIGV2ZW50IHdpdGggdGhlIGdpdmVuIG5hbWUgYW5kIGRhdGFcclxuICpcclxuICogQGV4cG9ydFxyXG4gKiBAcGFyYW0ge3N0cmluZ30gZXZlbnROYW1lXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gRXZlbnRzRW1pdChldmVudE5hbWUpIHtcclxuXHJcbiAgICBjb25zdCBwYXlsb2FkID0ge1xyXG4gICAgICAgIG5hbWU6IGV2ZW50TmFtZSxcclxuICAgICAgICBkYXRhOiBbXS5zbGljZS5hcHBseShhcmd1bWVudHMpLnNsaWNlKDEpLFxyXG4gICAgfTtcclxuXHJcbiAgICAvLyBOb3RpZnkgSlMgbGlzdGVuZXJzXHJcbiAgICBub3RpZnlMaXN0ZW5lcnMocGF5bG9hZCk7XHJcblxyXG4gICAgLy8gTm90aWZ5IEdvIGxpc3RlbmVyc1xyXG4gICAgd2luZG93LldhaWxzSW52b2tlKCdFRScgKyBKU09OLnN0cmluZ2lmeShwYXlsb2FkKSk7XHJcbn1cclxuXHJcbmV4cG9ydCBmdW5jdGlvbiBFdmVudHNPZmYoZXZlbnROYW1lKSB7XHJcbiAgICAvLyBSZW1vdmUgbG9jYWwgbGlzdGVuZXJzXHJcbiAgICBkZWxldGUgZXZlbnRMaXN0ZW5lcnNbZXZlbnROYW1lXTtcclxuXHJcbiAgICAvLyBOb3RpZnkgR28gbGlzdGVuZXJzXHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ0VYJyArIGV2ZW50TmFtZSk7XHJcbn0iLCAiLypcclxuIF8gICAgICAgX18gICAgICBfIF9fXHJcbnwgfCAgICAgLyAvX19fIF8oXykgL19fX19cclxufCB8IC98IC8gLyBfXyBgLyAvIC8gX19fL1xyXG58IHwvIHwvIC8gL18vIC8gLyAoX18gIClcclxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXHJcblRoZSBlbGVjdHJvbiBhbHRlcm5hdGl2ZSBmb3IgR29cclxuKGMpIExlYSBBbnRob255IDIwMTktcHJlc2VudFxyXG4qL1xyXG4vKiBqc2hpbnQgZXN2ZXJzaW9uOiA2ICovXHJcblxyXG5leHBvcnQgY29uc3QgY2FsbGJhY2tzID0ge307XHJcblxyXG4vKipcclxuICogUmV0dXJucyBhIG51bWJlciBmcm9tIHRoZSBuYXRpdmUgYnJvd3NlciByYW5kb20gZnVuY3Rpb25cclxuICpcclxuICogQHJldHVybnMgbnVtYmVyXHJcbiAqL1xyXG5mdW5jdGlvbiBjcnlwdG9SYW5kb20oKSB7XHJcblx0dmFyIGFycmF5ID0gbmV3IFVpbnQzMkFycmF5KDEpO1xyXG5cdHJldHVybiB3aW5kb3cuY3J5cHRvLmdldFJhbmRvbVZhbHVlcyhhcnJheSlbMF07XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBSZXR1cm5zIGEgbnVtYmVyIHVzaW5nIGRhIG9sZC1za29vbCBNYXRoLlJhbmRvbVxyXG4gKiBJIGxpa2VzIHRvIGNhbGwgaXQgTE9MUmFuZG9tXHJcbiAqXHJcbiAqIEByZXR1cm5zIG51bWJlclxyXG4gKi9cclxuZnVuY3Rpb24gYmFzaWNSYW5kb20oKSB7XHJcblx0cmV0dXJuIE1hdGgucmFuZG9tKCkgKiA5MDA3MTk5MjU0NzQwOTkxO1xyXG59XHJcblxyXG4vLyBQaWNrIGEgcmFuZG9tIG51bWJlciBmdW5jdGlvbiBiYXNlZCBvbiBicm93c2VyIGNhcGFiaWxpdHlcclxudmFyIHJhbmRvbUZ1bmM7XHJcbmlmICh3aW5kb3cuY3J5cHRvKSB7XHJcblx0cmFuZG9tRnVuYyA9IGNyeXB0b1JhbmRvbTtcclxufSBlbHNlIHtcclxuXHRyYW5kb21GdW5jID0gYmFzaWNSYW5kb207XHJcbn1cclxuXHJcblxyXG4vKipcclxuICogQ2FsbCBzZW5kcyBhIG1lc3NhZ2UgdG8gdGhlIGJhY2tlbmQgdG8gY2FsbCB0aGUgYmluZGluZyB3aXRoIHRoZVxyXG4gKiBnaXZlbiBkYXRhLiBBIHByb21pc2UgaXMgcmV0dXJuZWQgYW5kIHdpbGwgYmUgY29tcGxldGVkIHdoZW4gdGhlXHJcbiAqIGJhY2tlbmQgcmVzcG9uZHMuIFRoaXMgd2lsbCBiZSByZXNvbHZlZCB3aGVuIHRoZSBjYWxsIHdhcyBzdWNjZXNzZnVsXHJcbiAqIG9yIHJlamVjdGVkIGlmIGFuIGVycm9yIGlzIHBhc3NlZCBiYWNrLlxyXG4gKiBUaGVyZSBpcyBhIHRpbWVvdXQgbWVjaGFuaXNtLiBJZiB0aGUgY2FsbCBkb2Vzbid0IHJlc3BvbmQgaW4gdGhlIGdpdmVuXHJcbiAqIHRpbWUgKGluIG1pbGxpc2Vjb25kcykgdGhlbiB0aGUgcHJvbWlzZSBpcyByZWplY3RlZC5cclxuICpcclxuICogQGV4cG9ydFxyXG4gKiBAcGFyYW0ge3N0cmluZ30gbmFtZVxyXG4gKiBAcGFyYW0ge2FueT19IGFyZ3NcclxuICogQHBhcmFtIHtudW1iZXI9fSB0aW1lb3V0XHJcbiAqIEByZXR1cm5zXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gQ2FsbChuYW1lLCBhcmdzLCB0aW1lb3V0KSB7XHJcblxyXG5cdC8vIFRpbWVvdXQgaW5maW5pdGUgYnkgZGVmYXVsdFxyXG5cdGlmICh0aW1lb3V0ID09IG51bGwpIHtcclxuXHRcdHRpbWVvdXQgPSAwO1xyXG5cdH1cclxuXHJcblx0Ly8gQ3JlYXRlIGEgcHJvbWlzZVxyXG5cdHJldHVybiBuZXcgUHJvbWlzZShmdW5jdGlvbiAocmVzb2x2ZSwgcmVqZWN0KSB7XHJcblxyXG5cdFx0Ly8gQ3JlYXRlIGEgdW5pcXVlIGNhbGxiYWNrSURcclxuXHRcdHZhciBjYWxsYmFja0lEO1xyXG5cdFx0ZG8ge1xyXG5cdFx0XHRjYWxsYmFja0lEID0gbmFtZSArICctJyArIHJhbmRvbUZ1bmMoKTtcclxuXHRcdH0gd2hpbGUgKGNhbGxiYWNrc1tjYWxsYmFja0lEXSk7XHJcblxyXG5cdFx0dmFyIHRpbWVvdXRIYW5kbGU7XHJcblx0XHQvLyBTZXQgdGltZW91dFxyXG5cdFx0aWYgKHRpbWVvdXQgPiAwKSB7XHJcblx0XHRcdHRpbWVvdXRIYW5kbGUgPSBzZXRUaW1lb3V0KGZ1bmN0aW9uICgpIHtcclxuXHRcdFx0XHRyZWplY3QoRXJyb3IoJ0NhbGwgdG8gJyArIG5hbWUgKyAnIHRpbWVkIG91dC4gUmVxdWVzdCBJRDogJyArIGNhbGxiYWNrSUQpKTtcclxuXHRcdFx0fSwgdGltZW91dCk7XHJcblx0XHR9XHJcblxyXG5cdFx0Ly8gU3RvcmUgY2FsbGJhY2tcclxuXHRcdGNhbGxiYWNrc1tjYWxsYmFja0lEXSA9IHtcclxuXHRcdFx0dGltZW91dEhhbmRsZTogdGltZW91dEhhbmRsZSxcclxuXHRcdFx0cmVqZWN0OiByZWplY3QsXHJcblx0XHRcdHJlc29sdmU6IHJlc29sdmVcclxuXHRcdH07XHJcblxyXG5cdFx0dHJ5IHtcclxuXHRcdFx0Y29uc3QgcGF5bG9hZCA9IHtcclxuXHRcdFx0XHRuYW1lLFxyXG5cdFx0XHRcdGFyZ3MsXHJcblx0XHRcdFx0Y2FsbGJhY2tJRCxcclxuXHRcdFx0fTtcclxuXHJcblx0XHRcdC8vIE1ha2UgdGhlIGNhbGxcclxuXHRcdFx0d2luZG93LldhaWxzSW52b2tlKCdDJyArIEpTT04uc3RyaW5naWZ5KHBheWxvYWQpKTtcclxuXHRcdH0gY2F0Y2ggKGUpIHtcclxuXHRcdFx0Ly8gZXNsaW50LWRpc2FibGUtbmV4dC1saW5lXHJcblx0XHRcdGNvbnNvbGUuZXJyb3IoZSk7XHJcblx0XHR9XHJcblx0fSk7XHJcbn1cclxuXHJcblxyXG5cclxuLyoqXHJcbiAqIENhbGxlZCBieSB0aGUgYmFja2VuZCB0byByZXR1cm4gZGF0YSB0byBhIHByZXZpb3VzbHkgY2FsbGVkXHJcbiAqIGJpbmRpbmcgaW52b2NhdGlvblxyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqIEBwYXJhbSB7c3RyaW5nfSBpbmNvbWluZ01lc3NhZ2VcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBDYWxsYmFjayhpbmNvbWluZ01lc3NhZ2UpIHtcclxuXHQvLyBQYXJzZSB0aGUgbWVzc2FnZVxyXG5cdGxldCBtZXNzYWdlO1xyXG5cdHRyeSB7XHJcblx0XHRtZXNzYWdlID0gSlNPTi5wYXJzZShpbmNvbWluZ01lc3NhZ2UpO1xyXG5cdH0gY2F0Y2ggKGUpIHtcclxuXHRcdGNvbnN0IGVycm9yID0gYEludmFsaWQgSlNPTiBwYXNzZWQgdG8gY2FsbGJhY2s6ICR7ZS5tZXNzYWdlfS4gTWVzc2FnZTogJHtpbmNvbWluZ01lc3NhZ2V9YDtcclxuXHRcdHJ1bnRpbWUuTG9nRGVidWcoZXJyb3IpO1xyXG5cdFx0dGhyb3cgbmV3IEVycm9yKGVycm9yKTtcclxuXHR9XHJcblx0bGV0IGNhbGxiYWNrSUQgPSBtZXNzYWdlLmNhbGxiYWNraWQ7XHJcblx0bGV0IGNhbGxiYWNrRGF0YSA9IGNhbGxiYWNrc1tjYWxsYmFja0lEXTtcclxuXHRpZiAoIWNhbGxiYWNrRGF0YSkge1xyXG5cdFx0Y29uc3QgZXJyb3IgPSBgQ2FsbGJhY2sgJyR7Y2FsbGJhY2tJRH0nIG5vdCByZWdpc3RlcmVkISEhYDtcclxuXHRcdGNvbnNvbGUuZXJyb3IoZXJyb3IpOyAvLyBlc2xpbnQtZGlzYWJsZS1saW5lXHJcblx0XHR0aHJvdyBuZXcgRXJyb3IoZXJyb3IpO1xyXG5cdH1cclxuXHRjbGVhclRpbWVvdXQoY2FsbGJhY2tEYXRhLnRpbWVvdXRIYW5kbGUpO1xyXG5cclxuXHRkZWxldGUgY2FsbGJhY2tzW2NhbGxiYWNrSURdO1xyXG5cclxuXHRpZiAobWVzc2FnZS5lcnJvcikge1xyXG5cdFx0Y2FsbGJhY2tEYXRhLnJlamVjdChtZXNzYWdlLmVycm9yKTtcclxuXHR9IGVsc2Uge1xyXG5cdFx0Y2FsbGJhY2tEYXRhLnJlc29sdmUobWVzc2FnZS5yZXN1bHQpO1xyXG5cdH1cclxufVxyXG4iLCAiLypcclxuIF8gICAgICAgX18gICAgICBfIF9fICAgIFxyXG58IHwgICAgIC8gL19fXyBfKF8pIC9fX19fXHJcbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cclxufCB8LyB8LyAvIC9fLyAvIC8gKF9fICApIFxyXG58X18vfF9fL1xcX18sXy9fL18vX19fXy8gIFxyXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXHJcbihjKSBMZWEgQW50aG9ueSAyMDE5LXByZXNlbnRcclxuKi9cclxuLyoganNoaW50IGVzdmVyc2lvbjogNiAqL1xyXG5cclxuaW1wb3J0IHtDYWxsfSBmcm9tICcuL2NhbGxzJztcclxuXHJcbi8vIFRoaXMgaXMgd2hlcmUgd2UgYmluZCBnbyBtZXRob2Qgd3JhcHBlcnNcclxud2luZG93LmdvID0ge307XHJcblxyXG5leHBvcnQgZnVuY3Rpb24gU2V0QmluZGluZ3MoYmluZGluZ3NNYXApIHtcclxuXHR0cnkge1xyXG5cdFx0YmluZGluZ3NNYXAgPSBKU09OLnBhcnNlKGJpbmRpbmdzTWFwKTtcclxuXHR9IGNhdGNoIChlKSB7XHJcblx0XHRjb25zb2xlLmVycm9yKGUpO1xyXG5cdH1cclxuXHJcblx0Ly8gSW5pdGlhbGlzZSB0aGUgYmluZGluZ3MgbWFwXHJcblx0d2luZG93LmdvID0gd2luZG93LmdvIHx8IHt9O1xyXG5cclxuXHQvLyBJdGVyYXRlIHBhY2thZ2UgbmFtZXNcclxuXHRPYmplY3Qua2V5cyhiaW5kaW5nc01hcCkuZm9yRWFjaCgocGFja2FnZU5hbWUpID0+IHtcclxuXHJcblx0XHQvLyBDcmVhdGUgaW5uZXIgbWFwIGlmIGl0IGRvZXNuJ3QgZXhpc3RcclxuXHRcdHdpbmRvdy5nb1twYWNrYWdlTmFtZV0gPSB3aW5kb3cuZ29bcGFja2FnZU5hbWVdIHx8IHt9O1xyXG5cclxuXHRcdC8vIEl0ZXJhdGUgc3RydWN0IG5hbWVzXHJcblx0XHRPYmplY3Qua2V5cyhiaW5kaW5nc01hcFtwYWNrYWdlTmFtZV0pLmZvckVhY2goKHN0cnVjdE5hbWUpID0+IHtcclxuXHJcblx0XHRcdC8vIENyZWF0ZSBpbm5lciBtYXAgaWYgaXQgZG9lc24ndCBleGlzdFxyXG5cdFx0XHR3aW5kb3cuZ29bcGFja2FnZU5hbWVdW3N0cnVjdE5hbWVdID0gd2luZG93LmdvW3BhY2thZ2VOYW1lXVtzdHJ1Y3ROYW1lXSB8fCB7fTtcclxuXHJcblx0XHRcdE9iamVjdC5rZXlzKGJpbmRpbmdzTWFwW3BhY2thZ2VOYW1lXVtzdHJ1Y3ROYW1lXSkuZm9yRWFjaCgobWV0aG9kTmFtZSkgPT4ge1xyXG5cclxuXHRcdFx0XHR3aW5kb3cuZ29bcGFja2FnZU5hbWVdW3N0cnVjdE5hbWVdW21ldGhvZE5hbWVdID0gZnVuY3Rpb24gKCkge1xyXG5cclxuXHRcdFx0XHRcdC8vIE5vIHRpbWVvdXQgYnkgZGVmYXVsdFxyXG5cdFx0XHRcdFx0bGV0IHRpbWVvdXQgPSAwO1xyXG5cclxuXHRcdFx0XHRcdC8vIEFjdHVhbCBmdW5jdGlvblxyXG5cdFx0XHRcdFx0ZnVuY3Rpb24gZHluYW1pYygpIHtcclxuXHRcdFx0XHRcdFx0Y29uc3QgYXJncyA9IFtdLnNsaWNlLmNhbGwoYXJndW1lbnRzKTtcclxuXHRcdFx0XHRcdFx0cmV0dXJuIENhbGwoW3BhY2thZ2VOYW1lLCBzdHJ1Y3ROYW1lLCBtZXRob2ROYW1lXS5qb2luKCcuJyksIGFyZ3MsIHRpbWVvdXQpO1xyXG5cdFx0XHRcdFx0fVxyXG5cclxuXHRcdFx0XHRcdC8vIEFsbG93IHNldHRpbmcgdGltZW91dCB0byBmdW5jdGlvblxyXG5cdFx0XHRcdFx0ZHluYW1pYy5zZXRUaW1lb3V0ID0gZnVuY3Rpb24gKG5ld1RpbWVvdXQpIHtcclxuXHRcdFx0XHRcdFx0dGltZW91dCA9IG5ld1RpbWVvdXQ7XHJcblx0XHRcdFx0XHR9O1xyXG5cclxuXHRcdFx0XHRcdC8vIEFsbG93IGdldHRpbmcgdGltZW91dCB0byBmdW5jdGlvblxyXG5cdFx0XHRcdFx0ZHluYW1pYy5nZXRUaW1lb3V0ID0gZnVuY3Rpb24gKCkge1xyXG5cdFx0XHRcdFx0XHRyZXR1cm4gdGltZW91dDtcclxuXHRcdFx0XHRcdH07XHJcblxyXG5cdFx0XHRcdFx0cmV0dXJuIGR5bmFtaWM7XHJcblx0XHRcdFx0fSgpO1xyXG5cdFx0XHR9KTtcclxuXHRcdH0pO1xyXG5cdH0pO1xyXG59XHJcbiIsICIvKlxyXG4gX1x0ICAgX19cdCAgXyBfX1xyXG58IHxcdCAvIC9fX18gXyhfKSAvX19fX1xyXG58IHwgL3wgLyAvIF9fIGAvIC8gLyBfX18vXHJcbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxyXG58X18vfF9fL1xcX18sXy9fL18vX19fXy9cclxuVGhlIGVsZWN0cm9uIGFsdGVybmF0aXZlIGZvciBHb1xyXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XHJcbiovXHJcblxyXG4vKiBqc2hpbnQgZXN2ZXJzaW9uOiA5ICovXHJcblxyXG5cclxuaW1wb3J0IHtDYWxsfSBmcm9tIFwiLi9jYWxsc1wiO1xyXG5cclxuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd1JlbG9hZCgpIHtcclxuICAgIHdpbmRvdy5sb2NhdGlvbi5yZWxvYWQoKTtcclxufVxyXG5cclxuLyoqXHJcbiAqIFBsYWNlIHRoZSB3aW5kb3cgaW4gdGhlIGNlbnRlciBvZiB0aGUgc2NyZWVuXHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dDZW50ZXIoKSB7XHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1djJyk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBTZXRzIHRoZSB3aW5kb3cgdGl0bGVcclxuICpcclxuICogQHBhcmFtIHtzdHJpbmd9IHRpdGxlXHJcbiAqIEBleHBvcnRcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dTZXRUaXRsZSh0aXRsZSkge1xyXG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXVCcgKyB0aXRsZSk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBNYWtlcyB0aGUgd2luZG93IGdvIGZ1bGxzY3JlZW5cclxuICpcclxuICogQGV4cG9ydFxyXG4gKi9cclxuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd0Z1bGxzY3JlZW4oKSB7XHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dGJyk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBSZXZlcnRzIHRoZSB3aW5kb3cgZnJvbSBmdWxsc2NyZWVuXHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dVbkZ1bGxzY3JlZW4oKSB7XHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dmJyk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBTZXQgdGhlIFNpemUgb2YgdGhlIHdpbmRvd1xyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqIEBwYXJhbSB7bnVtYmVyfSB3aWR0aFxyXG4gKiBAcGFyYW0ge251bWJlcn0gaGVpZ2h0XHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gV2luZG93U2V0U2l6ZSh3aWR0aCwgaGVpZ2h0KSB7XHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dzOicgKyB3aWR0aCArICc6JyArIGhlaWdodCk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBHZXQgdGhlIFNpemUgb2YgdGhlIHdpbmRvd1xyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqIEByZXR1cm4ge1Byb21pc2U8e3c6IG51bWJlciwgaDogbnVtYmVyfT59IFRoZSBzaXplIG9mIHRoZSB3aW5kb3dcclxuXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gV2luZG93R2V0U2l6ZSgpIHtcclxuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOldpbmRvd0dldFNpemVcIik7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBTZXQgdGhlIG1heGltdW0gc2l6ZSBvZiB0aGUgd2luZG93XHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtudW1iZXJ9IHdpZHRoXHJcbiAqIEBwYXJhbSB7bnVtYmVyfSBoZWlnaHRcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dTZXRNYXhTaXplKHdpZHRoLCBoZWlnaHQpIHtcclxuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV1o6JyArIHdpZHRoICsgJzonICsgaGVpZ2h0KTtcclxufVxyXG5cclxuLyoqXHJcbiAqIFNldCB0aGUgbWluaW11bSBzaXplIG9mIHRoZSB3aW5kb3dcclxuICpcclxuICogQGV4cG9ydFxyXG4gKiBAcGFyYW0ge251bWJlcn0gd2lkdGhcclxuICogQHBhcmFtIHtudW1iZXJ9IGhlaWdodFxyXG4gKi9cclxuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd1NldE1pblNpemUod2lkdGgsIGhlaWdodCkge1xyXG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXejonICsgd2lkdGggKyAnOicgKyBoZWlnaHQpO1xyXG59XHJcblxyXG4vKipcclxuICogU2V0IHRoZSBQb3NpdGlvbiBvZiB0aGUgd2luZG93XHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtudW1iZXJ9IHhcclxuICogQHBhcmFtIHtudW1iZXJ9IHlcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dTZXRQb3NpdGlvbih4LCB5KSB7XHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dwOicgKyB4ICsgJzonICsgeSk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBHZXQgdGhlIFBvc2l0aW9uIG9mIHRoZSB3aW5kb3dcclxuICpcclxuICogQGV4cG9ydFxyXG4gKiBAcmV0dXJuIHtQcm9taXNlPHt4OiBudW1iZXIsIHk6IG51bWJlcn0+fSBUaGUgcG9zaXRpb24gb2YgdGhlIHdpbmRvd1xyXG4gKi9cclxuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd0dldFBvc2l0aW9uKCkge1xyXG4gICAgcmV0dXJuIENhbGwoXCI6d2FpbHM6V2luZG93R2V0UG9zXCIpO1xyXG59XHJcblxyXG4vKipcclxuICogSGlkZSB0aGUgV2luZG93XHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dIaWRlKCkge1xyXG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXSCcpO1xyXG59XHJcblxyXG4vKipcclxuICogU2hvdyB0aGUgV2luZG93XHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dTaG93KCkge1xyXG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXUycpO1xyXG59XHJcblxyXG4vKipcclxuICogTWF4aW1pc2UgdGhlIFdpbmRvd1xyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gV2luZG93TWF4aW1pc2UoKSB7XHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dNJyk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBVbm1heGltaXNlIHRoZSBXaW5kb3dcclxuICpcclxuICogQGV4cG9ydFxyXG4gKi9cclxuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd1VubWF4aW1pc2UoKSB7XHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dVJyk7XHJcbn1cclxuXHJcbi8qKlxyXG4gKiBNaW5pbWlzZSB0aGUgV2luZG93XHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICovXHJcbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dNaW5pbWlzZSgpIHtcclxuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV20nKTtcclxufVxyXG5cclxuLyoqXHJcbiAqIFVubWluaW1pc2UgdGhlIFdpbmRvd1xyXG4gKlxyXG4gKiBAZXhwb3J0XHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gV2luZG93VW5taW5pbWlzZSgpIHtcclxuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV3UnKTtcclxufVxyXG5cclxuXHJcbi8qKlxyXG4gKiBTZXRzIHRoZSBiYWNrZ3JvdW5kIGNvbG91ciBvZiB0aGUgd2luZG93XHJcbiAqXHJcbiAqIEBleHBvcnRcclxuICogQHBhcmFtIHtSR0JBfSBSR0JBIGJhY2tncm91bmQgY29sb3VyXHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gV2luZG93U2V0UkdCQShSR0JBKSB7XHJcbiAgICBsZXQgcmdiYSA9IEpTT04uc3RyaW5naWZ5KFJHQkEpO1xyXG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXcjonICsgcmdiYSk7XHJcbn1cclxuXHJcbiIsICIvKipcclxuICogQGRlc2NyaXB0aW9uOiBVc2UgdGhlIHN5c3RlbSBkZWZhdWx0IGJyb3dzZXIgdG8gb3BlbiB0aGUgdXJsXHJcbiAqIEBwYXJhbSB7c3RyaW5nfSB1cmwgXHJcbiAqIEByZXR1cm4ge3ZvaWR9XHJcbiAqL1xyXG5leHBvcnQgZnVuY3Rpb24gQnJvd3Nlck9wZW5VUkwodXJsKSB7XHJcbiAgd2luZG93LldhaWxzSW52b2tlKCdCTzonICsgdXJsKTtcclxufSIsICIvKlxyXG4gX1x0ICAgX19cdCAgXyBfX1xyXG58IHxcdCAvIC9fX18gXyhfKSAvX19fX1xyXG58IHwgL3wgLyAvIF9fIGAvIC8gLyBfX18vXHJcbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxyXG58X18vfF9fL1xcX18sXy9fL18vX19fXy9cclxuVGhlIGVsZWN0cm9uIGFsdGVybmF0aXZlIGZvciBHb1xyXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XHJcbiovXHJcbi8qIGpzaGludCBlc3ZlcnNpb246IDkgKi9cclxuaW1wb3J0ICogYXMgTG9nIGZyb20gJy4vbG9nJztcclxuaW1wb3J0IHtldmVudExpc3RlbmVycywgRXZlbnRzRW1pdCwgRXZlbnRzTm90aWZ5LCBFdmVudHNPZmYsIEV2ZW50c09uLCBFdmVudHNPbmNlLCBFdmVudHNPbk11bHRpcGxlfSBmcm9tICcuL2V2ZW50cyc7XHJcbmltcG9ydCB7Q2FsbGJhY2ssIGNhbGxiYWNrc30gZnJvbSAnLi9jYWxscyc7XHJcbmltcG9ydCB7U2V0QmluZGluZ3N9IGZyb20gXCIuL2JpbmRpbmdzXCI7XHJcbmltcG9ydCAqIGFzIFdpbmRvdyBmcm9tIFwiLi93aW5kb3dcIjtcclxuaW1wb3J0ICogYXMgQnJvd3NlciBmcm9tIFwiLi9icm93c2VyXCI7XHJcblxyXG5cclxuZXhwb3J0IGZ1bmN0aW9uIFF1aXQoKSB7XHJcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1EnKTtcclxufVxyXG5cclxuLy8gVGhlIEpTIHJ1bnRpbWVcclxud2luZG93LnJ1bnRpbWUgPSB7XHJcbiAgICAuLi5Mb2csXHJcbiAgICAuLi5XaW5kb3csXHJcbiAgICAuLi5Ccm93c2VyLFxyXG4gICAgRXZlbnRzT24sXHJcbiAgICBFdmVudHNPbmNlLFxyXG4gICAgRXZlbnRzT25NdWx0aXBsZSxcclxuICAgIEV2ZW50c0VtaXQsXHJcbiAgICBFdmVudHNPZmYsXHJcbiAgICBRdWl0XHJcbn07XHJcblxyXG4vLyBJbnRlcm5hbCB3YWlscyBlbmRwb2ludHNcclxud2luZG93LndhaWxzID0ge1xyXG4gICAgQ2FsbGJhY2ssXHJcbiAgICBFdmVudHNOb3RpZnksXHJcbiAgICBTZXRCaW5kaW5ncyxcclxuICAgIGV2ZW50TGlzdGVuZXJzLFxyXG4gICAgY2FsbGJhY2tzLFxyXG4gICAgZmxhZ3M6IHtcclxuICAgICAgICBkaXNhYmxlU2Nyb2xsYmFyRHJhZzogZmFsc2UsXHJcbiAgICAgICAgZGlzYWJsZVdhaWxzRGVmYXVsdENvbnRleHRNZW51OiBmYWxzZSxcclxuICAgICAgICBlbmFibGVSZXNpemU6IGZhbHNlLFxyXG4gICAgICAgIGRlZmF1bHRDdXJzb3I6IG51bGwsXHJcbiAgICAgICAgYm9yZGVyVGhpY2tuZXNzOiA2XHJcbiAgICB9XHJcbn07XHJcblxyXG4vLyBTZXQgdGhlIGJpbmRpbmdzXHJcbndpbmRvdy53YWlscy5TZXRCaW5kaW5ncyh3aW5kb3cud2FpbHNiaW5kaW5ncyk7XHJcbmRlbGV0ZSB3aW5kb3cud2FpbHMuU2V0QmluZGluZ3M7XHJcblxyXG4vLyBUaGlzIGlzIGV2YWx1YXRlZCBhdCBidWlsZCB0aW1lIGluIHBhY2thZ2UuanNvblxyXG4vLyBjb25zdCBkZXYgPSAwO1xyXG4vLyBjb25zdCBwcm9kdWN0aW9uID0gMTtcclxuaWYgKEVOViA9PT0gMCkge1xyXG4gICAgZGVsZXRlIHdpbmRvdy53YWlsc2JpbmRpbmdzO1xyXG59XHJcblxyXG4vLyBTZXR1cCBkcmFnIGhhbmRsZXJcclxuLy8gQmFzZWQgb24gY29kZSBmcm9tOiBodHRwczovL2dpdGh1Yi5jb20vcGF0cjBudXMvRGVza0dhcFxyXG53aW5kb3cuYWRkRXZlbnRMaXN0ZW5lcignbW91c2Vkb3duJywgKGUpID0+IHtcclxuXHJcbiAgICAvLyBDaGVjayBmb3IgcmVzaXppbmdcclxuICAgIGlmICh3aW5kb3cud2FpbHMuZmxhZ3MucmVzaXplRWRnZSkge1xyXG4gICAgICAgIHdpbmRvdy5XYWlsc0ludm9rZShcInJlc2l6ZTpcIiArIHdpbmRvdy53YWlscy5mbGFncy5yZXNpemVFZGdlKTtcclxuICAgICAgICBlLnByZXZlbnREZWZhdWx0KCk7XHJcbiAgICAgICAgcmV0dXJuO1xyXG4gICAgfVxyXG5cclxuICAgIC8vIENoZWNrIGZvciBkcmFnZ2luZ1xyXG4gICAgbGV0IGN1cnJlbnRFbGVtZW50ID0gZS50YXJnZXQ7XHJcbiAgICB3aGlsZSAoY3VycmVudEVsZW1lbnQgIT0gbnVsbCkge1xyXG4gICAgICAgIGlmIChjdXJyZW50RWxlbWVudC5oYXNBdHRyaWJ1dGUoJ2RhdGEtd2FpbHMtbm8tZHJhZycpKSB7XHJcbiAgICAgICAgICAgIGJyZWFrO1xyXG4gICAgICAgIH0gZWxzZSBpZiAoY3VycmVudEVsZW1lbnQuaGFzQXR0cmlidXRlKCdkYXRhLXdhaWxzLWRyYWcnKSkge1xyXG4gICAgICAgICAgICBpZiAod2luZG93LndhaWxzLmZsYWdzLmRpc2FibGVTY3JvbGxiYXJEcmFnKSB7XHJcbiAgICAgICAgICAgICAgICAvLyBUaGlzIGNoZWNrcyBmb3IgY2xpY2tzIG9uIHRoZSBzY3JvbGwgYmFyXHJcbiAgICAgICAgICAgICAgICBpZiAoZS5vZmZzZXRYID4gZS50YXJnZXQuY2xpZW50V2lkdGggfHwgZS5vZmZzZXRZID4gZS50YXJnZXQuY2xpZW50SGVpZ2h0KSB7XHJcbiAgICAgICAgICAgICAgICAgICAgYnJlYWs7XHJcbiAgICAgICAgICAgICAgICB9XHJcbiAgICAgICAgICAgIH1cclxuICAgICAgICAgICAgd2luZG93LldhaWxzSW52b2tlKFwiZHJhZ1wiKTtcclxuICAgICAgICAgICAgZS5wcmV2ZW50RGVmYXVsdCgpO1xyXG4gICAgICAgICAgICBicmVhaztcclxuICAgICAgICB9XHJcbiAgICAgICAgY3VycmVudEVsZW1lbnQgPSBjdXJyZW50RWxlbWVudC5wYXJlbnRFbGVtZW50O1xyXG4gICAgfVxyXG59KTtcclxuXHJcbmZ1bmN0aW9uIHNldFJlc2l6ZShjdXJzb3IpIHtcclxuICAgIGRvY3VtZW50LmJvZHkuc3R5bGUuY3Vyc29yID0gY3Vyc29yIHx8IHdpbmRvdy53YWlscy5mbGFncy5kZWZhdWx0Q3Vyc29yO1xyXG4gICAgd2luZG93LndhaWxzLmZsYWdzLnJlc2l6ZUVkZ2UgPSBjdXJzb3I7XHJcbn1cclxuXHJcbndpbmRvdy5hZGRFdmVudExpc3RlbmVyKCdtb3VzZW1vdmUnLCBmdW5jdGlvbiAoZSkge1xyXG4gICAgaWYgKCF3aW5kb3cud2FpbHMuZmxhZ3MuZW5hYmxlUmVzaXplKSB7XHJcbiAgICAgICAgcmV0dXJuO1xyXG4gICAgfVxyXG4gICAgaWYgKHdpbmRvdy53YWlscy5mbGFncy5kZWZhdWx0Q3Vyc29yID09IG51bGwpIHtcclxuICAgICAgICB3aW5kb3cud2FpbHMuZmxhZ3MuZGVmYXVsdEN1cnNvciA9IGRvY3VtZW50LmJvZHkuc3R5bGUuY3Vyc29yO1xyXG4gICAgfVxyXG4gICAgaWYgKHdpbmRvdy5vdXRlcldpZHRoIC0gZS5jbGllbnRYIDwgd2luZG93LndhaWxzLmZsYWdzLmJvcmRlclRoaWNrbmVzcyAmJiB3aW5kb3cub3V0ZXJIZWlnaHQgLSBlLmNsaWVudFkgPCB3aW5kb3cud2FpbHMuZmxhZ3MuYm9yZGVyVGhpY2tuZXNzKSB7XHJcbiAgICAgICAgZG9jdW1lbnQuYm9keS5zdHlsZS5jdXJzb3IgPSBcInNlLXJlc2l6ZVwiO1xyXG4gICAgfVxyXG4gICAgbGV0IHJpZ2h0Qm9yZGVyID0gd2luZG93Lm91dGVyV2lkdGggLSBlLmNsaWVudFggPCB3aW5kb3cud2FpbHMuZmxhZ3MuYm9yZGVyVGhpY2tuZXNzO1xyXG4gICAgbGV0IGxlZnRCb3JkZXIgPSBlLmNsaWVudFggPCB3aW5kb3cud2FpbHMuZmxhZ3MuYm9yZGVyVGhpY2tuZXNzO1xyXG4gICAgbGV0IHRvcEJvcmRlciA9IGUuY2xpZW50WSA8IHdpbmRvdy53YWlscy5mbGFncy5ib3JkZXJUaGlja25lc3M7XHJcbiAgICBsZXQgYm90dG9tQm9yZGVyID0gd2luZG93Lm91dGVySGVpZ2h0IC0gZS5jbGllbnRZIDwgd2luZG93LndhaWxzLmZsYWdzLmJvcmRlclRoaWNrbmVzcztcclxuXHJcbiAgICAvLyBJZiB3ZSBhcmVuJ3Qgb24gYW4gZWRnZSwgYnV0IHdlcmUsIHJlc2V0IHRoZSBjdXJzb3IgdG8gZGVmYXVsdFxyXG4gICAgaWYgKCFsZWZ0Qm9yZGVyICYmICFyaWdodEJvcmRlciAmJiAhdG9wQm9yZGVyICYmICFib3R0b21Cb3JkZXIgJiYgd2luZG93LndhaWxzLmZsYWdzLnJlc2l6ZUVkZ2UgIT09IHVuZGVmaW5lZCkge1xyXG4gICAgICAgIHNldFJlc2l6ZSgpO1xyXG4gICAgfSBlbHNlIGlmIChyaWdodEJvcmRlciAmJiBib3R0b21Cb3JkZXIpIHNldFJlc2l6ZShcInNlLXJlc2l6ZVwiKTtcclxuICAgIGVsc2UgaWYgKGxlZnRCb3JkZXIgJiYgYm90dG9tQm9yZGVyKSBzZXRSZXNpemUoXCJzdy1yZXNpemVcIik7XHJcbiAgICBlbHNlIGlmIChsZWZ0Qm9yZGVyICYmIHRvcEJvcmRlcikgc2V0UmVzaXplKFwibnctcmVzaXplXCIpO1xyXG4gICAgZWxzZSBpZiAodG9wQm9yZGVyICYmIHJpZ2h0Qm9yZGVyKSBzZXRSZXNpemUoXCJuZS1yZXNpemVcIik7XHJcbiAgICBlbHNlIGlmIChsZWZ0Qm9yZGVyKSBzZXRSZXNpemUoXCJ3LXJlc2l6ZVwiKTtcclxuICAgIGVsc2UgaWYgKHRvcEJvcmRlcikgc2V0UmVzaXplKFwibi1yZXNpemVcIik7XHJcbiAgICBlbHNlIGlmIChib3R0b21Cb3JkZXIpIHNldFJlc2l6ZShcInMtcmVzaXplXCIpO1xyXG4gICAgZWxzZSBpZiAocmlnaHRCb3JkZXIpIHNldFJlc2l6ZShcImUtcmVzaXplXCIpO1xyXG5cclxufSk7XHJcblxyXG4vLyBTZXR1cCBjb250ZXh0IG1lbnUgaG9va1xyXG53aW5kb3cuYWRkRXZlbnRMaXN0ZW5lcignY29udGV4dG1lbnUnLCBmdW5jdGlvbiAoZSkge1xyXG4gICAgaWYgKHdpbmRvdy53YWlscy5mbGFncy5kaXNhYmxlV2FpbHNEZWZhdWx0Q29udGV4dE1lbnUpIHtcclxuICAgICAgICBlLnByZXZlbnREZWZhdWx0KCk7XHJcbiAgICB9XHJcbn0pOyJdLAogICJtYXBwaW5ncyI6ICI7Ozs7Ozs7Ozs7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFrQkEsMEJBQXdCLE9BQU8sU0FBUztBQUl2QyxXQUFPLFlBQVksTUFBTSxRQUFRO0FBQUE7QUFTM0Isb0JBQWtCLFNBQVM7QUFDakMsbUJBQWUsS0FBSztBQUFBO0FBU2Qsb0JBQWtCLFNBQVM7QUFDakMsbUJBQWUsS0FBSztBQUFBO0FBU2Qsb0JBQWtCLFNBQVM7QUFDakMsbUJBQWUsS0FBSztBQUFBO0FBU2QsbUJBQWlCLFNBQVM7QUFDaEMsbUJBQWUsS0FBSztBQUFBO0FBU2Qsc0JBQW9CLFNBQVM7QUFDbkMsbUJBQWUsS0FBSztBQUFBO0FBU2Qsb0JBQWtCLFNBQVM7QUFDakMsbUJBQWUsS0FBSztBQUFBO0FBU2Qsb0JBQWtCLFNBQVM7QUFDakMsbUJBQWUsS0FBSztBQUFBO0FBU2QsdUJBQXFCLFVBQVU7QUFDckMsbUJBQWUsS0FBSztBQUFBO0FBSWQsTUFBTSxXQUFXO0FBQUEsSUFDdkIsT0FBTztBQUFBLElBQ1AsT0FBTztBQUFBLElBQ1AsTUFBTTtBQUFBLElBQ04sU0FBUztBQUFBLElBQ1QsT0FBTztBQUFBOzs7QUM3RlIsdUJBQWU7QUFBQSxJQU9YLFlBQVksVUFBVSxjQUFjO0FBRWhDLHFCQUFlLGdCQUFnQjtBQUcvQixXQUFLLFdBQVcsQ0FBQyxTQUFTO0FBQ3RCLGlCQUFTLE1BQU0sTUFBTTtBQUVyQixZQUFJLGlCQUFpQixJQUFJO0FBQ3JCLGlCQUFPO0FBQUE7QUFHWCx3QkFBZ0I7QUFDaEIsZUFBTyxpQkFBaUI7QUFBQTtBQUFBO0FBQUE7QUFLN0IsTUFBTSxpQkFBaUI7QUFVdkIsNEJBQTBCLFdBQVcsVUFBVSxjQUFjO0FBQ2hFLG1CQUFlLGFBQWEsZUFBZSxjQUFjO0FBQ3pELFVBQU0sZUFBZSxJQUFJLFNBQVMsVUFBVTtBQUM1QyxtQkFBZSxXQUFXLEtBQUs7QUFBQTtBQVU1QixvQkFBa0IsV0FBVyxVQUFVO0FBQzFDLHFCQUFpQixXQUFXLFVBQVU7QUFBQTtBQVVuQyxzQkFBb0IsV0FBVyxVQUFVO0FBQzVDLHFCQUFpQixXQUFXLFVBQVU7QUFBQTtBQUcxQywyQkFBeUIsV0FBVztBQUdoQyxRQUFJLFlBQVksVUFBVTtBQUcxQixRQUFJLGVBQWUsWUFBWTtBQUczQixZQUFNLHVCQUF1QixlQUFlLFdBQVc7QUFHdkQsZUFBUyxRQUFRLEdBQUcsUUFBUSxlQUFlLFdBQVcsUUFBUSxTQUFTLEdBQUc7QUFHdEUsY0FBTSxXQUFXLGVBQWUsV0FBVztBQUUzQyxZQUFJLE9BQU8sVUFBVTtBQUdyQixjQUFNLFVBQVUsU0FBUyxTQUFTO0FBQ2xDLFlBQUksU0FBUztBQUVULCtCQUFxQixPQUFPLE9BQU87QUFBQTtBQUFBO0FBSzNDLHFCQUFlLGFBQWE7QUFBQTtBQUFBO0FBVzdCLHdCQUFzQixlQUFlO0FBRXhDLFFBQUk7QUFDSixRQUFJO0FBQ0EsZ0JBQVUsS0FBSyxNQUFNO0FBQUEsYUFDaEIsR0FBUDtBQUNFLFlBQU0sUUFBUSxvQ0FBb0M7QUFDbEQsWUFBTSxJQUFJLE1BQU07QUFBQTtBQUVwQixvQkFBZ0I7QUFBQTtBQVNiLHNCQUFvQixXQUFXO0FBRWxDLFVBQU0sVUFBVTtBQUFBLE1BQ1osTUFBTTtBQUFBLE1BQ04sTUFBTSxHQUFHLE1BQU0sTUFBTSxXQUFXLE1BQU07QUFBQTtBQUkxQyxvQkFBZ0I7QUFHaEIsV0FBTyxZQUFZLE9BQU8sS0FBSyxVQUFVO0FBQUE7QUFHdEMscUJBQW1CLFdBQVc7QUFFakMsV0FBTyxlQUFlO0FBR3RCLFdBQU8sWUFBWSxPQUFPO0FBQUE7OztBQ2xKdkIsTUFBTSxZQUFZO0FBT3pCLDBCQUF3QjtBQUN2QixRQUFJLFFBQVEsSUFBSSxZQUFZO0FBQzVCLFdBQU8sT0FBTyxPQUFPLGdCQUFnQixPQUFPO0FBQUE7QUFTN0MseUJBQXVCO0FBQ3RCLFdBQU8sS0FBSyxXQUFXO0FBQUE7QUFJeEIsTUFBSTtBQUNKLE1BQUksT0FBTyxRQUFRO0FBQ2xCLGlCQUFhO0FBQUEsU0FDUDtBQUNOLGlCQUFhO0FBQUE7QUFrQlAsZ0JBQWMsTUFBTSxNQUFNLFNBQVM7QUFHekMsUUFBSSxXQUFXLE1BQU07QUFDcEIsZ0JBQVU7QUFBQTtBQUlYLFdBQU8sSUFBSSxRQUFRLFNBQVUsU0FBUyxRQUFRO0FBRzdDLFVBQUk7QUFDSixTQUFHO0FBQ0YscUJBQWEsT0FBTyxNQUFNO0FBQUEsZUFDbEIsVUFBVTtBQUVuQixVQUFJO0FBRUosVUFBSSxVQUFVLEdBQUc7QUFDaEIsd0JBQWdCLFdBQVcsV0FBWTtBQUN0QyxpQkFBTyxNQUFNLGFBQWEsT0FBTyw2QkFBNkI7QUFBQSxXQUM1RDtBQUFBO0FBSUosZ0JBQVUsY0FBYztBQUFBLFFBQ3ZCO0FBQUEsUUFDQTtBQUFBLFFBQ0E7QUFBQTtBQUdELFVBQUk7QUFDSCxjQUFNLFVBQVU7QUFBQSxVQUNmO0FBQUEsVUFDQTtBQUFBLFVBQ0E7QUFBQTtBQUlELGVBQU8sWUFBWSxNQUFNLEtBQUssVUFBVTtBQUFBLGVBQ2hDLEdBQVA7QUFFRCxnQkFBUSxNQUFNO0FBQUE7QUFBQTtBQUFBO0FBY1Ysb0JBQWtCLGlCQUFpQjtBQUV6QyxRQUFJO0FBQ0osUUFBSTtBQUNILGdCQUFVLEtBQUssTUFBTTtBQUFBLGFBQ2IsR0FBUDtBQUNELFlBQU0sUUFBUSxvQ0FBb0MsRUFBRSxxQkFBcUI7QUFDekUsY0FBUSxTQUFTO0FBQ2pCLFlBQU0sSUFBSSxNQUFNO0FBQUE7QUFFakIsUUFBSSxhQUFhLFFBQVE7QUFDekIsUUFBSSxlQUFlLFVBQVU7QUFDN0IsUUFBSSxDQUFDLGNBQWM7QUFDbEIsWUFBTSxRQUFRLGFBQWE7QUFDM0IsY0FBUSxNQUFNO0FBQ2QsWUFBTSxJQUFJLE1BQU07QUFBQTtBQUVqQixpQkFBYSxhQUFhO0FBRTFCLFdBQU8sVUFBVTtBQUVqQixRQUFJLFFBQVEsT0FBTztBQUNsQixtQkFBYSxPQUFPLFFBQVE7QUFBQSxXQUN0QjtBQUNOLG1CQUFhLFFBQVEsUUFBUTtBQUFBO0FBQUE7OztBQzFIL0IsU0FBTyxLQUFLO0FBRUwsdUJBQXFCLGFBQWE7QUFDeEMsUUFBSTtBQUNILG9CQUFjLEtBQUssTUFBTTtBQUFBLGFBQ2pCLEdBQVA7QUFDRCxjQUFRLE1BQU07QUFBQTtBQUlmLFdBQU8sS0FBSyxPQUFPLE1BQU07QUFHekIsV0FBTyxLQUFLLGFBQWEsUUFBUSxDQUFDLGdCQUFnQjtBQUdqRCxhQUFPLEdBQUcsZUFBZSxPQUFPLEdBQUcsZ0JBQWdCO0FBR25ELGFBQU8sS0FBSyxZQUFZLGNBQWMsUUFBUSxDQUFDLGVBQWU7QUFHN0QsZUFBTyxHQUFHLGFBQWEsY0FBYyxPQUFPLEdBQUcsYUFBYSxlQUFlO0FBRTNFLGVBQU8sS0FBSyxZQUFZLGFBQWEsYUFBYSxRQUFRLENBQUMsZUFBZTtBQUV6RSxpQkFBTyxHQUFHLGFBQWEsWUFBWSxjQUFjLFdBQVk7QUFHNUQsZ0JBQUksVUFBVTtBQUdkLCtCQUFtQjtBQUNsQixvQkFBTSxPQUFPLEdBQUcsTUFBTSxLQUFLO0FBQzNCLHFCQUFPLEtBQUssQ0FBQyxhQUFhLFlBQVksWUFBWSxLQUFLLE1BQU0sTUFBTTtBQUFBO0FBSXBFLG9CQUFRLGFBQWEsU0FBVSxZQUFZO0FBQzFDLHdCQUFVO0FBQUE7QUFJWCxvQkFBUSxhQUFhLFdBQVk7QUFDaEMscUJBQU87QUFBQTtBQUdSLG1CQUFPO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTs7O0FDN0RaO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQUFBO0FBQUE7QUFBQTtBQWVPLDBCQUF3QjtBQUMzQixXQUFPLFNBQVM7QUFBQTtBQVFiLDBCQUF3QjtBQUMzQixXQUFPLFlBQVk7QUFBQTtBQVNoQiwwQkFBd0IsT0FBTztBQUNsQyxXQUFPLFlBQVksT0FBTztBQUFBO0FBUXZCLDhCQUE0QjtBQUMvQixXQUFPLFlBQVk7QUFBQTtBQVFoQixnQ0FBOEI7QUFDakMsV0FBTyxZQUFZO0FBQUE7QUFVaEIseUJBQXVCLE9BQU8sUUFBUTtBQUN6QyxXQUFPLFlBQVksUUFBUSxRQUFRLE1BQU07QUFBQTtBQVV0QywyQkFBeUI7QUFDNUIsV0FBTyxLQUFLO0FBQUE7QUFVVCw0QkFBMEIsT0FBTyxRQUFRO0FBQzVDLFdBQU8sWUFBWSxRQUFRLFFBQVEsTUFBTTtBQUFBO0FBVXRDLDRCQUEwQixPQUFPLFFBQVE7QUFDNUMsV0FBTyxZQUFZLFFBQVEsUUFBUSxNQUFNO0FBQUE7QUFVdEMsNkJBQTJCLEdBQUcsR0FBRztBQUNwQyxXQUFPLFlBQVksUUFBUSxJQUFJLE1BQU07QUFBQTtBQVNsQywrQkFBNkI7QUFDaEMsV0FBTyxLQUFLO0FBQUE7QUFRVCx3QkFBc0I7QUFDekIsV0FBTyxZQUFZO0FBQUE7QUFRaEIsd0JBQXNCO0FBQ3pCLFdBQU8sWUFBWTtBQUFBO0FBUWhCLDRCQUEwQjtBQUM3QixXQUFPLFlBQVk7QUFBQTtBQVFoQiw4QkFBNEI7QUFDL0IsV0FBTyxZQUFZO0FBQUE7QUFRaEIsNEJBQTBCO0FBQzdCLFdBQU8sWUFBWTtBQUFBO0FBUWhCLDhCQUE0QjtBQUMvQixXQUFPLFlBQVk7QUFBQTtBQVVoQix5QkFBdUIsTUFBTTtBQUNoQyxRQUFJLE9BQU8sS0FBSyxVQUFVO0FBQzFCLFdBQU8sWUFBWSxRQUFRO0FBQUE7OztBQ3hML0I7QUFBQTtBQUFBO0FBQUE7QUFLTywwQkFBd0IsS0FBSztBQUNsQyxXQUFPLFlBQVksUUFBUTtBQUFBOzs7QUNZdEIsa0JBQWdCO0FBQ25CLFdBQU8sWUFBWTtBQUFBO0FBSXZCLFNBQU8sVUFBVTtBQUFBLE9BQ1Y7QUFBQSxPQUNBO0FBQUEsT0FDQTtBQUFBLElBQ0g7QUFBQSxJQUNBO0FBQUEsSUFDQTtBQUFBLElBQ0E7QUFBQSxJQUNBO0FBQUEsSUFDQTtBQUFBO0FBSUosU0FBTyxRQUFRO0FBQUEsSUFDWDtBQUFBLElBQ0E7QUFBQSxJQUNBO0FBQUEsSUFDQTtBQUFBLElBQ0E7QUFBQSxJQUNBLE9BQU87QUFBQSxNQUNILHNCQUFzQjtBQUFBLE1BQ3RCLGdDQUFnQztBQUFBLE1BQ2hDLGNBQWM7QUFBQSxNQUNkLGVBQWU7QUFBQSxNQUNmLGlCQUFpQjtBQUFBO0FBQUE7QUFLekIsU0FBTyxNQUFNLFlBQVksT0FBTztBQUNoQyxTQUFPLE9BQU8sTUFBTTtBQUtwQixNQUFJLE1BQVc7QUFDWCxXQUFPLE9BQU87QUFBQTtBQUtsQixTQUFPLGlCQUFpQixhQUFhLENBQUMsTUFBTTtBQUd4QyxRQUFJLE9BQU8sTUFBTSxNQUFNLFlBQVk7QUFDL0IsYUFBTyxZQUFZLFlBQVksT0FBTyxNQUFNLE1BQU07QUFDbEQsUUFBRTtBQUNGO0FBQUE7QUFJSixRQUFJLGlCQUFpQixFQUFFO0FBQ3ZCLFdBQU8sa0JBQWtCLE1BQU07QUFDM0IsVUFBSSxlQUFlLGFBQWEsdUJBQXVCO0FBQ25EO0FBQUEsaUJBQ08sZUFBZSxhQUFhLG9CQUFvQjtBQUN2RCxZQUFJLE9BQU8sTUFBTSxNQUFNLHNCQUFzQjtBQUV6QyxjQUFJLEVBQUUsVUFBVSxFQUFFLE9BQU8sZUFBZSxFQUFFLFVBQVUsRUFBRSxPQUFPLGNBQWM7QUFDdkU7QUFBQTtBQUFBO0FBR1IsZUFBTyxZQUFZO0FBQ25CLFVBQUU7QUFDRjtBQUFBO0FBRUosdUJBQWlCLGVBQWU7QUFBQTtBQUFBO0FBSXhDLHFCQUFtQixRQUFRO0FBQ3ZCLGFBQVMsS0FBSyxNQUFNLFNBQVMsVUFBVSxPQUFPLE1BQU0sTUFBTTtBQUMxRCxXQUFPLE1BQU0sTUFBTSxhQUFhO0FBQUE7QUFHcEMsU0FBTyxpQkFBaUIsYUFBYSxTQUFVLEdBQUc7QUFDOUMsUUFBSSxDQUFDLE9BQU8sTUFBTSxNQUFNLGNBQWM7QUFDbEM7QUFBQTtBQUVKLFFBQUksT0FBTyxNQUFNLE1BQU0saUJBQWlCLE1BQU07QUFDMUMsYUFBTyxNQUFNLE1BQU0sZ0JBQWdCLFNBQVMsS0FBSyxNQUFNO0FBQUE7QUFFM0QsUUFBSSxPQUFPLGFBQWEsRUFBRSxVQUFVLE9BQU8sTUFBTSxNQUFNLG1CQUFtQixPQUFPLGNBQWMsRUFBRSxVQUFVLE9BQU8sTUFBTSxNQUFNLGlCQUFpQjtBQUMzSSxlQUFTLEtBQUssTUFBTSxTQUFTO0FBQUE7QUFFakMsUUFBSSxjQUFjLE9BQU8sYUFBYSxFQUFFLFVBQVUsT0FBTyxNQUFNLE1BQU07QUFDckUsUUFBSSxhQUFhLEVBQUUsVUFBVSxPQUFPLE1BQU0sTUFBTTtBQUNoRCxRQUFJLFlBQVksRUFBRSxVQUFVLE9BQU8sTUFBTSxNQUFNO0FBQy9DLFFBQUksZUFBZSxPQUFPLGNBQWMsRUFBRSxVQUFVLE9BQU8sTUFBTSxNQUFNO0FBR3ZFLFFBQUksQ0FBQyxjQUFjLENBQUMsZUFBZSxDQUFDLGFBQWEsQ0FBQyxnQkFBZ0IsT0FBTyxNQUFNLE1BQU0sZUFBZSxRQUFXO0FBQzNHO0FBQUEsZUFDTyxlQUFlO0FBQWMsZ0JBQVU7QUFBQSxhQUN6QyxjQUFjO0FBQWMsZ0JBQVU7QUFBQSxhQUN0QyxjQUFjO0FBQVcsZ0JBQVU7QUFBQSxhQUNuQyxhQUFhO0FBQWEsZ0JBQVU7QUFBQSxhQUNwQztBQUFZLGdCQUFVO0FBQUEsYUFDdEI7QUFBVyxnQkFBVTtBQUFBLGFBQ3JCO0FBQWMsZ0JBQVU7QUFBQSxhQUN4QjtBQUFhLGdCQUFVO0FBQUE7QUFLcEMsU0FBTyxpQkFBaUIsZUFBZSxTQUFVLEdBQUc7QUFDaEQsUUFBSSxPQUFPLE1BQU0sTUFBTSxnQ0FBZ0M7QUFDbkQsUUFBRTtBQUFBO0FBQUE7IiwKICAibmFtZXMiOiBbXQp9Cg==
+//# sourceMappingURL=data:application/json;base64,ewogICJ2ZXJzaW9uIjogMywKICAic291cmNlcyI6IFsKICAgICJkZXNrdG9wL2xvZy5qcyIsCiAgICAiZGVza3RvcC9ldmVudHMuanMiLAogICAgImRlc2t0b3AvY2FsbHMuanMiLAogICAgImRlc2t0b3AvYmluZGluZ3MuanMiLAogICAgImRlc2t0b3Avd2luZG93LmpzIiwKICAgICJkZXNrdG9wL2Jyb3dzZXIuanMiLAogICAgImRlc2t0b3Avc2VjdXJlc3RvcmFnZS5qcyIsCiAgICAiZGVza3RvcC9zZXR0aW5ncy5qcyIsCiAgICAiZGVza3RvcC9qdW1wbGlzdC5qcyIsCiAgICAiZGVza3RvcC9jdXJzb3IuanMiLAogICAgImRlc2t0b3AvaW5wdXQuanMiLAogICAgImRlc2t0b3AvYXNzZXRzLmpzIiwKICAgICJkZXNrdG9wL2FyZ3MuanMiLAogICAgImRlc2t0b3AvZWxldmF0aW9uLmpzIiwKICAgICJkZXNrdG9wL2Rvd25sb2Fkcy5qcyIsCiAgICAiZGVza3RvcC9jbGlwYm9hcmQuanMiLAogICAgImRlc2t0b3AvZHJhZ2Ryb3AuanMiLAogICAgImRlc2t0b3AvbWFpbi5qcyIKICBdLAogICJzb3VyY2VzQ29udGVudCI6IFsKICAgICIvKlxuIF8gICAgICAgX18gICAgICBfIF9fXG58IHwgICAgIC8gL19fXyBfKF8pIC9fX19fXG58IHwgL3wgLyAvIF9fIGAvIC8gLyBfX18vXG58IHwvIHwvIC8gL18vIC8gLyAoX18gIClcbnxfXy98X18vXFxfXyxfL18vXy9fX19fL1xuVGhlIGVsZWN0cm9uIGFsdGVybmF0aXZlIGZvciBHb1xuKGMpIExlYSBBbnRob255IDIwMTktcHJlc2VudFxuKi9cblxuLyoganNoaW50IGVzdmVyc2lvbjogNiAqL1xuXG4vKipcbiAqIFNlbmRzIGEgbG9nIG1lc3NhZ2UgdG8gdGhlIGJhY2tlbmQgd2l0aCB0aGUgZ2l2ZW4gbGV2ZWwgKyBtZXNzYWdlXG4gKlxuICogQHBhcmFtIHtzdHJpbmd9IGxldmVsXG4gKiBAcGFyYW0ge3N0cmluZ30gbWVzc2FnZVxuICovXG5mdW5jdGlvbiBzZW5kTG9nTWVzc2FnZShsZXZlbCwgbWVzc2FnZSkge1xuXG5cdC8vIExvZyBNZXNzYWdlIGZvcm1hdDpcblx0Ly8gbFt0eXBlXVttZXNzYWdlXVxuXHR3aW5kb3cuV2FpbHNJbnZva2UoJ0wnICsgbGV2ZWwgKyBtZXNzYWdlKTtcbn1cblxuLyoqXG4gKiBMb2cgdGhlIGdpdmVuIHRyYWNlIG1lc3NhZ2Ugd2l0aCB0aGUgYmFja2VuZFxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7c3RyaW5nfSBtZXNzYWdlXG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBMb2dUcmFjZShtZXNzYWdlKSB7XG5cdHNlbmRMb2dNZXNzYWdlKCdUJywgbWVzc2FnZSk7XG59XG5cbi8qKlxuICogTG9nIHRoZSBnaXZlbiBtZXNzYWdlIHdpdGggdGhlIGJhY2tlbmRcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge3N0cmluZ30gbWVzc2FnZVxuICovXG5leHBvcnQgZnVuY3Rpb24gTG9nUHJpbnQobWVzc2FnZSkge1xuXHRzZW5kTG9nTWVzc2FnZSgnUCcsIG1lc3NhZ2UpO1xufVxuXG4vKipcbiAqIExvZyB0aGUgZ2l2ZW4gZGVidWcgbWVzc2FnZSB3aXRoIHRoZSBiYWNrZW5kXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IG1lc3NhZ2VcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIExvZ0RlYnVnKG1lc3NhZ2UpIHtcblx0c2VuZExvZ01lc3NhZ2UoJ0QnLCBtZXNzYWdlKTtcbn1cblxuLyoqXG4gKiBMb2cgdGhlIGdpdmVuIGluZm8gbWVzc2FnZSB3aXRoIHRoZSBiYWNrZW5kXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IG1lc3NhZ2VcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIExvZ0luZm8obWVzc2FnZSkge1xuXHRzZW5kTG9nTWVzc2FnZSgnSScsIG1lc3NhZ2UpO1xufVxuXG4vKipcbiAqIExvZyB0aGUgZ2l2ZW4gd2FybmluZyBtZXNzYWdlIHdpdGggdGhlIGJhY2tlbmRcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge3N0cmluZ30gbWVzc2FnZVxuICovXG5leHBvcnQgZnVuY3Rpb24gTG9nV2FybmluZyhtZXNzYWdlKSB7XG5cdHNlbmRMb2dNZXNzYWdlKCdXJywgbWVzc2FnZSk7XG59XG5cbi8qKlxuICogTG9nIHRoZSBnaXZlbiBlcnJvciBtZXNzYWdlIHdpdGggdGhlIGJhY2tlbmRcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge3N0cmluZ30gbWVzc2FnZVxuICovXG5leHBvcnQgZnVuY3Rpb24gTG9nRXJyb3IobWVzc2FnZSkge1xuXHRzZW5kTG9nTWVzc2FnZSgnRScsIG1lc3NhZ2UpO1xufVxuXG4vKipcbiAqIExvZyB0aGUgZ2l2ZW4gZmF0YWwgbWVzc2FnZSB3aXRoIHRoZSBiYWNrZW5kXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IG1lc3NhZ2VcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIExvZ0ZhdGFsKG1lc3NhZ2UpIHtcblx0c2VuZExvZ01lc3NhZ2UoJ0YnLCBtZXNzYWdlKTtcbn1cblxuLyoqXG4gKiBTZXRzIHRoZSBMb2cgbGV2ZWwgdG8gdGhlIGdpdmVuIGxvZyBsZXZlbFxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7bnVtYmVyfSBsb2dsZXZlbFxuICovXG5leHBvcnQgZnVuY3Rpb24gU2V0TG9nTGV2ZWwobG9nbGV2ZWwpIHtcblx0c2VuZExvZ01lc3NhZ2UoJ1MnLCBsb2dsZXZlbCk7XG59XG5cbi8vIExvZyBsZXZlbHNcbmV4cG9ydCBjb25zdCBMb2dMZXZlbCA9IHtcblx0VFJBQ0U6IDEsXG5cdERFQlVHOiAyLFxuXHRJTkZPOiAzLFxuXHRXQVJOSU5HOiA0LFxuXHRFUlJPUjogNSxcbn07XG4iLAogICAgIi8qXG4gXyAgICAgICBfXyAgICAgIF8gX19cbnwgfCAgICAgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuLyoganNoaW50IGVzdmVyc2lvbjogNiAqL1xuXG4vLyBEZWZpbmVzIGEgc2luZ2xlIGxpc3RlbmVyIHdpdGggYSBtYXhpbXVtIG51bWJlciBvZiB0aW1lcyB0byBjYWxsYmFja1xuXG4vKipcbiAqIFRoZSBMaXN0ZW5lciBjbGFzcyBkZWZpbmVzIGEgbGlzdGVuZXIhIDotKVxuICpcbiAqIEBjbGFzcyBMaXN0ZW5lclxuICovXG5jbGFzcyBMaXN0ZW5lciB7XG4gICAgLyoqXG4gICAgICogQ3JlYXRlcyBhbiBpbnN0YW5jZSBvZiBMaXN0ZW5lci5cbiAgICAgKiBAcGFyYW0ge2Z1bmN0aW9ufSBjYWxsYmFja1xuICAgICAqIEBwYXJhbSB7bnVtYmVyfSBtYXhDYWxsYmFja3NcbiAgICAgKiBAbWVtYmVyb2YgTGlzdGVuZXJcbiAgICAgKi9cbiAgICBjb25zdHJ1Y3RvcihjYWxsYmFjaywgbWF4Q2FsbGJhY2tzKSB7XG4gICAgICAgIC8vIERlZmF1bHQgb2YgLTEgbWVhbnMgaW5maW5pdGVcbiAgICAgICAgbWF4Q2FsbGJhY2tzID0gbWF4Q2FsbGJhY2tzIHx8IC0xO1xuICAgICAgICAvLyBDYWxsYmFjayBpbnZva2VzIHRoZSBjYWxsYmFjayB3aXRoIHRoZSBnaXZlbiBkYXRhXG4gICAgICAgIC8vIFJldHVybnMgdHJ1ZSBpZiB0aGlzIGxpc3RlbmVyIHNob3VsZCBiZSBkZXN0cm95ZWRcbiAgICAgICAgdGhpcy5DYWxsYmFjayA9IChkYXRhKSA9PiB7XG4gICAgICAgICAgICBjYWxsYmFjay5hcHBseShudWxsLCBkYXRhKTtcbiAgICAgICAgICAgIC8vIElmIG1heENhbGxiYWNrcyBpcyBpbmZpbml0ZSwgcmV0dXJuIGZhbHNlIChkbyBub3QgZGVzdHJveSlcbiAgICAgICAgICAgIGlmIChtYXhDYWxsYmFja3MgPT09IC0xKSB7XG4gICAgICAgICAgICAgICAgcmV0dXJuIGZhbHNlO1xuICAgICAgICAgICAgfVxuICAgICAgICAgICAgLy8gRGVjcmVtZW50IG1heENhbGxiYWNrcy4gUmV0dXJuIHRydWUgaWYgbm93IDAsIG90aGVyd2lzZSBmYWxzZVxuICAgICAgICAgICAgbWF4Q2FsbGJhY2tzIC09IDE7XG4gICAgICAgICAgICByZXR1cm4gbWF4Q2FsbGJhY2tzID09PSAwO1xuICAgICAgICB9O1xuICAgIH1cbn1cblxuZXhwb3J0IGNvbnN0IGV2ZW50TGlzdGVuZXJzID0ge307XG5cbi8qKlxuICogUmVnaXN0ZXJzIGFuIGV2ZW50IGxpc3RlbmVyIHRoYXQgd2lsbCBiZSBpbnZva2VkIGBtYXhDYWxsYmFja3NgIHRpbWVzIGJlZm9yZSBiZWluZyBkZXN0cm95ZWRcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge3N0cmluZ30gZXZlbnROYW1lXG4gKiBAcGFyYW0ge2Z1bmN0aW9ufSBjYWxsYmFja1xuICogQHBhcmFtIHtudW1iZXJ9IG1heENhbGxiYWNrc1xuICovXG5leHBvcnQgZnVuY3Rpb24gRXZlbnRzT25NdWx0aXBsZShldmVudE5hbWUsIGNhbGxiYWNrLCBtYXhDYWxsYmFja3MpIHtcbiAgICBldmVudExpc3RlbmVyc1tldmVudE5hbWVdID0gZXZlbnRMaXN0ZW5lcnNbZXZlbnROYW1lXSB8fCBbXTtcbiAgICBjb25zdCB0aGlzTGlzdGVuZXIgPSBuZXcgTGlzdGVuZXIoY2FsbGJhY2ssIG1heENhbGxiYWNrcyk7XG4gICAgZXZlbnRMaXN0ZW5lcnNbZXZlbnROYW1lXS5wdXNoKHRoaXNMaXN0ZW5lcik7XG59XG5cbi8qKlxuICogUmVnaXN0ZXJzIGFuIGV2ZW50IGxpc3RlbmVyIHRoYXQgd2lsbCBiZSBpbnZva2VkIGV2ZXJ5IHRpbWUgdGhlIGV2ZW50IGlzIGVtaXR0ZWRcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge3N0cmluZ30gZXZlbnROYW1lXG4gKiBAcGFyYW0ge2Z1bmN0aW9ufSBjYWxsYmFja1xuICovXG5leHBvcnQgZnVuY3Rpb24gRXZlbnRzT24oZXZlbnROYW1lLCBjYWxsYmFjaykge1xuICAgIEV2ZW50c09uTXVsdGlwbGUoZXZlbnROYW1lLCBjYWxsYmFjaywgLTEpO1xufVxuXG4vKipcbiAqIFJlZ2lzdGVycyBhbiBldmVudCBsaXN0ZW5lciB0aGF0IHdpbGwgYmUgaW52b2tlZCBvbmNlIHRoZW4gZGVzdHJveWVkXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IGV2ZW50TmFtZVxuICogQHBhcmFtIHtmdW5jdGlvbn0gY2FsbGJhY2tcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIEV2ZW50c09uY2UoZXZlbnROYW1lLCBjYWxsYmFjaykge1xuICAgIEV2ZW50c09uTXVsdGlwbGUoZXZlbnROYW1lLCBjYWxsYmFjaywgMSk7XG59XG5cbmZ1bmN0aW9uIG5vdGlmeUxpc3RlbmVycyhldmVudERhdGEpIHtcblxuICAgIC8vIEdldCB0aGUgZXZlbnQgbmFtZVxuICAgIGxldCBldmVudE5hbWUgPSBldmVudERhdGEubmFtZTtcblxuICAgIC8vIENoZWNrIGlmIHdlIGhhdmUgYW55IGxpc3RlbmVycyBmb3IgdGhpcyBldmVudFxuICAgIGlmIChldmVudExpc3RlbmVyc1tldmVudE5hbWVdKSB7XG5cbiAgICAgICAgLy8gS2VlcCBhIGxpc3Qgb2YgbGlzdGVuZXIgaW5kZXhlcyB0byBkZXN0cm95XG4gICAgICAgIGNvbnN0IG5ld0V2ZW50TGlzdGVuZXJMaXN0ID0gZXZlbnRMaXN0ZW5lcnNbZXZlbnROYW1lXS5zbGljZSgpO1xuXG4gICAgICAgIC8vIEl0ZXJhdGUgbGlzdGVuZXJzXG4gICAgICAgIGZvciAobGV0IGNvdW50ID0gMDsgY291bnQgPCBldmVudExpc3RlbmVyc1tldmVudE5hbWVdLmxlbmd0aDsgY291bnQgKz0gMSkge1xuXG4gICAgICAgICAgICAvLyBHZXQgbmV4dCBsaXN0ZW5lclxuICAgICAgICAgICAgY29uc3QgbGlzdGVuZXIgPSBldmVudExpc3RlbmVyc1tldmVudE5hbWVdW2NvdW50XTtcblxuICAgICAgICAgICAgbGV0IGRhdGEgPSBldmVudERhdGEuZGF0YTtcblxuICAgICAgICAgICAgLy8gRG8gdGhlIGNhbGxiYWNrXG4gICAgICAgICAgICBjb25zdCBkZXN0cm95ID0gbGlzdGVuZXIuQ2FsbGJhY2soZGF0YSk7XG4gICAgICAgICAgICBpZiAoZGVzdHJveSkge1xuICAgICAgICAgICAgICAgIC8vIGlmIHRoZSBsaXN0ZW5lciBpbmRpY2F0ZWQgdG8gZGVzdHJveSBpdHNlbGYsIGFkZCBpdCB0byB0aGUgZGVzdHJveSBsaXN0XG4gICAgICAgICAgICAgICAgbmV3RXZlbnRMaXN0ZW5lckxpc3Quc3BsaWNlKGNvdW50LCAxKTtcbiAgICAgICAgICAgIH1cbiAgICAgICAgfVxuXG4gICAgICAgIC8vIFVwZGF0ZSBjYWxsYmFja3Mgd2l0aCBuZXcgbGlzdCBvZiBsaXN0ZW5lcnNcbiAgICAgICAgZXZlbnRMaXN0ZW5lcnNbZXZlbnROYW1lXSA9IG5ld0V2ZW50TGlzdGVuZXJMaXN0O1xuICAgIH1cbn1cblxuLyoqXG4gKiBOb3RpZnkgaW5mb3JtcyBmcm9udGVuZCBsaXN0ZW5lcnMgdGhhdCBhbiBldmVudCB3YXMgZW1pdHRlZCB3aXRoIHRoZSBnaXZlbiBkYXRhXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IG5vdGlmeU1lc3NhZ2UgLSBlbmNvZGVkIG5vdGlmaWNhdGlvbiBtZXNzYWdlXG5cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIEV2ZW50c05vdGlmeShub3RpZnlNZXNzYWdlKSB7XG4gICAgLy8gUGFyc2UgdGhlIG1lc3NhZ2VcbiAgICBsZXQgbWVzc2FnZTtcbiAgICB0cnkge1xuICAgICAgICBtZXNzYWdlID0gSlNPTi5wYXJzZShub3RpZnlNZXNzYWdlKTtcbiAgICB9IGNhdGNoIChlKSB7XG4gICAgICAgIGNvbnN0IGVycm9yID0gJ0ludmFsaWQgSlNPTiBwYXNzZWQgdG8gTm90aWZ5OiAnICsgbm90aWZ5TWVzc2FnZTtcbiAgICAgICAgdGhyb3cgbmV3IEVycm9yKGVycm9yKTtcbiAgICB9XG4gICAgbm90aWZ5TGlzdGVuZXJzKG1lc3NhZ2UpO1xufVxuXG4vKipcbiAqIEVtaXQgYW4gZXZlbnQgd2l0aCB0aGUgZ2l2ZW4gbmFtZSBhbmQgZGF0YVxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7c3RyaW5nfSBldmVudE5hbWVcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIEV2ZW50c0VtaXQoZXZlbnROYW1lKSB7XG5cbiAgICBjb25zdCBwYXlsb2FkID0ge1xuICAgICAgICBuYW1lOiBldmVudE5hbWUsXG4gICAgICAgIGRhdGE6IFtdLnNsaWNlLmFwcGx5KGFyZ3VtZW50cykuc2xpY2UoMSksXG4gICAgfTtcblxuICAgIC8vIE5vdGlmeSBKUyBsaXN0ZW5lcnNcbiAgICBub3RpZnlMaXN0ZW5lcnMocGF5bG9hZCk7XG5cbiAgICAvLyBOb3RpZnkgR28gbGlzdGVuZXJzXG4gICAgd2luZG93LldhaWxzSW52b2tlKCdFRScgKyBKU09OLnN0cmluZ2lmeShwYXlsb2FkKSk7XG59XG5cbmV4cG9ydCBmdW5jdGlvbiBFdmVudHNPZmYoZXZlbnROYW1lKSB7XG4gICAgLy8gUmVtb3ZlIGxvY2FsIGxpc3RlbmVyc1xuICAgIGRlbGV0ZSBldmVudExpc3RlbmVyc1tldmVudE5hbWVdO1xuXG4gICAgLy8gTm90aWZ5IEdvIGxpc3RlbmVyc1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnRVgnICsgZXZlbnROYW1lKTtcbn0iLAogICAgIi8qXG4gXyAgICAgICBfXyAgICAgIF8gX19cbnwgfCAgICAgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuLyoganNoaW50IGVzdmVyc2lvbjogNiAqL1xuXG5pbXBvcnQge2V2ZW50TGlzdGVuZXJzLCBFdmVudHNPbn0gZnJvbSAnLi9ldmVudHMnO1xuXG5leHBvcnQgY29uc3QgY2FsbGJhY2tzID0ge307XG5cbi8vIFRoZSBwcm9ncmVzcyBvZiBhIGNhbGwgaXMgc2VudCBhcyBhbiBldmVudCBuYW1lZCB3aXRoIHRoaXMgcHJlZml4IGFuZCB0aGUgY2FsbGJhY2tJRFxuY29uc3QgcHJvZ3Jlc3NFdmVudFByZWZpeCA9ICd3YWlsczpwcm9ncmVzczonO1xuXG4vKipcbiAqIFJldHVybnMgYSBudW1iZXIgZnJvbSB0aGUgbmF0aXZlIGJyb3dzZXIgcmFuZG9tIGZ1bmN0aW9uXG4gKlxuICogQHJldHVybnMgbnVtYmVyXG4gKi9cbmZ1bmN0aW9uIGNyeXB0b1JhbmRvbSgpIHtcblx0dmFyIGFycmF5ID0gbmV3IFVpbnQzMkFycmF5KDEpO1xuXHRyZXR1cm4gd2luZG93LmNyeXB0by5nZXRSYW5kb21WYWx1ZXMoYXJyYXkpWzBdO1xufVxuXG4vKipcbiAqIFJldHVybnMgYSBudW1iZXIgdXNpbmcgZGEgb2xkLXNrb29sIE1hdGguUmFuZG9tXG4gKiBJIGxpa2VzIHRvIGNhbGwgaXQgTE9MUmFuZG9tXG4gKlxuICogQHJldHVybnMgbnVtYmVyXG4gKi9cbmZ1bmN0aW9uIGJhc2ljUmFuZG9tKCkge1xuXHRyZXR1cm4gTWF0aC5yYW5kb20oKSAqIDkwMDcxOTkyNTQ3NDA5OTE7XG59XG5cbi8vIFBpY2sgYSByYW5kb20gbnVtYmVyIGZ1bmN0aW9uIGJhc2VkIG9uIGJyb3dzZXIgY2FwYWJpbGl0eVxudmFyIHJhbmRvbUZ1bmM7XG5pZiAod2luZG93LmNyeXB0bykge1xuXHRyYW5kb21GdW5jID0gY3J5cHRvUmFuZG9tO1xufSBlbHNlIHtcblx0cmFuZG9tRnVuYyA9IGJhc2ljUmFuZG9tO1xufVxuXG5cbi8qKlxuICogQ2FsbCBzZW5kcyBhIG1lc3NhZ2UgdG8gdGhlIGJhY2tlbmQgdG8gY2FsbCB0aGUgYmluZGluZyB3aXRoIHRoZVxuICogZ2l2ZW4gZGF0YS4gQSBwcm9taXNlIGlzIHJldHVybmVkIGFuZCB3aWxsIGJlIGNvbXBsZXRlZCB3aGVuIHRoZVxuICogYmFja2VuZCByZXNwb25kcy4gVGhpcyB3aWxsIGJlIHJlc29sdmVkIHdoZW4gdGhlIGNhbGwgd2FzIHN1Y2Nlc3NmdWxcbiAqIG9yIHJlamVjdGVkIGlmIGFuIGVycm9yIGlzIHBhc3NlZCBiYWNrLlxuICogVGhlcmUgaXMgYSB0aW1lb3V0IG1lY2hhbmlzbS4gSWYgdGhlIGNhbGwgZG9lc24ndCByZXNwb25kIGluIHRoZSBnaXZlblxuICogdGltZSAoaW4gbWlsbGlzZWNvbmRzKSB0aGVuIHRoZSBwcm9taXNlIGlzIHJlamVjdGVkIGFuZCB0aGUgY29udGV4dCBvZlxuICogdGhlIGNhbGwgaW4gR28gaXMgY2FuY2VsbGVkLlxuICogVGhlIElEIG9mIHRoZSByZXF1ZXN0IGlzIGF2YWlsYWJsZSBhcyBgcmVxdWVzdElEYCBvbiB0aGUgcmV0dXJuZWQgcHJvbWlzZVxuICogc28gdGhhdCB0aGUgY2FsbCBjYW4gYmUgY2FuY2VsbGVkIHVzaW5nIGBDYWxsQ2FuY2VsYC4gUHJvZ3Jlc3Mgc2VudCBieSB0aGVcbiAqIEdvIG1ldGhvZCBjYW4gYmUgcmVjZWl2ZWQgYnkgcmVnaXN0ZXJpbmcgYSBoYW5kbGVyIHdpdGggYG9uUHJvZ3Jlc3NgLlxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7c3RyaW5nfSBuYW1lXG4gKiBAcGFyYW0ge2FueT19IGFyZ3NcbiAqIEBwYXJhbSB7bnVtYmVyPX0gdGltZW91dFxuICogQHJldHVybnNcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIENhbGwobmFtZSwgYXJncywgdGltZW91dCkge1xuXG5cdC8vIFRpbWVvdXQgaW5maW5pdGUgYnkgZGVmYXVsdFxuXHRpZiAodGltZW91dCA9PSBudWxsKSB7XG5cdFx0dGltZW91dCA9IDA7XG5cdH1cblxuXHQvLyBDcmVhdGUgYSB1bmlxdWUgY2FsbGJhY2tJRFxuXHR2YXIgY2FsbGJhY2tJRDtcblx0ZG8ge1xuXHRcdGNhbGxiYWNrSUQgPSBuYW1lICsgJy0nICsgcmFuZG9tRnVuYygpO1xuXHR9IHdoaWxlIChjYWxsYmFja3NbY2FsbGJhY2tJRF0pO1xuXG5cdC8vIENyZWF0ZSBhIHByb21pc2Vcblx0Y29uc3QgcHJvbWlzZSA9IG5ldyBQcm9taXNlKGZ1bmN0aW9uIChyZXNvbHZlLCByZWplY3QpIHtcblxuXHRcdHZhciB0aW1lb3V0SGFuZGxlO1xuXHRcdC8vIFNldCB0aW1lb3V0XG5cdFx0aWYgKHRpbWVvdXQgPiAwKSB7XG5cdFx0XHR0aW1lb3V0SGFuZGxlID0gc2V0VGltZW91dChmdW5jdGlvbiAoKSB7XG5cdFx0XHRcdHJlamVjdChFcnJvcignQ2FsbCB0byAnICsgbmFtZSArICcgdGltZWQgb3V0LiBSZXF1ZXN0IElEOiAnICsgY2FsbGJhY2tJRCkpO1xuXHRcdFx0fSwgdGltZW91dCk7XG5cdFx0fVxuXG5cdFx0Ly8gU3RvcmUgY2FsbGJhY2tcblx0XHRjYWxsYmFja3NbY2FsbGJhY2tJRF0gPSB7XG5cdFx0XHR0aW1lb3V0SGFuZGxlOiB0aW1lb3V0SGFuZGxlLFxuXHRcdFx0cmVqZWN0OiByZWplY3QsXG5cdFx0XHRyZXNvbHZlOiByZXNvbHZlXG5cdFx0fTtcblxuXHRcdHRyeSB7XG5cdFx0XHRjb25zdCBwYXlsb2FkID0ge1xuXHRcdFx0XHRuYW1lLFxuXHRcdFx0XHRhcmdzLFxuXHRcdFx0XHRjYWxsYmFja0lELFxuXHRcdFx0XHR0aW1lb3V0LFxuXHRcdFx0fTtcblxuXHRcdFx0Ly8gTWFrZSB0aGUgY2FsbFxuXHRcdFx0d2luZG93LldhaWxzSW52b2tlKCdDJyArIEpTT04uc3RyaW5naWZ5KHBheWxvYWQpKTtcblx0XHR9IGNhdGNoIChlKSB7XG5cdFx0XHQvLyBlc2xpbnQtZGlzYWJsZS1uZXh0LWxpbmVcblx0XHRcdGNvbnNvbGUuZXJyb3IoZSk7XG5cdFx0fVxuXHR9KTtcblx0cHJvbWlzZS5yZXF1ZXN0SUQgPSBjYWxsYmFja0lEO1xuXHRwcm9taXNlLm9uUHJvZ3Jlc3MgPSBmdW5jdGlvbiAoY2FsbGJhY2spIHtcblx0XHRFdmVudHNPbihwcm9ncmVzc0V2ZW50UHJlZml4ICsgY2FsbGJhY2tJRCwgY2FsbGJhY2spO1xuXHRcdHJldHVybiBwcm9taXNlO1xuXHR9O1xuXG5cdHJldHVybiBwcm9taXNlO1xufVxuXG4vKipcbiAqIENhbGxDYW5jZWwgY2FuY2VscyB0aGUgY29udGV4dCBvZiBhbiBpbi1mbGlnaHQgY2FsbCB0byBhIGJvdW5kIG1ldGhvZC5cbiAqIFRoZSBjYWxsJ3MgcHJvbWlzZSBpcyBzdGlsbCBzZXR0bGVkIHdpdGggdGhlIHJlc3VsdCBvZiB0aGUgbWV0aG9kLCB3aGljaFxuICogaXMgdXN1YWxseSBhbiBlcnJvciBvbmNlIHRoZSBtZXRob2Qgb2JzZXJ2ZXMgdGhlIGNhbmNlbGxhdGlvbi5cbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge3N0cmluZ30gcmVxdWVzdElEXG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBDYWxsQ2FuY2VsKHJlcXVlc3RJRCkge1xuXHR3aW5kb3cuV2FpbHNJbnZva2UoJ1gnICsgcmVxdWVzdElEKTtcbn1cblxuXG5cbi8qKlxuICogQ2FsbGVkIGJ5IHRoZSBiYWNrZW5kIHRvIHJldHVybiBkYXRhIHRvIGEgcHJldmlvdXNseSBjYWxsZWRcbiAqIGJpbmRpbmcgaW52b2NhdGlvblxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7c3RyaW5nfSBpbmNvbWluZ01lc3NhZ2VcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIENhbGxiYWNrKGluY29taW5nTWVzc2FnZSkge1xuXHQvLyBQYXJzZSB0aGUgbWVzc2FnZVxuXHRsZXQgbWVzc2FnZTtcblx0dHJ5IHtcblx0XHRtZXNzYWdlID0gSlNPTi5wYXJzZShpbmNvbWluZ01lc3NhZ2UpO1xuXHR9IGNhdGNoIChlKSB7XG5cdFx0Y29uc3QgZXJyb3IgPSBgSW52YWxpZCBKU09OIHBhc3NlZCB0byBjYWxsYmFjazogJHtlLm1lc3NhZ2V9LiBNZXNzYWdlOiAke2luY29taW5nTWVzc2FnZX1gO1xuXHRcdHJ1bnRpbWUuTG9nRGVidWcoZXJyb3IpO1xuXHRcdHRocm93IG5ldyBFcnJvcihlcnJvcik7XG5cdH1cblx0bGV0IGNhbGxiYWNrSUQgPSBtZXNzYWdlLmNhbGxiYWNraWQ7XG5cdGxldCBjYWxsYmFja0RhdGEgPSBjYWxsYmFja3NbY2FsbGJhY2tJRF07XG5cdGlmICghY2FsbGJhY2tEYXRhKSB7XG5cdFx0Y29uc3QgZXJyb3IgPSBgQ2FsbGJhY2sgJyR7Y2FsbGJhY2tJRH0nIG5vdCByZWdpc3RlcmVkISEhYDtcblx0XHRjb25zb2xlLmVycm9yKGVycm9yKTsgLy8gZXNsaW50LWRpc2FibGUtbGluZVxuXHRcdHRocm93IG5ldyBFcnJvcihlcnJvcik7XG5cdH1cblx0Y2xlYXJUaW1lb3V0KGNhbGxiYWNrRGF0YS50aW1lb3V0SGFuZGxlKTtcblxuXHRkZWxldGUgY2FsbGJhY2tzW2NhbGxiYWNrSURdO1xuXHRkZWxldGUgZXZlbnRMaXN0ZW5lcnNbcHJvZ3Jlc3NFdmVudFByZWZpeCArIGNhbGxiYWNrSURdO1xuXG5cdGlmIChtZXNzYWdlLmVycm9yKSB7XG5cdFx0Y2FsbGJhY2tEYXRhLnJlamVjdChtZXNzYWdlLmVycm9yKTtcblx0fSBlbHNlIHtcblx0XHRjYWxsYmFja0RhdGEucmVzb2x2ZShtZXNzYWdlLnJlc3VsdCk7XG5cdH1cbn1cbiIsCiAgICAiLypcbiBfICAgICAgIF9fICAgICAgXyBfXyAgICBcbnwgfCAgICAgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKSBcbnxfXy98X18vXFxfXyxfL18vXy9fX19fLyAgXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuLyoganNoaW50IGVzdmVyc2lvbjogNiAqL1xuXG5pbXBvcnQge0NhbGx9IGZyb20gJy4vY2FsbHMnO1xuXG4vLyBUaGlzIGlzIHdoZXJlIHdlIGJpbmQgZ28gbWV0aG9kIHdyYXBwZXJzXG53aW5kb3cuZ28gPSB7fTtcblxuLy8gbmV3QmluZGluZyBjcmVhdGVzIHRoZSB3cmFwcGVyIHRoYXQgY2FsbHMgdGhlIGdpdmVuIGJvdW5kIG1ldGhvZCBvciBmdW5jdGlvblxuZnVuY3Rpb24gbmV3QmluZGluZyhuYW1lKSB7XG5cblx0Ly8gTm8gdGltZW91dCBieSBkZWZhdWx0XG5cdGxldCB0aW1lb3V0ID0gMDtcblxuXHQvLyBBY3R1YWwgZnVuY3Rpb25cblx0ZnVuY3Rpb24gZHluYW1pYygpIHtcblx0XHRjb25zdCBhcmdzID0gW10uc2xpY2UuY2FsbChhcmd1bWVudHMpO1xuXHRcdHJldHVybiBDYWxsKG5hbWUsIGFyZ3MsIHRpbWVvdXQpO1xuXHR9XG5cblx0Ly8gQWxsb3cgc2V0dGluZyB0aW1lb3V0IHRvIGZ1bmN0aW9uXG5cdGR5bmFtaWMuc2V0VGltZW91dCA9IGZ1bmN0aW9uIChuZXdUaW1lb3V0KSB7XG5cdFx0dGltZW91dCA9IG5ld1RpbWVvdXQ7XG5cdH07XG5cblx0Ly8gQWxsb3cgZ2V0dGluZyB0aW1lb3V0IHRvIGZ1bmN0aW9uXG5cdGR5bmFtaWMuZ2V0VGltZW91dCA9IGZ1bmN0aW9uICgpIHtcblx0XHRyZXR1cm4gdGltZW91dDtcblx0fTtcblxuXHRyZXR1cm4gZHluYW1pYztcbn1cblxuZXhwb3J0IGZ1bmN0aW9uIFNldEJpbmRpbmdzKGJpbmRpbmdzTWFwKSB7XG5cdHRyeSB7XG5cdFx0YmluZGluZ3NNYXAgPSBKU09OLnBhcnNlKGJpbmRpbmdzTWFwKTtcblx0fSBjYXRjaCAoZSkge1xuXHRcdGNvbnNvbGUuZXJyb3IoZSk7XG5cdH1cblxuXHQvLyBJbml0aWFsaXNlIHRoZSBiaW5kaW5ncyBtYXAuIEFueSBwcmV2aW91cyBiaW5kaW5ncyBhcmUgcmVwbGFjZWQsXG5cdC8vIHNvIHRoYXQgbWV0aG9kcyByZW1vdmVkIGFmdGVyIGEgcmVidWlsZCBhcmUgbm8gbG9uZ2VyIGJvdW5kLlxuXHR3aW5kb3cuZ28gPSB7fTtcblxuXHQvLyBJdGVyYXRlIHBhY2thZ2UgYW5kIGZ1bmN0aW9uIG5hbWVzXG5cdE9iamVjdC5rZXlzKGJpbmRpbmdzTWFwKS5mb3JFYWNoKChwYWNrYWdlTmFtZSkgPT4ge1xuXG5cdFx0Ly8gRnVuY3Rpb25zIGFyZSBib3VuZCBhbG9uZ3NpZGUgdGhlIHBhY2thZ2VzXG5cdFx0aWYgKHR5cGVvZiBiaW5kaW5nc01hcFtwYWNrYWdlTmFtZV0ubmFtZSA9PT0gJ3N0cmluZycpIHtcblx0XHRcdHdpbmRvdy5nb1twYWNrYWdlTmFtZV0gPSBuZXdCaW5kaW5nKHBhY2thZ2VOYW1lKTtcblx0XHRcdHJldHVybjtcblx0XHR9XG5cblx0XHQvLyBDcmVhdGUgaW5uZXIgbWFwcyBpZiB0aGV5IGRvbid0IGV4aXN0LlxuXHRcdC8vIFBhY2thZ2VzIG5hbWVzcGFjZWQgYnkgcGF0aCBoYXZlIG11bHRpcGxlIHBhcnRzLCBFRzogJ2ludGVybmFsLmF1dGgnXG5cdFx0bGV0IHBhY2thZ2VNYXAgPSB3aW5kb3cuZ287XG5cdFx0cGFja2FnZU5hbWUuc3BsaXQoJy4nKS5mb3JFYWNoKChwYXJ0KSA9PiB7XG5cdFx0XHRwYWNrYWdlTWFwW3BhcnRdID0gcGFja2FnZU1hcFtwYXJ0XSB8fCB7fTtcblx0XHRcdHBhY2thZ2VNYXAgPSBwYWNrYWdlTWFwW3BhcnRdO1xuXHRcdH0pO1xuXG5cdFx0Ly8gSXRlcmF0ZSBzdHJ1Y3QgbmFtZXNcblx0XHRPYmplY3Qua2V5cyhiaW5kaW5nc01hcFtwYWNrYWdlTmFtZV0pLmZvckVhY2goKHN0cnVjdE5hbWUpID0+IHtcblxuXHRcdFx0Ly8gQ3JlYXRlIGlubmVyIG1hcCBpZiBpdCBkb2Vzbid0IGV4aXN0XG5cdFx0XHRwYWNrYWdlTWFwW3N0cnVjdE5hbWVdID0gcGFja2FnZU1hcFtzdHJ1Y3ROYW1lXSB8fCB7fTtcblxuXHRcdFx0T2JqZWN0LmtleXMoYmluZGluZ3NNYXBbcGFja2FnZU5hbWVdW3N0cnVjdE5hbWVdKS5mb3JFYWNoKChtZXRob2ROYW1lKSA9PiB7XG5cdFx0XHRcdHBhY2thZ2VNYXBbc3RydWN0TmFtZV1bbWV0aG9kTmFtZV0gPSBuZXdCaW5kaW5nKFtwYWNrYWdlTmFtZSwgc3RydWN0TmFtZSwgbWV0aG9kTmFtZV0uam9pbignLicpKTtcblx0XHRcdH0pO1xuXHRcdH0pO1xuXHR9KTtcbn1cbiIsCiAgICAiLypcbiBfXHQgICBfX1x0ICBfIF9fXG58IHxcdCAvIC9fX18gXyhfKSAvX19fX1xufCB8IC98IC8gLyBfXyBgLyAvIC8gX19fL1xufCB8LyB8LyAvIC9fLyAvIC8gKF9fICApXG58X18vfF9fL1xcX18sXy9fL18vX19fXy9cblRoZSBlbGVjdHJvbiBhbHRlcm5hdGl2ZSBmb3IgR29cbihjKSBMZWEgQW50aG9ueSAyMDE5LXByZXNlbnRcbiovXG5cbi8qIGpzaGludCBlc3ZlcnNpb246IDkgKi9cblxuXG5pbXBvcnQge0NhbGx9IGZyb20gXCIuL2NhbGxzXCI7XG5cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dSZWxvYWQoKSB7XG4gICAgd2luZG93LmxvY2F0aW9uLnJlbG9hZCgpO1xufVxuXG4vKipcbiAqIExvYWRzIHRoZSBnaXZlbiBVUkwgaW4gdGhlIHdpbmRvdy4gUmVsYXRpdmUgVVJMcywgRUc6IFwic2V0dGluZ3MuaHRtbFwiLFxuICogbG9hZCB0aGUgcGFnZXMgaW4gdGhlIGFzc2V0cyBvZiB0aGUgYXBwbGljYXRpb24uXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IHVybFxuICogQHJldHVybiB7UHJvbWlzZTx2b2lkPn1cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd0xvYWRVUkwodXJsKSB7XG4gICAgcmV0dXJuIENhbGwoXCI6d2FpbHM6V2luZG93TG9hZFVSTFwiLCBbdXJsXSk7XG59XG5cbi8qKlxuICogUGxhY2UgdGhlIHdpbmRvdyBpbiB0aGUgY2VudGVyIG9mIHRoZSBzY3JlZW5cbiAqXG4gKiBAZXhwb3J0XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dDZW50ZXIoKSB7XG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXYycpO1xufVxuXG4vKipcbiAqIFNldHMgdGhlIHdpbmRvdyB0aXRsZVxuICpcbiAqIEBwYXJhbSB7c3RyaW5nfSB0aXRsZVxuICogQGV4cG9ydFxuICovXG5leHBvcnQgZnVuY3Rpb24gV2luZG93U2V0VGl0bGUodGl0bGUpIHtcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dUJyArIHRpdGxlKTtcbn1cblxuLyoqXG4gKiBNYWtlcyB0aGUgd2luZG93IGdvIGZ1bGxzY3JlZW5cbiAqXG4gKiBAZXhwb3J0XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dGdWxsc2NyZWVuKCkge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV0YnKTtcbn1cblxuLyoqXG4gKiBSZXZlcnRzIHRoZSB3aW5kb3cgZnJvbSBmdWxsc2NyZWVuXG4gKlxuICogQGV4cG9ydFxuICovXG5leHBvcnQgZnVuY3Rpb24gV2luZG93VW5GdWxsc2NyZWVuKCkge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV2YnKTtcbn1cblxuLyoqXG4gKiBTZXQgdGhlIFNpemUgb2YgdGhlIHdpbmRvd1xuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7bnVtYmVyfSB3aWR0aFxuICogQHBhcmFtIHtudW1iZXJ9IGhlaWdodFxuICovXG5leHBvcnQgZnVuY3Rpb24gV2luZG93U2V0U2l6ZSh3aWR0aCwgaGVpZ2h0KSB7XG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXczonICsgd2lkdGggKyAnOicgKyBoZWlnaHQpO1xufVxuXG4vKipcbiAqIEdldCB0aGUgU2l6ZSBvZiB0aGUgd2luZG93XG4gKlxuICogQGV4cG9ydFxuICogQHJldHVybiB7UHJvbWlzZTx7dzogbnVtYmVyLCBoOiBudW1iZXJ9Pn0gVGhlIHNpemUgb2YgdGhlIHdpbmRvd1xuXG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dHZXRTaXplKCkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOldpbmRvd0dldFNpemVcIik7XG59XG5cbi8qKlxuICogU2V0IHRoZSBtYXhpbXVtIHNpemUgb2YgdGhlIHdpbmRvd1xuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7bnVtYmVyfSB3aWR0aFxuICogQHBhcmFtIHtudW1iZXJ9IGhlaWdodFxuICovXG5leHBvcnQgZnVuY3Rpb24gV2luZG93U2V0TWF4U2l6ZSh3aWR0aCwgaGVpZ2h0KSB7XG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXWjonICsgd2lkdGggKyAnOicgKyBoZWlnaHQpO1xufVxuXG4vKipcbiAqIFNldCB0aGUgbWluaW11bSBzaXplIG9mIHRoZSB3aW5kb3dcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge251bWJlcn0gd2lkdGhcbiAqIEBwYXJhbSB7bnVtYmVyfSBoZWlnaHRcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd1NldE1pblNpemUod2lkdGgsIGhlaWdodCkge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV3o6JyArIHdpZHRoICsgJzonICsgaGVpZ2h0KTtcbn1cblxuLyoqXG4gKiBTZXQgdGhlIFBvc2l0aW9uIG9mIHRoZSB3aW5kb3dcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge251bWJlcn0geFxuICogQHBhcmFtIHtudW1iZXJ9IHlcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd1NldFBvc2l0aW9uKHgsIHkpIHtcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dwOicgKyB4ICsgJzonICsgeSk7XG59XG5cbi8qKlxuICogR2V0IHRoZSBQb3NpdGlvbiBvZiB0aGUgd2luZG93XG4gKlxuICogQGV4cG9ydFxuICogQHJldHVybiB7UHJvbWlzZTx7eDogbnVtYmVyLCB5OiBudW1iZXJ9Pn0gVGhlIHBvc2l0aW9uIG9mIHRoZSB3aW5kb3dcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd0dldFBvc2l0aW9uKCkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOldpbmRvd0dldFBvc1wiKTtcbn1cblxuLyoqXG4gKiBIaWRlIHRoZSBXaW5kb3dcbiAqXG4gKiBAZXhwb3J0XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dIaWRlKCkge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV0gnKTtcbn1cblxuLyoqXG4gKiBTaG93IHRoZSBXaW5kb3dcbiAqXG4gKiBAZXhwb3J0XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dTaG93KCkge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV1MnKTtcbn1cblxuLyoqXG4gKiBTaG93IHRoZSBXaW5kb3cgd2l0aG91dCB0YWtpbmcgdGhlIGZvY3VzIGZyb20gdGhlIGFwcGxpY2F0aW9uIHRoZSB1c2VyIGlzIGluXG4gKlxuICogQGV4cG9ydFxuICovXG5leHBvcnQgZnVuY3Rpb24gV2luZG93U2hvd05vQWN0aXZhdGUoKSB7XG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXTicpO1xufVxuXG4vKipcbiAqIE1heGltaXNlIHRoZSBXaW5kb3dcbiAqXG4gKiBAZXhwb3J0XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dNYXhpbWlzZSgpIHtcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dNJyk7XG59XG5cbi8qKlxuICogVW5tYXhpbWlzZSB0aGUgV2luZG93XG4gKlxuICogQGV4cG9ydFxuICovXG5leHBvcnQgZnVuY3Rpb24gV2luZG93VW5tYXhpbWlzZSgpIHtcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dVJyk7XG59XG5cbi8qKlxuICogTWluaW1pc2UgdGhlIFdpbmRvd1xuICpcbiAqIEBleHBvcnRcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd01pbmltaXNlKCkge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV20nKTtcbn1cblxuLyoqXG4gKiBVbm1pbmltaXNlIHRoZSBXaW5kb3dcbiAqXG4gKiBAZXhwb3J0XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dVbm1pbmltaXNlKCkge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV3UnKTtcbn1cblxuXG4vKipcbiAqIFNldHMgdGhlIGJhY2tncm91bmQgY29sb3VyIG9mIHRoZSB3aW5kb3dcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge1JHQkF9IFJHQkEgYmFja2dyb3VuZCBjb2xvdXJcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd1NldFJHQkEoUkdCQSkge1xuICAgIGxldCByZ2JhID0gSlNPTi5zdHJpbmdpZnkoUkdCQSk7XG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXcjonICsgcmdiYSk7XG59XG5cblxuLyoqXG4gKiBTZXRzIHdoZXRoZXIgbW91c2UgZXZlbnRzIHBhc3MgdGhyb3VnaCB0aGUgd2luZG93IHRvIHRoZSB3aW5kb3dzIGJlbmVhdGggaXRcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge2Jvb2xlYW59IGlnbm9yZVxuICovXG5leHBvcnQgZnVuY3Rpb24gV2luZG93U2V0SWdub3JlTW91c2VFdmVudHMoaWdub3JlKSB7XG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXSScgKyAoaWdub3JlID8gJzEnIDogJzAnKSk7XG59XG5cbi8qKlxuICogU2V0cyB3aGV0aGVyIHRoZSB3aW5kb3cgaXMga2VwdCBiZW5lYXRoIGFsbCBvdGhlciB3aW5kb3dzLCBFRzogZm9yIGRlc2t0b3Agd2lkZ2V0c1xuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7Ym9vbGVhbn0gYm90dG9tXG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dTZXRBbHdheXNPbkJvdHRvbShib3R0b20pIHtcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dCJyArIChib3R0b20gPyAnMScgOiAnMCcpKTtcbn1cblxuLyoqXG4gKiBNYWtlcyB0aGUgd2luZG93IGZ1bGxzY3JlZW4gYW5kIGhpZGVzIHRoZSBjdXJzb3Igd2hpbGUgaXQgaXMgb3ZlciB0aGUgd2luZG93LiBPbiBXaW5kb3dzLCB0aGUgZGlzcGxheSBpcyBhbHNvIGtlcHRcbiAqIG9uIGFuZCB0aGUgY29tcHV0ZXIga2VwdCBhd2FrZS4gRXhpdFByZXNlbnRhdGlvbk1vZGUgcmVzdG9yZXMgdGhlIHByZXZpb3VzIHN0YXRlLlxuICpcbiAqIEBleHBvcnRcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIEVudGVyUHJlc2VudGF0aW9uTW9kZSgpIHtcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dQMScpO1xufVxuXG4vKipcbiAqIExlYXZlcyBwcmVzZW50YXRpb24gbW9kZSwgcmVzdG9yaW5nIHRoZSBzdGF0ZSBvZiB0aGUgd2luZG93IGZyb20gYmVmb3JlIGl0IHdhcyBlbnRlcmVkXG4gKlxuICogQGV4cG9ydFxuICovXG5leHBvcnQgZnVuY3Rpb24gRXhpdFByZXNlbnRhdGlvbk1vZGUoKSB7XG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXUDAnKTtcbn1cblxuLyoqXG4gKiBFbmFibGVzIG9yIGRpc2FibGVzIG1vdXNlIGFuZCBrZXlib2FyZCBpbnB1dCB0byB0aGUgd2luZG93LCBFRzogd2hpbGUgYSBuYXRpdmUgZGlhbG9nIGlzIHNob3duLlxuICogTm90IHN1cHBvcnRlZCBvbiBtYWNPUy5cbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge2Jvb2xlYW59IGVuYWJsZWRcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd1NldEVuYWJsZWQoZW5hYmxlZCkge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV0UnICsgKGVuYWJsZWQgPyAnMScgOiAnMCcpKTtcbn1cblxuLyoqXG4gKiBSZXR1cm5zIHRydWUgaWYgdGhlIHdpbmRvdyBhY2NlcHRzIG1vdXNlIGFuZCBrZXlib2FyZCBpbnB1dFxuICpcbiAqIEBleHBvcnRcbiAqIEByZXR1cm4ge1Byb21pc2U8Ym9vbGVhbj59XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBXaW5kb3dJc0VuYWJsZWQoKSB7XG4gICAgcmV0dXJuIENhbGwoXCI6d2FpbHM6V2luZG93SXNFbmFibGVkXCIpO1xufVxuXG4vKipcbiAqIFJlcGxhY2VzIHRoZSBidXR0b25zIGluIHRoZSB0b29sYmFyIG9mIHRoZSB0YXNrYmFyIHRodW1ibmFpbCBvZiB0aGUgd2luZG93IG9uIFdpbmRvd3MuXG4gKiBDbGlja2luZyBhIGJ1dHRvbiBlbWl0cyB0aGUgYHdhaWxzOnRodW1ibmFpbGJ1dHRvbjpjbGlja2AgZXZlbnQgd2l0aCB0aGUgSUQgb2YgdGhlIGJ1dHRvbi5cbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge1RodW1ibmFpbEJ1dHRvbltdfSBidXR0b25zIC0gYSBtYXhpbXVtIG9mIDcgYnV0dG9uc1xuICogQHJldHVybiB7UHJvbWlzZTx2b2lkPn1cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFdpbmRvd1NldFRodW1ibmFpbEJ1dHRvbnMoYnV0dG9ucykge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOldpbmRvd1NldFRodW1ibmFpbEJ1dHRvbnNcIiwgW2J1dHRvbnNdKTtcbn1cbiIsCiAgICAiaW1wb3J0IHtDYWxsfSBmcm9tIFwiLi9jYWxsc1wiO1xuXG4vKipcbiAqIEBkZXNjcmlwdGlvbjogVXNlIHRoZSBzeXN0ZW0gZGVmYXVsdCBicm93c2VyIHRvIG9wZW4gdGhlIHVybC4gT25seSBodHRwLCBodHRwcyBhbmQgbWFpbHRvIFVSTHMgYXJlIG9wZW5lZC5cbiAqIEBwYXJhbSB7c3RyaW5nfSB1cmwgXG4gKiBAcmV0dXJuIHt2b2lkfVxuICovXG5leHBvcnQgZnVuY3Rpb24gQnJvd3Nlck9wZW5VUkwodXJsKSB7XG4gIHdpbmRvdy5XYWlsc0ludm9rZSgnQk86JyArIHVybCk7XG59XG5cbi8qKlxuICogQGRlc2NyaXB0aW9uOiBPcGVucyB0aGUgZ2l2ZW4gZmlsZSBpbiB0aGUgYXBwbGljYXRpb24gYXNzb2NpYXRlZCB3aXRoIGl0cyB0eXBlLlxuICogRXhlY3V0YWJsZXMgYW5kIGRpcmVjdG9yaWVzIGFyZSBub3Qgb3BlbmVkLlxuICogQHBhcmFtIHtzdHJpbmd9IHBhdGhcbiAqIEByZXR1cm4ge1Byb21pc2U8dm9pZD59XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBPcGVuRmlsZUluRGVmYXVsdEFwcChwYXRoKSB7XG4gIHJldHVybiBDYWxsKFwiOndhaWxzOk9wZW5GaWxlSW5EZWZhdWx0QXBwXCIsIFtwYXRoXSk7XG59XG4iLAogICAgIi8qXG4gX1x0ICAgX19cdCAgXyBfX1xufCB8XHQgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuXG4vKiBqc2hpbnQgZXN2ZXJzaW9uOiA5ICovXG5cbmltcG9ydCB7Q2FsbH0gZnJvbSBcIi4vY2FsbHNcIjtcblxuLyoqXG4gKiBSZXR1cm5zIHRoZSBzZWNyZXQgc3RvcmVkIHdpdGggdGhlIGdpdmVuIGtleS5cbiAqIFRoZSBwcm9taXNlIGlzIHJlamVjdGVkIHdpdGggYW4gZXJyb3Igd2l0aCB0aGUgY29kZSBgTm90Rm91bmRgIGlmIHRoZXJlIGlzIG5vIHNlY3JldCB3aXRoIHRoZSBrZXksXG4gKiBvciBgQWNjZXNzRGVuaWVkYCBpZiB0aGUgY3JlZGVudGlhbCBzdG9yZSBjYW4ndCBiZSBhY2Nlc3NlZC5cbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge3N0cmluZ30ga2V5XG4gKiBAcmV0dXJuIHtQcm9taXNlPHN0cmluZz59XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBTZWN1cmVTdG9yYWdlR2V0KGtleSkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOlNlY3VyZVN0b3JhZ2VHZXRcIiwgW2tleV0pO1xufVxuXG4vKipcbiAqIFN0b3JlcyBhIHNlY3JldCB3aXRoIHRoZSBnaXZlbiBrZXkgaW4gdGhlIGNyZWRlbnRpYWwgc3RvcmUgb2YgdGhlIG9wZXJhdGluZyBzeXN0ZW0sXG4gKiByZXBsYWNpbmcgYW55IGV4aXN0aW5nIHNlY3JldFxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7c3RyaW5nfSBrZXlcbiAqIEBwYXJhbSB7c3RyaW5nfSB2YWx1ZVxuICogQHJldHVybiB7UHJvbWlzZTx2b2lkPn1cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFNlY3VyZVN0b3JhZ2VTZXQoa2V5LCB2YWx1ZSkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOlNlY3VyZVN0b3JhZ2VTZXRcIiwgW2tleSwgdmFsdWVdKTtcbn1cblxuLyoqXG4gKiBEZWxldGVzIHRoZSBzZWNyZXQgc3RvcmVkIHdpdGggdGhlIGdpdmVuIGtleVxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7c3RyaW5nfSBrZXlcbiAqIEByZXR1cm4ge1Byb21pc2U8dm9pZD59XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBTZWN1cmVTdG9yYWdlRGVsZXRlKGtleSkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOlNlY3VyZVN0b3JhZ2VEZWxldGVcIiwgW2tleV0pO1xufVxuIiwKICAgICIvKlxuIF9cdCAgIF9fXHQgIF8gX19cbnwgfFx0IC8gL19fXyBfKF8pIC9fX19fXG58IHwgL3wgLyAvIF9fIGAvIC8gLyBfX18vXG58IHwvIHwvIC8gL18vIC8gLyAoX18gIClcbnxfXy98X18vXFxfXyxfL18vXy9fX19fL1xuVGhlIGVsZWN0cm9uIGFsdGVybmF0aXZlIGZvciBHb1xuKGMpIExlYSBBbnRob255IDIwMTktcHJlc2VudFxuKi9cblxuLyoganNoaW50IGVzdmVyc2lvbjogOSAqL1xuXG5pbXBvcnQge0NhbGx9IGZyb20gXCIuL2NhbGxzXCI7XG5cbi8qKlxuICogUmV0dXJucyB0aGUgc2V0dGluZyB3aXRoIHRoZSBnaXZlbiBrZXksIG9yIG51bGwgaWYgaXQgZG9lc24ndCBleGlzdFxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7c3RyaW5nfSBrZXlcbiAqIEByZXR1cm4ge1Byb21pc2U8YW55Pn1cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFNldHRpbmdzR2V0KGtleSkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOlNldHRpbmdzR2V0XCIsIFtrZXldKTtcbn1cblxuLyoqXG4gKiBTZXRzIHRoZSBzZXR0aW5nIHdpdGggdGhlIGdpdmVuIGtleS4gVGhlIHZhbHVlIG11c3QgYmUgc2VyaWFsaXNhYmxlIHRvIEpTT04uXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IGtleVxuICogQHBhcmFtIHthbnl9IHZhbHVlXG4gKiBAcmV0dXJuIHtQcm9taXNlPHZvaWQ+fVxuICovXG5leHBvcnQgZnVuY3Rpb24gU2V0dGluZ3NTZXQoa2V5LCB2YWx1ZSkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOlNldHRpbmdzU2V0XCIsIFtrZXksIHZhbHVlXSk7XG59XG5cbi8qKlxuICogRGVsZXRlcyB0aGUgc2V0dGluZyB3aXRoIHRoZSBnaXZlbiBrZXlcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge3N0cmluZ30ga2V5XG4gKiBAcmV0dXJuIHtQcm9taXNlPHZvaWQ+fVxuICovXG5leHBvcnQgZnVuY3Rpb24gU2V0dGluZ3NEZWxldGUoa2V5KSB7XG4gICAgcmV0dXJuIENhbGwoXCI6d2FpbHM6U2V0dGluZ3NEZWxldGVcIiwgW2tleV0pO1xufVxuXG4vKipcbiAqIFJldHVybnMgdGhlIHBhdGggb2YgdGhlIGZpbGUgdGhlIHNldHRpbmdzIGFyZSBzYXZlZCB0b1xuICpcbiAqIEBleHBvcnRcbiAqIEByZXR1cm4ge1Byb21pc2U8c3RyaW5nPn1cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFNldHRpbmdzUGF0aCgpIHtcbiAgICByZXR1cm4gQ2FsbChcIjp3YWlsczpTZXR0aW5nc1BhdGhcIik7XG59XG4iLAogICAgIi8qXG4gX1x0ICAgX19cdCAgXyBfX1xufCB8XHQgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuXG4vKiBqc2hpbnQgZXN2ZXJzaW9uOiA5ICovXG5cbmltcG9ydCB7Q2FsbH0gZnJvbSBcIi4vY2FsbHNcIjtcblxuLyoqXG4gKiBSZXBsYWNlcyB0aGUgdGFza3Mgc2hvd24gaW4gdGhlIGp1bXAgbGlzdCBvZiB0aGUgYXBwbGljYXRpb24gb24gV2luZG93cy5cbiAqIExhdW5jaGluZyB0aGUgYXBwbGljYXRpb24gZnJvbSBhIHRhc2sgZW1pdHMgdGhlIGB3YWlsczpqdW1wbGlzdDp0YXNrYCBldmVudCB3aXRoIHRoZSBJRCBvZiB0aGUgdGFzay5cbiAqXG4gKiBAZXhwb3J0XG4gKiBAcGFyYW0ge0p1bXBMaXN0VGFza1tdfSB0YXNrc1xuICogQHJldHVybiB7UHJvbWlzZTx2b2lkPn1cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFNldEp1bXBMaXN0VGFza3ModGFza3MpIHtcbiAgICByZXR1cm4gQ2FsbChcIjp3YWlsczpTZXRKdW1wTGlzdFRhc2tzXCIsIFt0YXNrc10pO1xufVxuXG4vKipcbiAqIEFkZHMgYSBmaWxlIHRvIHRoZSByZWNlbnQgZG9jdW1lbnRzIG9mIHRoZSBhcHBsaWNhdGlvbiwgc2hvd24gaW4gaXRzIGp1bXAgbGlzdCBvbiBXaW5kb3dzXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IHBhdGggLSBhYnNvbHV0ZSBwYXRoIG9mIHRoZSBmaWxlXG4gKiBAcmV0dXJuIHtQcm9taXNlPHZvaWQ+fVxuICovXG5leHBvcnQgZnVuY3Rpb24gQWRkUmVjZW50RG9jdW1lbnQocGF0aCkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOkFkZFJlY2VudERvY3VtZW50XCIsIFtwYXRoXSk7XG59XG4iLAogICAgIi8qXG4gX1x0ICAgX19cdCAgXyBfX1xufCB8XHQgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuXG4vKiBqc2hpbnQgZXN2ZXJzaW9uOiA5ICovXG5cbi8vIFRoZSBjdXJzb3IgaXMgc2V0IGJ5IEdvLCB3aGljaCB1c2VzIHRoZSBuYXRpdmUgY3Vyc29ycyB3aGVyZSBpdCBjYW4uIFdoZXJlIHRoZSB3ZWJ2aWV3IGRyYXdzIHRoZSBjdXJzb3IgaXRzZWxmLFxuLy8gR28gc2V0cyBpdCB3aXRoIGEgc3R5bGVzaGVldCB0aGF0IG92ZXJyaWRlcyB0aGUgcGFnZS5cbmxldCBjdXJzb3JTdHlsZSA9IG51bGw7XG5cbi8qKlxuICogU2V0cyB0aGUgY3Vyc29yIHNob3duIG92ZXIgdGhlIHdob2xlIHdpbmRvdywgb3ZlcnJpZGluZyB0aGUgY3Vyc29ycyBzZXQgYnkgdGhlIHBhZ2UuXG4gKiBUYWtlcyB0aGUgbmFtZSBvZiBhIENTUyBjdXJzb3IsIEVHOiBgY3Jvc3NoYWlyYCBvciBgd2FpdGAuIGBhcnJvd2AsIGBoYW5kYCBhbmQgYGliZWFtYCBhcmUgYWxzbyBhY2NlcHRlZC5cbiAqIEFuIGVtcHR5IG5hbWUgcmVzdG9yZXMgdGhlIGN1cnNvcnMgb2YgdGhlIHBhZ2UuXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IGN1cnNvclxuICovXG5leHBvcnQgZnVuY3Rpb24gU2V0Q3Vyc29yKGN1cnNvcikge1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV0MnICsgKGN1cnNvciB8fCAnJykpO1xufVxuXG4vKipcbiAqIFNldHMgdGhlIGN1cnNvciBzaG93biBvdmVyIHRoZSB3aG9sZSB3aW5kb3cgdG8gYW4gLmljbyBvciAucG5nIGltYWdlXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IHVybCAtIFVSTCBvZiB0aGUgaW1hZ2UsIEVHOiBhIGRhdGEgVVJMXG4gKiBAcGFyYW0ge251bWJlcn0gaG90c3BvdFhcbiAqIEBwYXJhbSB7bnVtYmVyfSBob3RzcG90WVxuICogQHJldHVybiB7UHJvbWlzZTx2b2lkPn1cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFNldEN1c3RvbUN1cnNvcih1cmwsIGhvdHNwb3RYLCBob3RzcG90WSkge1xuICAgIHJldHVybiBmZXRjaCh1cmwpXG4gICAgICAgIC50aGVuKChyZXNwb25zZSkgPT4ge1xuICAgICAgICAgICAgaWYgKCFyZXNwb25zZS5vaykge1xuICAgICAgICAgICAgICAgIHRocm93IG5ldyBFcnJvcignVW5hYmxlIHRvIGxvYWQgdGhlIGN1cnNvciAnICsgdXJsICsgJzogJyArIHJlc3BvbnNlLnN0YXR1cyk7XG4gICAgICAgICAgICB9XG4gICAgICAgICAgICByZXR1cm4gcmVzcG9uc2UuYmxvYigpO1xuICAgICAgICB9KVxuICAgICAgICAudGhlbigoYmxvYikgPT4gbmV3IFByb21pc2UoKHJlc29sdmUsIHJlamVjdCkgPT4ge1xuICAgICAgICAgICAgY29uc3QgcmVhZGVyID0gbmV3IEZpbGVSZWFkZXIoKTtcbiAgICAgICAgICAgIHJlYWRlci5vbmxvYWQgPSAoKSA9PiByZXNvbHZlKHJlYWRlci5yZXN1bHQuc2xpY2UocmVhZGVyLnJlc3VsdC5pbmRleE9mKCcsJykgKyAxKSk7XG4gICAgICAgICAgICByZWFkZXIub25lcnJvciA9ICgpID0+IHJlamVjdChyZWFkZXIuZXJyb3IpO1xuICAgICAgICAgICAgcmVhZGVyLnJlYWRBc0RhdGFVUkwoYmxvYik7XG4gICAgICAgIH0pKVxuICAgICAgICAudGhlbigoaW1hZ2UpID0+IHtcbiAgICAgICAgICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV1gnICsgSlNPTi5zdHJpbmdpZnkoe2ltYWdlLCBob3RzcG90WDogaG90c3BvdFggfHwgMCwgaG90c3BvdFk6IGhvdHNwb3RZIHx8IDB9KSk7XG4gICAgICAgIH0pO1xufVxuXG4vKipcbiAqIEhpZGVzIHRoZSBjdXJzb3Igd2hpbGUgaXQgaXMgb3ZlciB0aGUgd2luZG93XG4gKlxuICogQGV4cG9ydFxuICovXG5leHBvcnQgZnVuY3Rpb24gSGlkZUN1cnNvcigpIHtcbiAgICB3aW5kb3cuV2FpbHNJbnZva2UoJ1dLMScpO1xufVxuXG4vKipcbiAqIFNob3dzIHRoZSBjdXJzb3IgYWdhaW4gYWZ0ZXIgSGlkZUN1cnNvclxuICpcbiAqIEBleHBvcnRcbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIFNob3dDdXJzb3IoKSB7XG4gICAgd2luZG93LldhaWxzSW52b2tlKCdXSzAnKTtcbn1cblxuLyoqXG4gKiBTZXRzIHRoZSBDU1MgY3Vyc29yIG92ZXJyaWRpbmcgdGhlIGN1cnNvcnMgb2YgdGhlIHBhZ2UuIENhbGxlZCBieSBHbywgc28gaXQgaXNuJ3QgcGFydCBvZiB0aGUgSlMgcnVudGltZS5cbiAqIEFuIGVtcHR5IGN1cnNvciByZXN0b3JlcyB0aGUgY3Vyc29ycyBvZiB0aGUgcGFnZS5cbiAqXG4gKiBAcGFyYW0ge3N0cmluZ30gY3Vyc29yXG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBTZXRDdXJzb3JTdHlsZShjdXJzb3IpIHtcbiAgICBpZiAoY3Vyc29yU3R5bGUgPT09IG51bGwpIHtcbiAgICAgICAgaWYgKCFjdXJzb3IpIHtcbiAgICAgICAgICAgIHJldHVybjtcbiAgICAgICAgfVxuICAgICAgICBjdXJzb3JTdHlsZSA9IGRvY3VtZW50LmNyZWF0ZUVsZW1lbnQoJ3N0eWxlJyk7XG4gICAgICAgIChkb2N1bWVudC5oZWFkIHx8IGRvY3VtZW50LmRvY3VtZW50RWxlbWVudCkuYXBwZW5kQ2hpbGQoY3Vyc29yU3R5bGUpO1xuICAgIH1cbiAgICBjdXJzb3JTdHlsZS50ZXh0Q29udGVudCA9IGN1cnNvciA/ICcqLCAqOjpiZWZvcmUsICo6OmFmdGVyIHsgY3Vyc29yOiAnICsgY3Vyc29yICsgJyAhaW1wb3J0YW50OyB9JyA6ICcnO1xufVxuIiwKICAgICIvKlxuIF9cdCAgIF9fXHQgIF8gX19cbnwgfFx0IC8gL19fXyBfKF8pIC9fX19fXG58IHwgL3wgLyAvIF9fIGAvIC8gLyBfX18vXG58IHwvIHwvIC8gL18vIC8gLyAoX18gIClcbnxfXy98X18vXFxfXyxfL18vXy9fX19fL1xuVGhlIGVsZWN0cm9uIGFsdGVybmF0aXZlIGZvciBHb1xuKGMpIExlYSBBbnRob255IDIwMTktcHJlc2VudFxuKi9cblxuLyoganNoaW50IGVzdmVyc2lvbjogOSAqL1xuXG5pbXBvcnQge0V2ZW50c0VtaXR9IGZyb20gJy4vZXZlbnRzJztcblxuLy8gSW5wdXQgZXZlbnRzIGFyZSBmb3J3YXJkZWQgYXQgbW9zdCBvbmNlIHBlciBmcmFtZSwgc28gbW92aW5nIGEgZmluZ2VyIG9yIHNwaW5uaW5nIGEgd2hlZWwgZG9lc24ndCBmbG9vZCB0aGUgYXBwXG5sZXQgZW5hYmxlZCA9IHt3aGVlbDogZmFsc2UsIHRvdWNoOiBmYWxzZSwgZ2VzdHVyZTogZmFsc2V9O1xubGV0IHBlbmRpbmdXaGVlbCA9IG51bGw7XG5sZXQgcGVuZGluZ1RvdWNoTW92ZSA9IG51bGw7XG5sZXQgZnJhbWVSZXF1ZXN0ZWQgPSBmYWxzZTtcblxuZnVuY3Rpb24gZmx1c2goKSB7XG4gICAgZnJhbWVSZXF1ZXN0ZWQgPSBmYWxzZTtcbiAgICBpZiAocGVuZGluZ1doZWVsICE9PSBudWxsKSB7XG4gICAgICAgIEV2ZW50c0VtaXQoJ3dhaWxzOmlucHV0OndoZWVsJywgcGVuZGluZ1doZWVsKTtcbiAgICAgICAgcGVuZGluZ1doZWVsID0gbnVsbDtcbiAgICB9XG4gICAgaWYgKHBlbmRpbmdUb3VjaE1vdmUgIT09IG51bGwpIHtcbiAgICAgICAgRXZlbnRzRW1pdCgnd2FpbHM6aW5wdXQ6dG91Y2gnLCBwZW5kaW5nVG91Y2hNb3ZlKTtcbiAgICAgICAgcGVuZGluZ1RvdWNoTW92ZSA9IG51bGw7XG4gICAgfVxufVxuXG5mdW5jdGlvbiByZXF1ZXN0Rmx1c2goKSB7XG4gICAgaWYgKCFmcmFtZVJlcXVlc3RlZCkge1xuICAgICAgICBmcmFtZVJlcXVlc3RlZCA9IHRydWU7XG4gICAgICAgIHdpbmRvdy5yZXF1ZXN0QW5pbWF0aW9uRnJhbWUoZmx1c2gpO1xuICAgIH1cbn1cblxuZnVuY3Rpb24gb25XaGVlbChlKSB7XG4gICAgaWYgKHBlbmRpbmdXaGVlbCA9PT0gbnVsbCkge1xuICAgICAgICBwZW5kaW5nV2hlZWwgPSB7ZGVsdGFYOiAwLCBkZWx0YVk6IDAsIGRlbHRhWjogMH07XG4gICAgfVxuICAgIHBlbmRpbmdXaGVlbC5kZWx0YVggKz0gZS5kZWx0YVg7XG4gICAgcGVuZGluZ1doZWVsLmRlbHRhWSArPSBlLmRlbHRhWTtcbiAgICBwZW5kaW5nV2hlZWwuZGVsdGFaICs9IGUuZGVsdGFaO1xuICAgIHBlbmRpbmdXaGVlbC5kZWx0YU1vZGUgPSBlLmRlbHRhTW9kZTtcbiAgICBwZW5kaW5nV2hlZWwueCA9IGUuY2xpZW50WDtcbiAgICBwZW5kaW5nV2hlZWwueSA9IGUuY2xpZW50WTtcbiAgICBwZW5kaW5nV2hlZWwuY3RybEtleSA9IGUuY3RybEtleTtcbiAgICBwZW5kaW5nV2hlZWwuc2hpZnRLZXkgPSBlLnNoaWZ0S2V5O1xuICAgIHBlbmRpbmdXaGVlbC5hbHRLZXkgPSBlLmFsdEtleTtcbiAgICBwZW5kaW5nV2hlZWwubWV0YUtleSA9IGUubWV0YUtleTtcbiAgICByZXF1ZXN0Rmx1c2goKTtcbn1cblxuZnVuY3Rpb24gdG91Y2hMaXN0KHRvdWNoZXMpIHtcbiAgICByZXR1cm4gQXJyYXkucHJvdG90eXBlLm1hcC5jYWxsKHRvdWNoZXMsICh0b3VjaCkgPT4gKHtcbiAgICAgICAgaWQ6IHRvdWNoLmlkZW50aWZpZXIsXG4gICAgICAgIHg6IHRvdWNoLmNsaWVudFgsXG4gICAgICAgIHk6IHRvdWNoLmNsaWVudFksXG4gICAgICAgIGZvcmNlOiB0b3VjaC5mb3JjZSxcbiAgICB9KSk7XG59XG5cbmZ1bmN0aW9uIHRvdWNoRGF0YSh0eXBlLCBlKSB7XG4gICAgcmV0dXJuIHtcbiAgICAgICAgdHlwZTogdHlwZSxcbiAgICAgICAgdG91Y2hlczogdG91Y2hMaXN0KGUudG91Y2hlcyksXG4gICAgICAgIGNoYW5nZWQ6IHRvdWNoTGlzdChlLmNoYW5nZWRUb3VjaGVzKSxcbiAgICB9O1xufVxuXG5mdW5jdGlvbiBvblRvdWNoKGUpIHtcbiAgICBjb25zdCB0eXBlID0gZS50eXBlLnN1YnN0cmluZygndG91Y2gnLmxlbmd0aCk7XG4gICAgaWYgKHR5cGUgPT09ICdtb3ZlJykge1xuICAgICAgICBwZW5kaW5nVG91Y2hNb3ZlID0gdG91Y2hEYXRhKHR5cGUsIGUpO1xuICAgICAgICByZXF1ZXN0Rmx1c2goKTtcbiAgICAgICAgcmV0dXJuO1xuICAgIH1cbiAgICAvLyBTZW5kIGFueSBwZW5kaW5nIG1vdmUgZmlyc3QsIHNvIHRoZSBldmVudHMgc3RheSBpbiBvcmRlclxuICAgIGZsdXNoKCk7XG4gICAgRXZlbnRzRW1pdCgnd2FpbHM6aW5wdXQ6dG91Y2gnLCB0b3VjaERhdGEodHlwZSwgZSkpO1xufVxuXG4vLyBHZXN0dXJlIGV2ZW50cyBhcmUgb25seSBzZW50IGJ5IFdlYktpdCBvbiBtYWNPU1xuZnVuY3Rpb24gb25HZXN0dXJlKGUpIHtcbiAgICBFdmVudHNFbWl0KCd3YWlsczppbnB1dDpnZXN0dXJlJywge1xuICAgICAgICB0eXBlOiBlLnR5cGUuc3Vic3RyaW5nKCdnZXN0dXJlJy5sZW5ndGgpLFxuICAgICAgICBzY2FsZTogZS5zY2FsZSxcbiAgICAgICAgcm90YXRpb246IGUucm90YXRpb24sXG4gICAgICAgIHg6IGUuY2xpZW50WCxcbiAgICAgICAgeTogZS5jbGllbnRZLFxuICAgIH0pO1xufVxuXG5mdW5jdGlvbiBsaXN0ZW4obmFtZXMsIGxpc3RlbmVyLCBlbmFibGUpIHtcbiAgICBuYW1lcy5mb3JFYWNoKChuYW1lKSA9PiB7XG4gICAgICAgIGlmIChlbmFibGUpIHtcbiAgICAgICAgICAgIHdpbmRvdy5hZGRFdmVudExpc3RlbmVyKG5hbWUsIGxpc3RlbmVyLCB7Y2FwdHVyZTogdHJ1ZSwgcGFzc2l2ZTogdHJ1ZX0pO1xuICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgICAgd2luZG93LnJlbW92ZUV2ZW50TGlzdGVuZXIobmFtZSwgbGlzdGVuZXIsIHtjYXB0dXJlOiB0cnVlfSk7XG4gICAgICAgIH1cbiAgICB9KTtcbn1cblxuLyoqXG4gKiBTZXRzIHdoaWNoIGlucHV0IGV2ZW50cyBhcmUgZm9yd2FyZGVkIGFzIHRoZSBgd2FpbHM6aW5wdXQ6d2hlZWxgLCBgd2FpbHM6aW5wdXQ6dG91Y2hgIGFuZFxuICogYHdhaWxzOmlucHV0Omdlc3R1cmVgIGV2ZW50cywgd2hpY2ggR28gY2FuIGxpc3RlbiB0b1xuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7e3doZWVsPzogYm9vbGVhbiwgdG91Y2g/OiBib29sZWFuLCBnZXN0dXJlPzogYm9vbGVhbn19IGV2ZW50c1xuICovXG5leHBvcnQgZnVuY3Rpb24gU2V0SW5wdXRFdmVudHMoZXZlbnRzKSB7XG4gICAgZXZlbnRzID0gZXZlbnRzIHx8IHt9O1xuICAgIHdpbmRvdy5XYWlsc0ludm9rZSgnV0cnICsgSlNPTi5zdHJpbmdpZnkoe3doZWVsOiAhIWV2ZW50cy53aGVlbCwgdG91Y2g6ICEhZXZlbnRzLnRvdWNoLCBnZXN0dXJlOiAhIWV2ZW50cy5nZXN0dXJlfSkpO1xufVxuXG4vLyBTZXRQYWdlSW5wdXRFdmVudHMgc2V0cyB3aGljaCBpbnB1dCBldmVudHMgYXJlIGZvcndhcmRlZCBmcm9tIHRoZSBwYWdlLiBJdCBpcyBjYWxsZWQgYnkgR28sIHdoaWNoIGZvcndhcmRzXG4vLyB0aGUgZXZlbnRzIGl0IGNhbiByZWFkIG5hdGl2ZWx5IGl0c2VsZi5cbmV4cG9ydCBmdW5jdGlvbiBTZXRQYWdlSW5wdXRFdmVudHMoZXZlbnRzKSB7XG4gICAgZXZlbnRzID0gZXZlbnRzIHx8IHt9O1xuICAgIGNvbnN0IHdoZWVsID0gISFldmVudHMud2hlZWwsIHRvdWNoID0gISFldmVudHMudG91Y2gsIGdlc3R1cmUgPSAhIWV2ZW50cy5nZXN0dXJlO1xuICAgIGlmICh3aGVlbCAhPT0gZW5hYmxlZC53aGVlbCkge1xuICAgICAgICBsaXN0ZW4oWyd3aGVlbCddLCBvbldoZWVsLCB3aGVlbCk7XG4gICAgfVxuICAgIGlmICh0b3VjaCAhPT0gZW5hYmxlZC50b3VjaCkge1xuICAgICAgICBsaXN0ZW4oWyd0b3VjaHN0YXJ0JywgJ3RvdWNobW92ZScsICd0b3VjaGVuZCcsICd0b3VjaGNhbmNlbCddLCBvblRvdWNoLCB0b3VjaCk7XG4gICAgfVxuICAgIGlmIChnZXN0dXJlICE9PSBlbmFibGVkLmdlc3R1cmUpIHtcbiAgICAgICAgbGlzdGVuKFsnZ2VzdHVyZXN0YXJ0JywgJ2dlc3R1cmVjaGFuZ2UnLCAnZ2VzdHVyZWVuZCddLCBvbkdlc3R1cmUsIGdlc3R1cmUpO1xuICAgIH1cbiAgICBlbmFibGVkID0ge3doZWVsLCB0b3VjaCwgZ2VzdHVyZX07XG59XG4iLAogICAgIi8qXG4gX1x0ICAgX19cdCAgXyBfX1xufCB8XHQgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuXG4vKiBqc2hpbnQgZXN2ZXJzaW9uOiA5ICovXG5cbmltcG9ydCB7Q2FsbH0gZnJvbSBcIi4vY2FsbHNcIjtcblxuLyoqXG4gKiBSZXR1cm5zIHRoZSBoYXNoIG9mIHRoZSBmcm9udGVuZCBhc3NldHMgZW1iZWRkZWQgaW4gdGhlIGFwcGxpY2F0aW9uLCB3aGljaCBjaGFuZ2VzIHdoZW5ldmVyIHRoZSBhc3NldHMgY2hhbmdlXG4gKlxuICogQGV4cG9ydFxuICogQHJldHVybiB7UHJvbWlzZTxzdHJpbmc+fVxuICovXG5leHBvcnQgZnVuY3Rpb24gQXNzZXRzSGFzaCgpIHtcbiAgICByZXR1cm4gQ2FsbChcIjp3YWlsczpBc3NldHNIYXNoXCIpO1xufVxuIiwKICAgICIvKlxuIF9cdCAgIF9fXHQgIF8gX19cbnwgfFx0IC8gL19fXyBfKF8pIC9fX19fXG58IHwgL3wgLyAvIF9fIGAvIC8gLyBfX18vXG58IHwvIHwvIC8gL18vIC8gLyAoX18gIClcbnxfXy98X18vXFxfXyxfL18vXy9fX19fL1xuVGhlIGVsZWN0cm9uIGFsdGVybmF0aXZlIGZvciBHb1xuKGMpIExlYSBBbnRob255IDIwMTktcHJlc2VudFxuKi9cblxuLyoganNoaW50IGVzdmVyc2lvbjogOSAqL1xuXG5pbXBvcnQge0NhbGx9IGZyb20gXCIuL2NhbGxzXCI7XG5cbi8qKlxuICogUmV0dXJucyB0aGUgY29tbWFuZC1saW5lIGFyZ3VtZW50cyB0aGUgYXBwbGljYXRpb24gd2FzIGxhdW5jaGVkIHdpdGgsIHdpdGhvdXQgdGhlIG5hbWUgb2YgdGhlIGV4ZWN1dGFibGVcbiAqXG4gKiBAZXhwb3J0XG4gKiBAcmV0dXJuIHtQcm9taXNlPHN0cmluZ1tdPn1cbiAqL1xuZXhwb3J0IGZ1bmN0aW9uIEFyZ3MoKSB7XG4gICAgcmV0dXJuIENhbGwoXCI6d2FpbHM6QXJnc1wiKTtcbn1cbiIsCiAgICAiLypcbiBfXHQgICBfX1x0ICBfIF9fXG58IHxcdCAvIC9fX18gXyhfKSAvX19fX1xufCB8IC98IC8gLyBfXyBgLyAvIC8gX19fL1xufCB8LyB8LyAvIC9fLyAvIC8gKF9fICApXG58X18vfF9fL1xcX18sXy9fL18vX19fXy9cblRoZSBlbGVjdHJvbiBhbHRlcm5hdGl2ZSBmb3IgR29cbihjKSBMZWEgQW50aG9ueSAyMDE5LXByZXNlbnRcbiovXG5cbi8qIGpzaGludCBlc3ZlcnNpb246IDkgKi9cblxuaW1wb3J0IHtDYWxsfSBmcm9tIFwiLi9jYWxsc1wiO1xuXG4vKipcbiAqIFJldHVybnMgdHJ1ZSBpZiB0aGUgYXBwbGljYXRpb24gaXMgcnVubmluZyB3aXRoIGFkbWluaXN0cmF0b3IgcmlnaHRzLCBvciBhcyByb290IG9uIG1hY09TIGFuZCBMaW51eFxuICpcbiAqIEBleHBvcnRcbiAqIEByZXR1cm4ge1Byb21pc2U8Ym9vbGVhbj59XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBJc0VsZXZhdGVkKCkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOklzRWxldmF0ZWRcIik7XG59XG5cbi8qKlxuICogUmVzdGFydHMgdGhlIGFwcGxpY2F0aW9uIHdpdGggYWRtaW5pc3RyYXRvciByaWdodHMgYW5kIHRoZSBzYW1lIGFyZ3VtZW50cywgb25jZSB0aGUgdXNlciBoYXMgYWNjZXB0ZWQgdGhlIFVBQyBwcm9tcHQuXG4gKiBUaGUgcHJvbWlzZSBpcyByZWplY3RlZCwgYW5kIHRoZSBhcHBsaWNhdGlvbiBrZWVwcyBydW5uaW5nLCBpZiB0aGUgcHJvbXB0IGlzIGNhbmNlbGxlZC4gT25seSBzdXBwb3J0ZWQgb24gV2luZG93cy5cbiAqXG4gKiBAZXhwb3J0XG4gKiBAcmV0dXJuIHtQcm9taXNlPHZvaWQ+fVxuICovXG5leHBvcnQgZnVuY3Rpb24gUmVsYXVuY2hFbGV2YXRlZCgpIHtcbiAgICByZXR1cm4gQ2FsbChcIjp3YWlsczpSZWxhdW5jaEVsZXZhdGVkXCIpO1xufVxuIiwKICAgICIvKlxuIF9cdCAgIF9fXHQgIF8gX19cbnwgfFx0IC8gL19fXyBfKF8pIC9fX19fXG58IHwgL3wgLyAvIF9fIGAvIC8gLyBfX18vXG58IHwvIHwvIC8gL18vIC8gLyAoX18gIClcbnxfXy98X18vXFxfXyxfL18vXy9fX19fL1xuVGhlIGVsZWN0cm9uIGFsdGVybmF0aXZlIGZvciBHb1xuKGMpIExlYSBBbnRob255IDIwMTktcHJlc2VudFxuKi9cblxuLyoganNoaW50IGVzdmVyc2lvbjogOSAqL1xuXG5pbXBvcnQge0NhbGx9IGZyb20gXCIuL2NhbGxzXCI7XG5cbi8qKlxuICogQ2FuY2VscyBhIGRvd25sb2FkIG9mIHRoZSB3ZWJ2aWV3IG9uIFdpbmRvd3MuIFRoZSBJRCBvZiB0aGUgZG93bmxvYWQgaXMgZ2l2ZW4gYnkgdGhlIGB3YWlsczpkb3dubG9hZDoqYCBldmVudHMuXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmd9IGlkXG4gKiBAcmV0dXJuIHtQcm9taXNlPHZvaWQ+fVxuICovXG5leHBvcnQgZnVuY3Rpb24gRG93bmxvYWRDYW5jZWwoaWQpIHtcbiAgICByZXR1cm4gQ2FsbChcIjp3YWlsczpEb3dubG9hZENhbmNlbFwiLCBbaWRdKTtcbn1cbiIsCiAgICAiLypcbiBfXHQgICBfX1x0ICBfIF9fXG58IHxcdCAvIC9fX18gXyhfKSAvX19fX1xufCB8IC98IC8gLyBfXyBgLyAvIC8gX19fL1xufCB8LyB8LyAvIC9fLyAvIC8gKF9fICApXG58X18vfF9fL1xcX18sXy9fL18vX19fXy9cblRoZSBlbGVjdHJvbiBhbHRlcm5hdGl2ZSBmb3IgR29cbihjKSBMZWEgQW50aG9ueSAyMDE5LXByZXNlbnRcbiovXG5cbi8qIGpzaGludCBlc3ZlcnNpb246IDkgKi9cblxuaW1wb3J0IHtDYWxsfSBmcm9tIFwiLi9jYWxsc1wiO1xuXG4vKipcbiAqIFJldHVybnMgdGhlIGltYWdlIG9uIHRoZSBjbGlwYm9hcmQgYXMgYmFzZTY0IGVuY29kZWQgUE5HIGRhdGEsIG9yIG51bGwgaWYgdGhlIGNsaXBib2FyZCBob2xkcyBubyBpbWFnZS5cbiAqIE9ubHkgc3VwcG9ydGVkIG9uIFdpbmRvd3MuXG4gKlxuICogQGV4cG9ydFxuICogQHJldHVybiB7UHJvbWlzZTxzdHJpbmd8bnVsbD59XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBDbGlwYm9hcmRHZXRJbWFnZSgpIHtcbiAgICByZXR1cm4gQ2FsbChcIjp3YWlsczpDbGlwYm9hcmRHZXRJbWFnZVwiLCBbXSk7XG59XG5cbi8qKlxuICogUmVwbGFjZXMgdGhlIGNvbnRlbnRzIG9mIHRoZSBjbGlwYm9hcmQgd2l0aCBhbiBpbWFnZSwgZ2l2ZW4gYXMgYmFzZTY0IGVuY29kZWQgUE5HIGRhdGEuXG4gKiBPbmx5IHN1cHBvcnRlZCBvbiBXaW5kb3dzLlxuICpcbiAqIEBleHBvcnRcbiAqIEBwYXJhbSB7c3RyaW5nfSBkYXRhXG4gKiBAcmV0dXJuIHtQcm9taXNlPHZvaWQ+fVxuICovXG5leHBvcnQgZnVuY3Rpb24gQ2xpcGJvYXJkU2V0SW1hZ2UoZGF0YSkge1xuICAgIHJldHVybiBDYWxsKFwiOndhaWxzOkNsaXBib2FyZFNldEltYWdlXCIsIFtkYXRhXSk7XG59XG4iLAogICAgIi8qXG4gX1x0ICAgX19cdCAgXyBfX1xufCB8XHQgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuXG4vKiBqc2hpbnQgZXN2ZXJzaW9uOiA5ICovXG5cbmltcG9ydCB7Q2FsbH0gZnJvbSBcIi4vY2FsbHNcIjtcblxuLyoqXG4gKiBEcmFncyBmaWxlcyBmcm9tIHRoZSB3aW5kb3cgdG8gb3RoZXIgYXBwbGljYXRpb25zIG9uIFdpbmRvd3MsIEVHOiB0byBFeHBsb3Jlci5cbiAqIEl0IG11c3QgYmUgY2FsbGVkIHdoaWxlIHRoZSBsZWZ0IG1vdXNlIGJ1dHRvbiBpcyBoZWxkIGRvd24sIHN1Y2ggYXMgZnJvbSBhIGBtb3VzZWRvd25gIGV2ZW50IGhhbmRsZXIuXG4gKlxuICogQGV4cG9ydFxuICogQHBhcmFtIHtzdHJpbmdbXX0gZmlsZXMgLSBhYnNvbHV0ZSBwYXRocyBvZiB0aGUgZmlsZXNcbiAqIEByZXR1cm4ge1Byb21pc2U8dm9pZD59XG4gKi9cbmV4cG9ydCBmdW5jdGlvbiBTdGFydERyYWcoZmlsZXMpIHtcbiAgICByZXR1cm4gQ2FsbChcIjp3YWlsczpTdGFydERyYWdcIiwgW2ZpbGVzXSk7XG59XG4iLAogICAgIi8qXG4gX1x0ICAgX19cdCAgXyBfX1xufCB8XHQgLyAvX19fIF8oXykgL19fX19cbnwgfCAvfCAvIC8gX18gYC8gLyAvIF9fXy9cbnwgfC8gfC8gLyAvXy8gLyAvIChfXyAgKVxufF9fL3xfXy9cXF9fLF8vXy9fL19fX18vXG5UaGUgZWxlY3Ryb24gYWx0ZXJuYXRpdmUgZm9yIEdvXG4oYykgTGVhIEFudGhvbnkgMjAxOS1wcmVzZW50XG4qL1xuLyoganNoaW50IGVzdmVyc2lvbjogOSAqL1xuaW1wb3J0ICogYXMgTG9nIGZyb20gJy4vbG9nJztcbmltcG9ydCB7ZXZlbnRMaXN0ZW5lcnMsIEV2ZW50c0VtaXQsIEV2ZW50c05vdGlmeSwgRXZlbnRzT2ZmLCBFdmVudHNPbiwgRXZlbnRzT25jZSwgRXZlbnRzT25NdWx0aXBsZX0gZnJvbSAnLi9ldmVudHMnO1xuaW1wb3J0IHtDYWxsYmFjaywgQ2FsbENhbmNlbCwgY2FsbGJhY2tzfSBmcm9tICcuL2NhbGxzJztcbmltcG9ydCB7U2V0QmluZGluZ3N9IGZyb20gXCIuL2JpbmRpbmdzXCI7XG5pbXBvcnQgKiBhcyBXaW5kb3cgZnJvbSBcIi4vd2luZG93XCI7XG5pbXBvcnQgKiBhcyBCcm93c2VyIGZyb20gXCIuL2Jyb3dzZXJcIjtcbmltcG9ydCAqIGFzIFNlY3VyZVN0b3JhZ2UgZnJvbSBcIi4vc2VjdXJlc3RvcmFnZVwiO1xuaW1wb3J0ICogYXMgU2V0dGluZ3MgZnJvbSBcIi4vc2V0dGluZ3NcIjtcbmltcG9ydCAqIGFzIEp1bXBMaXN0IGZyb20gXCIuL2p1bXBsaXN0XCI7XG5pbXBvcnQgKiBhcyBDdXJzb3IgZnJvbSBcIi4vY3Vyc29yXCI7XG5pbXBvcnQgKiBhcyBJbnB1dCBmcm9tIFwiLi9pbnB1dFwiO1xuaW1wb3J0ICogYXMgQXNzZXRzIGZyb20gXCIuL2Fzc2V0c1wiO1xuaW1wb3J0ICogYXMgQXJncyBmcm9tIFwiLi9hcmdzXCI7XG5pbXBvcnQgKiBhcyBFbGV2YXRpb24gZnJvbSBcIi4vZWxldmF0aW9uXCI7XG5pbXBvcnQgKiBhcyBEb3dubG9hZHMgZnJvbSBcIi4vZG93bmxvYWRzXCI7XG5pbXBvcnQgKiBhcyBDbGlwYm9hcmQgZnJvbSBcIi4vY2xpcGJvYXJkXCI7XG5pbXBvcnQgKiBhcyBEcmFnRHJvcCBmcm9tIFwiLi9kcmFnZHJvcFwiO1xuXG5cbmV4cG9ydCBmdW5jdGlvbiBRdWl0KGV4aXRDb2RlKSB7XG4gICAgLy8gVGhlIHByb2Nlc3MgZXhpdHMgd2l0aCB0aGUgY29kZSBvbmNlIHRoZSBhcHBsaWNhdGlvbiBoYXMgc2h1dCBkb3duXG4gICAgd2luZG93LldhaWxzSW52b2tlKCdRJyArIChOdW1iZXIuaXNJbnRlZ2VyKGV4aXRDb2RlKSA/IGV4aXRDb2RlIDogJycpKTtcbn1cblxuLy8gU2V0Q3Vyc29yU3R5bGUgYW5kIFNldFBhZ2VJbnB1dEV2ZW50cyBhcmUgaW50ZXJuYWwgZW5kcG9pbnRzXG5jb25zdCB7U2V0Q3Vyc29yU3R5bGUsIC4uLkN1cnNvclJ1bnRpbWV9ID0gQ3Vyc29yO1xuY29uc3Qge1NldFBhZ2VJbnB1dEV2ZW50cywgLi4uSW5wdXRSdW50aW1lfSA9IElucHV0O1xuXG4vLyBUaGUgSlMgcnVudGltZVxud2luZG93LnJ1bnRpbWUgPSB7XG4gICAgLi4uTG9nLFxuICAgIC4uLldpbmRvdyxcbiAgICAuLi5Ccm93c2VyLFxuICAgIC4uLlNlY3VyZVN0b3JhZ2UsXG4gICAgLi4uU2V0dGluZ3MsXG4gICAgLi4uSnVtcExpc3QsXG4gICAgLi4uQ3Vyc29yUnVudGltZSxcbiAgICAuLi5JbnB1dFJ1bnRpbWUsXG4gICAgLi4uQXNzZXRzLFxuICAgIC4uLkFyZ3MsXG4gICAgLi4uRWxldmF0aW9uLFxuICAgIC4uLkRvd25sb2FkcyxcbiAgICAuLi5DbGlwYm9hcmQsXG4gICAgLi4uRHJhZ0Ryb3AsXG4gICAgRXZlbnRzT24sXG4gICAgRXZlbnRzT25jZSxcbiAgICBFdmVudHNPbk11bHRpcGxlLFxuICAgIEV2ZW50c0VtaXQsXG4gICAgRXZlbnRzT2ZmLFxuICAgIENhbGxDYW5jZWwsXG4gICAgUXVpdFxufTtcblxuLy8gSW50ZXJuYWwgd2FpbHMgZW5kcG9pbnRzXG53aW5kb3cud2FpbHMgPSB7XG4gICAgQ2FsbGJhY2ssXG4gICAgRXZlbnRzTm90aWZ5LFxuICAgIFNldEJpbmRpbmdzLFxuICAgIFNldEN1cnNvclN0eWxlLFxuICAgIFNldFBhZ2VJbnB1dEV2ZW50cyxcbiAgICBldmVudExpc3RlbmVycyxcbiAgICBjYWxsYmFja3MsXG4gICAgZmxhZ3M6IHtcbiAgICAgICAgZGlzYWJsZVNjcm9sbGJhckRyYWc6IGZhbHNlLFxuICAgICAgICBkaXNhYmxlV2FpbHNEZWZhdWx0Q29udGV4dE1lbnU6IGZhbHNlLFxuICAgICAgICBlbmFibGVSZXNpemU6IGZhbHNlLFxuICAgICAgICBkZWZhdWx0Q3Vyc29yOiBudWxsLFxuICAgICAgICBib3JkZXJUaGlja25lc3M6IDZcbiAgICB9XG59O1xuXG4vLyBTZXQgdGhlIGJpbmRpbmdzXG53aW5kb3cud2FpbHMuU2V0QmluZGluZ3Mod2luZG93LndhaWxzYmluZGluZ3MpO1xuXG4vLyBUaGlzIGlzIGV2YWx1YXRlZCBhdCBidWlsZCB0aW1lIGluIHBhY2thZ2UuanNvblxuLy8gY29uc3QgZGV2ID0gMDtcbi8vIGNvbnN0IHByb2R1Y3Rpb24gPSAxO1xuaWYgKEVOViA9PT0gMCkge1xuICAgIGRlbGV0ZSB3aW5kb3cud2FpbHNiaW5kaW5ncztcbn0gZWxzZSB7XG4gICAgLy8gVGhlIGJpbmRpbmdzIGFyZSBvbmx5IHVwZGF0ZWQgYWZ0ZXIgYSByZWJ1aWxkIGluIGRldiBtb2RlXG4gICAgZGVsZXRlIHdpbmRvdy53YWlscy5TZXRCaW5kaW5ncztcbn1cblxuLy8gU2V0dXAgZHJhZyBoYW5kbGVyXG4vLyBCYXNlZCBvbiBjb2RlIGZyb206IGh0dHBzOi8vZ2l0aHViLmNvbS9wYXRyMG51cy9EZXNrR2FwXG53aW5kb3cuYWRkRXZlbnRMaXN0ZW5lcignbW91c2Vkb3duJywgKGUpID0+IHtcblxuICAgIC8vIENoZWNrIGZvciByZXNpemluZ1xuICAgIGlmICh3aW5kb3cud2FpbHMuZmxhZ3MucmVzaXplRWRnZSkge1xuICAgICAgICB3aW5kb3cuV2FpbHNJbnZva2UoXCJyZXNpemU6XCIgKyB3aW5kb3cud2FpbHMuZmxhZ3MucmVzaXplRWRnZSk7XG4gICAgICAgIGUucHJldmVudERlZmF1bHQoKTtcbiAgICAgICAgcmV0dXJuO1xuICAgIH1cblxuICAgIC8vIENoZWNrIGZvciBkcmFnZ2luZ1xuICAgIGxldCBjdXJyZW50RWxlbWVudCA9IGUudGFyZ2V0O1xuICAgIHdoaWxlIChjdXJyZW50RWxlbWVudCAhPSBudWxsKSB7XG4gICAgICAgIGlmIChjdXJyZW50RWxlbWVudC5oYXNBdHRyaWJ1dGUoJ2RhdGEtd2FpbHMtbm8tZHJhZycpKSB7XG4gICAgICAgICAgICBicmVhaztcbiAgICAgICAgfSBlbHNlIGlmIChjdXJyZW50RWxlbWVudC5oYXNBdHRyaWJ1dGUoJ2RhdGEtd2FpbHMtZHJhZycpKSB7XG4gICAgICAgICAgICBpZiAod2luZG93LndhaWxzLmZsYWdzLmRpc2FibGVTY3JvbGxiYXJEcmFnKSB7XG4gICAgICAgICAgICAgICAgLy8gVGhpcyBjaGVja3MgZm9yIGNsaWNrcyBvbiB0aGUgc2Nyb2xsIGJhclxuICAgICAgICAgICAgICAgIGlmIChlLm9mZnNldFggPiBlLnRhcmdldC5jbGllbnRXaWR0aCB8fCBlLm9mZnNldFkgPiBlLnRhcmdldC5jbGllbnRIZWlnaHQpIHtcbiAgICAgICAgICAgICAgICAgICAgYnJlYWs7XG4gICAgICAgICAgICAgICAgfVxuICAgICAgICAgICAgfVxuICAgICAgICAgICAgd2luZG93LldhaWxzSW52b2tlKFwiZHJhZ1wiKTtcbiAgICAgICAgICAgIGUucHJldmVudERlZmF1bHQoKTtcbiAgICAgICAgICAgIGJyZWFrO1xuICAgICAgICB9XG4gICAgICAgIGN1cnJlbnRFbGVtZW50ID0gY3VycmVudEVsZW1lbnQucGFyZW50RWxlbWVudDtcbiAgICB9XG59KTtcblxuZnVuY3Rpb24gc2V0UmVzaXplKGN1cnNvcikge1xuICAgIGRvY3VtZW50LmJvZHkuc3R5bGUuY3Vyc29yID0gY3Vyc29yIHx8IHdpbmRvdy53YWlscy5mbGFncy5kZWZhdWx0Q3Vyc29yO1xuICAgIHdpbmRvdy53YWlscy5mbGFncy5yZXNpemVFZGdlID0gY3Vyc29yO1xufVxuXG53aW5kb3cuYWRkRXZlbnRMaXN0ZW5lcignbW91c2Vtb3ZlJywgZnVuY3Rpb24gKGUpIHtcbiAgICBpZiAoIXdpbmRvdy53YWlscy5mbGFncy5lbmFibGVSZXNpemUpIHtcbiAgICAgICAgcmV0dXJuO1xuICAgIH1cbiAgICBpZiAod2luZG93LndhaWxzLmZsYWdzLmRlZmF1bHRDdXJzb3IgPT0gbnVsbCkge1xuICAgICAgICB3aW5kb3cud2FpbHMuZmxhZ3MuZGVmYXVsdEN1cnNvciA9IGRvY3VtZW50LmJvZHkuc3R5bGUuY3Vyc29yO1xuICAgIH1cbiAgICBpZiAod2luZG93Lm91dGVyV2lkdGggLSBlLmNsaWVudFggPCB3aW5kb3cud2FpbHMuZmxhZ3MuYm9yZGVyVGhpY2tuZXNzICYmIHdpbmRvdy5vdXRlckhlaWdodCAtIGUuY2xpZW50WSA8IHdpbmRvdy53YWlscy5mbGFncy5ib3JkZXJUaGlja25lc3MpIHtcbiAgICAgICAgZG9jdW1lbnQuYm9keS5zdHlsZS5jdXJzb3IgPSBcInNlLXJlc2l6ZVwiO1xuICAgIH1cbiAgICBsZXQgcmlnaHRCb3JkZXIgPSB3aW5kb3cub3V0ZXJXaWR0aCAtIGUuY2xpZW50WCA8IHdpbmRvdy53YWlscy5mbGFncy5ib3JkZXJUaGlja25lc3M7XG4gICAgbGV0IGxlZnRCb3JkZXIgPSBlLmNsaWVudFggPCB3aW5kb3cud2FpbHMuZmxhZ3MuYm9yZGVyVGhpY2tuZXNzO1xuICAgIGxldCB0b3BCb3JkZXIgPSBlLmNsaWVudFkgPCB3aW5kb3cud2FpbHMuZmxhZ3MuYm9yZGVyVGhpY2tuZXNzO1xuICAgIGxldCBib3R0b21Cb3JkZXIgPSB3aW5kb3cub3V0ZXJIZWlnaHQgLSBlLmNsaWVudFkgPCB3aW5kb3cud2FpbHMuZmxhZ3MuYm9yZGVyVGhpY2tuZXNzO1xuXG4gICAgLy8gSWYgd2UgYXJlbid0IG9uIGFuIGVkZ2UsIGJ1dCB3ZXJlLCByZXNldCB0aGUgY3Vyc29yIHRvIGRlZmF1bHRcbiAgICBpZiAoIWxlZnRCb3JkZXIgJiYgIXJpZ2h0Qm9yZGVyICYmICF0b3BCb3JkZXIgJiYgIWJvdHRvbUJvcmRlciAmJiB3aW5kb3cud2FpbHMuZmxhZ3MucmVzaXplRWRnZSAhPT0gdW5kZWZpbmVkKSB7XG4gICAgICAgIHNldFJlc2l6ZSgpO1xuICAgIH0gZWxzZSBpZiAocmlnaHRCb3JkZXIgJiYgYm90dG9tQm9yZGVyKSBzZXRSZXNpemUoXCJzZS1yZXNpemVcIik7XG4gICAgZWxzZSBpZiAobGVmdEJvcmRlciAmJiBib3R0b21Cb3JkZXIpIHNldFJlc2l6ZShcInN3LXJlc2l6ZVwiKTtcbiAgICBlbHNlIGlmIChsZWZ0Qm9yZGVyICYmIHRvcEJvcmRlcikgc2V0UmVzaXplKFwibnctcmVzaXplXCIpO1xuICAgIGVsc2UgaWYgKHRvcEJvcmRlciAmJiByaWdodEJvcmRlcikgc2V0UmVzaXplKFwibmUtcmVzaXplXCIpO1xuICAgIGVsc2UgaWYgKGxlZnRCb3JkZXIpIHNldFJlc2l6ZShcInctcmVzaXplXCIpO1xuICAgIGVsc2UgaWYgKHRvcEJvcmRlcikgc2V0UmVzaXplKFwibi1yZXNpemVcIik7XG4gICAgZWxzZSBpZiAoYm90dG9tQm9yZGVyKSBzZXRSZXNpemUoXCJzLXJlc2l6ZVwiKTtcbiAgICBlbHNlIGlmIChyaWdodEJvcmRlcikgc2V0UmVzaXplKFwiZS1yZXNpemVcIik7XG5cbn0pO1xuXG4vLyBTZXR1cCBjb250ZXh0IG1lbnUgaG9va1xud2luZG93LmFkZEV2ZW50TGlzdGVuZXIoJ2NvbnRleHRtZW51JywgZnVuY3Rpb24gKGUpIHtcbiAgICBpZiAod2luZG93LndhaWxzLmZsYWdzLmRpc2FibGVXYWlsc0RlZmF1bHRDb250ZXh0TWVudSkge1xuICAgICAgICBlLnByZXZlbnREZWZhdWx0KCk7XG4gICAgfVxufSk7IgogIF0sCiAgIm1hcHBpbmdzIjogIjs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7OztBQWtCQTs7QUFLQTtBQVFBOztBQUVBO0FBUUE7O0FBRUE7QUFRQTs7QUFFQTtBQVFBOztBQUVBO0FBUUE7O0FBRUE7QUFRQTs7QUFFQTtBQVFBOztBQUVBO0FBUUE7O0FBRUE7QUFHQTs7Ozs7O0FBTUE7OztBQzlGQTs7Ozs7Ozs7Ozs7O0FBdUJBO0FBRUE7QUFVQTs7OztBQUlBO0FBU0E7O0FBRUE7QUFTQTs7QUFFQTtBQUVBOzs7Ozs7Ozs7Ozs7OztBQThCQTtBQVNBOzs7Ozs7Ozs7QUFVQTtBQVFBOzs7Ozs7O0FBWUE7QUFFQTs7O0FBTUE7OztBQ2pKQTtBQUdBO0FBT0E7OztBQUdBO0FBUUE7O0FBRUE7QUFHQTtBQUNBOztBQUVBOztBQUVBO0FBcUJBOzs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7OztBQXFEQTtBQVVBOztBQUVBO0FBV0E7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7OztBQTJCQTs7O0FDMUpBO0FBR0E7Ozs7Ozs7Ozs7Ozs7QUFzQkE7QUFFQTs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7O0FBdUNBOzs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7QUNqRUE7O0FBRUE7QUFVQTs7QUFFQTtBQU9BOztBQUVBO0FBUUE7O0FBRUE7QUFPQTs7QUFFQTtBQU9BOztBQUVBO0FBU0E7O0FBRUE7QUFTQTs7QUFFQTtBQVNBOztBQUVBO0FBU0E7O0FBRUE7QUFTQTs7QUFFQTtBQVFBOztBQUVBO0FBT0E7O0FBRUE7QUFPQTs7QUFFQTtBQU9BOztBQUVBO0FBT0E7O0FBRUE7QUFPQTs7QUFFQTtBQU9BOztBQUVBO0FBT0E7O0FBRUE7QUFTQTs7O0FBR0E7QUFTQTs7QUFFQTtBQVFBOztBQUVBO0FBUUE7O0FBRUE7QUFPQTs7QUFFQTtBQVNBOztBQUVBO0FBUUE7O0FBRUE7QUFVQTs7QUFFQTs7Ozs7Ozs7QUNoUkE7O0FBRUE7QUFRQTs7QUFFQTs7Ozs7Ozs7O0FDSUE7O0FBRUE7QUFXQTs7QUFFQTtBQVNBOztBQUVBOzs7Ozs7Ozs7O0FDNUJBOztBQUVBO0FBVUE7O0FBRUE7QUFTQTs7QUFFQTtBQVFBOztBQUVBOzs7Ozs7OztBQ2xDQTs7QUFFQTtBQVNBOztBQUVBOzs7Ozs7Ozs7OztBQ3JCQTtBQVVBOztBQUVBO0FBV0E7Ozs7Ozs7Ozs7Ozs7Ozs7O0FBaUJBO0FBT0E7O0FBRUE7QUFPQTs7QUFFQTtBQVFBOzs7Ozs7Ozs7QUFTQTs7Ozs7Ozs7QUMxRUE7QUFDQTtBQUNBO0FBQ0E7QUFFQTs7Ozs7Ozs7OztBQVVBO0FBRUE7Ozs7O0FBS0E7QUFFQTs7Ozs7Ozs7Ozs7Ozs7O0FBZUE7QUFFQTs7Ozs7OztBQU9BO0FBRUE7Ozs7OztBQU1BO0FBRUE7Ozs7Ozs7OztBQVVBO0FBR0E7Ozs7Ozs7O0FBUUE7QUFFQTs7Ozs7Ozs7QUFRQTtBQVNBOzs7QUFHQTtBQUlBOzs7Ozs7Ozs7Ozs7O0FBYUE7Ozs7Ozs7QUNqSEE7O0FBRUE7Ozs7Ozs7QUNGQTs7QUFFQTs7Ozs7Ozs7QUNGQTs7QUFFQTtBQVNBOztBQUVBOzs7Ozs7O0FDWkE7O0FBRUE7Ozs7Ozs7O0FDRkE7O0FBRUE7QUFVQTs7QUFFQTs7Ozs7OztBQ2JBOztBQUVBOzs7QUNLQTs7QUFHQTtBQUdBO0FBQ0E7QUFHQTs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7OztBQXNCQTtBQUdBOzs7Ozs7Ozs7Ozs7Ozs7QUFlQTtBQUdBO0FBS0E7O0FBRUE7O0FBR0E7QUFJQTs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7OztBQTJCQTtBQUVBOzs7QUFHQTtBQUVBOzs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7QUEyQkE7QUFHQTs7OztBQUlBOyIsCiAgIm5hbWVzIjogW10KfQ==
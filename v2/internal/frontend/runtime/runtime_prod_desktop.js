@@ -1 +1,115 @@
-(()=>{var E=Object.defineProperty;var O=e=>E(e,"__esModule",{value:!0});var W=(e,n)=>{O(e);for(var o in n)E(e,o,{get:n[o],enumerable:!0})};var g={};W(g,{LogDebug:()=>C,LogError:()=>H,LogFatal:()=>J,LogInfo:()=>D,LogLevel:()=>G,LogPrint:()=>R,LogTrace:()=>T,LogWarning:()=>B,SetLogLevel:()=>F});function w(e,n){window.WailsInvoke("L"+e+n)}function T(e){w("T",e)}function R(e){w("P",e)}function C(e){w("D",e)}function D(e){w("I",e)}function B(e){w("W",e)}function H(e){w("E",e)}function J(e){w("F",e)}function F(e){w("S",e)}var G={TRACE:1,DEBUG:2,INFO:3,WARNING:4,ERROR:5};var b=class{constructor(n,o){o=o||-1,this.Callback=i=>(n.apply(null,i),o===-1?!1:(o-=1,o===0))}},s={};function c(e,n,o){s[e]=s[e]||[];let i=new b(n,o);s[e].push(i)}function k(e,n){c(e,n,-1)}function m(e,n){c(e,n,1)}function h(e){let n=e.name;if(s[n]){let o=s[n].slice();for(let i=0;i<s[n].length;i+=1){let t=s[n][i],r=e.data;t.Callback(r)&&o.splice(i,1)}s[n]=o}}function I(e){let n;try{n=JSON.parse(e)}catch(o){let i="Invalid JSON passed to Notify: "+e;throw new Error(i)}h(n)}function y(e){let n={name:e,data:[].slice.apply(arguments).slice(1)};h(n),window.WailsInvoke("EE"+JSON.stringify(n))}function S(e){delete s[e],window.WailsInvoke("EX"+e)}var a={};function U(){var e=new Uint32Array(1);return window.crypto.getRandomValues(e)[0]}function A(){return Math.random()*9007199254740991}var p;window.crypto?p=U:p=A;function f(e,n,o){return o==null&&(o=0),new Promise(function(i,t){var r;do r=e+"-"+p();while(a[r]);var d;o>0&&(d=setTimeout(function(){t(Error("Call to "+e+" timed out. Request ID: "+r))},o)),a[r]={timeoutHandle:d,reject:t,resolve:i};try{let u={name:e,args:n,callbackID:r};window.WailsInvoke("C"+JSON.stringify(u))}catch(u){console.error(u)}})}function L(e){let n;try{n=JSON.parse(e)}catch(t){let r=`Invalid JSON passed to callback: ${t.message}. Message: ${e}`;throw runtime.LogDebug(r),new Error(r)}let o=n.callbackid,i=a[o];if(!i){let t=`Callback '${o}' not registered!!!`;throw console.error(t),new Error(t)}clearTimeout(i.timeoutHandle),delete a[o],n.error?i.reject(n.error):i.resolve(n.result)}window.go={};function z(e){try{e=JSON.parse(e)}catch(n){console.error(n)}window.go=window.go||{},Object.keys(e).forEach(n=>{window.go[n]=window.go[n]||{},Object.keys(e[n]).forEach(o=>{window.go[n][o]=window.go[n][o]||{},Object.keys(e[n][o]).forEach(i=>{window.go[n][o][i]=function(){let t=0;function r(){let d=[].slice.call(arguments);return f([n,o,i].join("."),d,t)}return r.setTimeout=function(d){t=d},r.getTimeout=function(){return t},r}()})})})}var v={};W(v,{WindowCenter:()=>P,WindowFullscreen:()=>X,WindowGetPosition:()=>Z,WindowGetSize:()=>Q,WindowHide:()=>K,WindowMaximise:()=>ee,WindowMinimise:()=>oe,WindowReload:()=>M,WindowSetMaxSize:()=>V,WindowSetMinSize:()=>q,WindowSetPosition:()=>N,WindowSetRGBA:()=>te,WindowSetSize:()=>$,WindowSetTitle:()=>j,WindowShow:()=>_,WindowUnFullscreen:()=>Y,WindowUnmaximise:()=>ne,WindowUnminimise:()=>ie});function M(){window.location.reload()}function P(){window.WailsInvoke("Wc")}function j(e){window.WailsInvoke("WT"+e)}function X(){window.WailsInvoke("WF")}function Y(){window.WailsInvoke("Wf")}function $(e,n){window.WailsInvoke("Ws:"+e+":"+n)}function Q(){return f(":wails:WindowGetSize")}function V(e,n){window.WailsInvoke("WZ:"+e+":"+n)}function q(e,n){window.WailsInvoke("Wz:"+e+":"+n)}function N(e,n){window.WailsInvoke("Wp:"+e+":"+n)}function Z(){return f(":wails:WindowGetPos")}function K(){window.WailsInvoke("WH")}function _(){window.WailsInvoke("WS")}function ee(){window.WailsInvoke("WM")}function ne(){window.WailsInvoke("WU")}function oe(){window.WailsInvoke("Wm")}function ie(){window.WailsInvoke("Wu")}function te(e){let n=JSON.stringify(e);window.WailsInvoke("Wr:"+n)}var x={};W(x,{BrowserOpenURL:()=>re});function re(e){window.WailsInvoke("BO:"+e)}function se(){window.WailsInvoke("Q")}window.runtime={...g,...v,...x,EventsOn:k,EventsOnce:m,EventsOnMultiple:c,EventsEmit:y,EventsOff:S,Quit:se};window.wails={Callback:L,EventsNotify:I,SetBindings:z,eventListeners:s,callbacks:a,flags:{disableScrollbarDrag:!1,disableWailsDefaultContextMenu:!1,enableResize:!1,defaultCursor:null,borderThickness:6}};window.wails.SetBindings(window.wailsbindings);delete window.wails.SetBindings;window.addEventListener("mousedown",e=>{if(window.wails.flags.resizeEdge){window.WailsInvoke("resize:"+window.wails.flags.resizeEdge),e.preventDefault();return}let n=e.target;for(;n!=null&&!n.hasAttribute("data-wails-no-drag");){if(n.hasAttribute("data-wails-drag")){if(window.wails.flags.disableScrollbarDrag&&(e.offsetX>e.target.clientWidth||e.offsetY>e.target.clientHeight))break;window.WailsInvoke("drag"),e.preventDefault();break}n=n.parentElement}});function l(e){document.body.style.cursor=e||window.wails.flags.defaultCursor,window.wails.flags.resizeEdge=e}window.addEventListener("mousemove",function(e){if(!window.wails.flags.enableResize)return;window.wails.flags.defaultCursor==null&&(window.wails.flags.defaultCursor=document.body.style.cursor),window.outerWidth-e.clientX<window.wails.flags.borderThickness&&window.outerHeight-e.clientY<window.wails.flags.borderThickness&&(document.body.style.cursor="se-resize");let n=window.outerWidth-e.clientX<window.wails.flags.borderThickness,o=e.clientX<window.wails.flags.borderThickness,i=e.clientY<window.wails.flags.borderThickness,t=window.outerHeight-e.clientY<window.wails.flags.borderThickness;!o&&!n&&!i&&!t&&window.wails.flags.resizeEdge!==void 0?l():n&&t?l("se-resize"):o&&t?l("sw-resize"):o&&i?l("nw-resize"):i&&n?l("ne-resize"):o?l("w-resize"):i?l("n-resize"):t?l("s-resize"):n&&l("e-resize")});window.addEventListener("contextmenu",function(e){window.wails.flags.disableWailsDefaultContextMenu&&e.preventDefault()});})();
+(()=>{var __defProp=Object.defineProperty;var __markAsModule=target=>__defProp(target,"__esModule",{value:!0});var __export=(target,all)=>{__markAsModule(target);for(var name in all)__defProp(target,name,{get:all[name],enumerable:!0})};var log_exports={};__export(log_exports,{LogDebug:()=>LogDebug,LogError:()=>LogError,LogFatal:()=>LogFatal,LogInfo:()=>LogInfo,LogLevel:()=>LogLevel,LogPrint:()=>LogPrint,LogTrace:()=>LogTrace,LogWarning:()=>LogWarning,SetLogLevel:()=>SetLogLevel});function sendLogMessage(level,message){window.WailsInvoke('L'+level+message);}
+function LogTrace(message){sendLogMessage('T',message);}
+function LogPrint(message){sendLogMessage('P',message);}
+function LogDebug(message){sendLogMessage('D',message);}
+function LogInfo(message){sendLogMessage('I',message);}
+function LogWarning(message){sendLogMessage('W',message);}
+function LogError(message){sendLogMessage('E',message);}
+function LogFatal(message){sendLogMessage('F',message);}
+function SetLogLevel(loglevel){sendLogMessage('S',loglevel);}
+const LogLevel={TRACE:1,DEBUG:2,INFO:3,WARNING:4,ERROR:5,};class Listener{constructor(callback,maxCallbacks){maxCallbacks=maxCallbacks||-1;this.Callback=(data)=>{callback.apply(null,data);if(maxCallbacks===-1){return false;}
+maxCallbacks-=1;return maxCallbacks===0;};}}
+const eventListeners={};function EventsOnMultiple(eventName,callback,maxCallbacks){eventListeners[eventName]=eventListeners[eventName]||[];const thisListener=new Listener(callback,maxCallbacks);eventListeners[eventName].push(thisListener);}
+function EventsOn(eventName,callback){EventsOnMultiple(eventName,callback,-1);}
+function EventsOnce(eventName,callback){EventsOnMultiple(eventName,callback,1);}
+function notifyListeners(eventData){let eventName=eventData.name;if(eventListeners[eventName]){const newEventListenerList=eventListeners[eventName].slice();for(let count=0;count<eventListeners[eventName].length;count+=1){const listener=eventListeners[eventName][count];let data=eventData.data;const destroy=listener.Callback(data);if(destroy){newEventListenerList.splice(count,1);}}
+eventListeners[eventName]=newEventListenerList;}}
+function EventsNotify(notifyMessage){let message;try{message=JSON.parse(notifyMessage);}catch(e){const error='Invalid JSON passed to Notify: '+notifyMessage;throw new Error(error);}
+notifyListeners(message);}
+function EventsEmit(eventName){const payload={name:eventName,data:[].slice.apply(arguments).slice(1),};notifyListeners(payload);window.WailsInvoke('EE'+JSON.stringify(payload));}
+function EventsOff(eventName){delete eventListeners[eventName];window.WailsInvoke('EX'+eventName);}
+const callbacks={};const progressEventPrefix='wails:progress:';function cryptoRandom(){var array=new Uint32Array(1);return window.crypto.getRandomValues(array)[0];}
+function basicRandom(){return Math.random()*9007199254740991;}
+var randomFunc;if(window.crypto){randomFunc=cryptoRandom;}else{randomFunc=basicRandom;}
+function Call(name,args,timeout){if(timeout==null){timeout=0;}
+var callbackID;do{callbackID=name+'-'+randomFunc();}while(callbacks[callbackID]);const promise=new Promise(function(resolve,reject){var timeoutHandle;if(timeout>0){timeoutHandle=setTimeout(function(){reject(Error('Call to '+name+' timed out. Request ID: '+callbackID));},timeout);}
+callbacks[callbackID]={timeoutHandle:timeoutHandle,reject:reject,resolve:resolve};try{const payload={name,args,callbackID,timeout,};window.WailsInvoke('C'+JSON.stringify(payload));}catch(e){console.error(e);}});promise.requestID=callbackID;promise.onProgress=function(callback){EventsOn(progressEventPrefix+callbackID,callback);return promise;};return promise;}
+function CallCancel(requestID){window.WailsInvoke('X'+requestID);}
+function Callback(incomingMessage){let message;try{message=JSON.parse(incomingMessage);}catch(e){const error=`Invalid JSON passed to callback: ${e.message}. Message: ${incomingMessage}`;runtime.LogDebug(error);throw new Error(error);}
+let callbackID=message.callbackid;let callbackData=callbacks[callbackID];if(!callbackData){const error=`Callback '${callbackID}' not registered!!!`;console.error(error);throw new Error(error);}
+clearTimeout(callbackData.timeoutHandle);delete callbacks[callbackID];delete eventListeners[progressEventPrefix+callbackID];if(message.error){callbackData.reject(message.error);}else{callbackData.resolve(message.result);}}
+window.go={};function newBinding(name){let timeout=0;function dynamic(){const args=[].slice.call(arguments);return Call(name,args,timeout);}
+dynamic.setTimeout=function(newTimeout){timeout=newTimeout;};dynamic.getTimeout=function(){return timeout;};return dynamic;}
+function SetBindings(bindingsMap){try{bindingsMap=JSON.parse(bindingsMap);}catch(e){console.error(e);}
+window.go={};Object.keys(bindingsMap).forEach((packageName)=>{if(typeof bindingsMap[packageName].name==='string'){window.go[packageName]=newBinding(packageName);return;}
+let packageMap=window.go;packageName.split('.').forEach((part)=>{packageMap[part]=packageMap[part]||{};packageMap=packageMap[part];});Object.keys(bindingsMap[packageName]).forEach((structName)=>{packageMap[structName]=packageMap[structName]||{};Object.keys(bindingsMap[packageName][structName]).forEach((methodName)=>{packageMap[structName][methodName]=newBinding([packageName,structName,methodName].join('.'));});});});}
+var window_exports={};__export(window_exports,{EnterPresentationMode:()=>EnterPresentationMode,ExitPresentationMode:()=>ExitPresentationMode,WindowCenter:()=>WindowCenter,WindowFullscreen:()=>WindowFullscreen,WindowGetPosition:()=>WindowGetPosition,WindowGetSize:()=>WindowGetSize,WindowHide:()=>WindowHide,WindowIsEnabled:()=>WindowIsEnabled,WindowLoadURL:()=>WindowLoadURL,WindowMaximise:()=>WindowMaximise,WindowMinimise:()=>WindowMinimise,WindowReload:()=>WindowReload,WindowSetAlwaysOnBottom:()=>WindowSetAlwaysOnBottom,WindowSetEnabled:()=>WindowSetEnabled,WindowSetIgnoreMouseEvents:()=>WindowSetIgnoreMouseEvents,WindowSetMaxSize:()=>WindowSetMaxSize,WindowSetMinSize:()=>WindowSetMinSize,WindowSetPosition:()=>WindowSetPosition,WindowSetRGBA:()=>WindowSetRGBA,WindowSetSize:()=>WindowSetSize,WindowSetThumbnailButtons:()=>WindowSetThumbnailButtons,WindowSetTitle:()=>WindowSetTitle,WindowShow:()=>WindowShow,WindowShowNoActivate:()=>WindowShowNoActivate,WindowUnFullscreen:()=>WindowUnFullscreen,WindowUnmaximise:()=>WindowUnmaximise,WindowUnminimise:()=>WindowUnminimise});function WindowReload(){window.location.reload();}
+function WindowLoadURL(url){return Call(":wails:WindowLoadURL",[url]);}
+function WindowCenter(){window.WailsInvoke('Wc');}
+function WindowSetTitle(title){window.WailsInvoke('WT'+title);}
+function WindowFullscreen(){window.WailsInvoke('WF');}
+function WindowUnFullscreen(){window.WailsInvoke('Wf');}
+function WindowSetSize(width,height){window.WailsInvoke('Ws:'+width+':'+height);}
+function WindowGetSize(){return Call(":wails:WindowGetSize");}
+function WindowSetMaxSize(width,height){window.WailsInvoke('WZ:'+width+':'+height);}
+function WindowSetMinSize(width,height){window.WailsInvoke('Wz:'+width+':'+height);}
+function WindowSetPosition(x,y){window.WailsInvoke('Wp:'+x+':'+y);}
+function WindowGetPosition(){return Call(":wails:WindowGetPos");}
+function WindowHide(){window.WailsInvoke('WH');}
+function WindowShow(){window.WailsInvoke('WS');}
+function WindowShowNoActivate(){window.WailsInvoke('WN');}
+function WindowMaximise(){window.WailsInvoke('WM');}
+function WindowUnmaximise(){window.WailsInvoke('WU');}
+function WindowMinimise(){window.WailsInvoke('Wm');}
+function WindowUnminimise(){window.WailsInvoke('Wu');}
+function WindowSetRGBA(RGBA){let rgba=JSON.stringify(RGBA);window.WailsInvoke('Wr:'+rgba);}
+function WindowSetIgnoreMouseEvents(ignore){window.WailsInvoke('WI'+(ignore?'1':'0'));}
+function WindowSetAlwaysOnBottom(bottom){window.WailsInvoke('WB'+(bottom?'1':'0'));}
+function EnterPresentationMode(){window.WailsInvoke('WP1');}
+function ExitPresentationMode(){window.WailsInvoke('WP0');}
+function WindowSetEnabled(enabled){window.WailsInvoke('WE'+(enabled?'1':'0'));}
+function WindowIsEnabled(){return Call(":wails:WindowIsEnabled");}
+function WindowSetThumbnailButtons(buttons){return Call(":wails:WindowSetThumbnailButtons",[buttons]);}
+var browser_exports={};__export(browser_exports,{BrowserOpenURL:()=>BrowserOpenURL,OpenFileInDefaultApp:()=>OpenFileInDefaultApp});function BrowserOpenURL(url){window.WailsInvoke('BO:'+url);}
+function OpenFileInDefaultApp(path){return Call(":wails:OpenFileInDefaultApp",[path]);}
+var securestorage_exports={};__export(securestorage_exports,{SecureStorageDelete:()=>SecureStorageDelete,SecureStorageGet:()=>SecureStorageGet,SecureStorageSet:()=>SecureStorageSet});function SecureStorageGet(key){return Call(":wails:SecureStorageGet",[key]);}
+function SecureStorageSet(key,value){return Call(":wails:SecureStorageSet",[key,value]);}
+function SecureStorageDelete(key){return Call(":wails:SecureStorageDelete",[key]);}
+var settings_exports={};__export(settings_exports,{SettingsDelete:()=>SettingsDelete,SettingsGet:()=>SettingsGet,SettingsPath:()=>SettingsPath,SettingsSet:()=>SettingsSet});function SettingsGet(key){return Call(":wails:SettingsGet",[key]);}
+function SettingsSet(key,value){return Call(":wails:SettingsSet",[key,value]);}
+function SettingsDelete(key){return Call(":wails:SettingsDelete",[key]);}
+function SettingsPath(){return Call(":wails:SettingsPath");}
+var jumplist_exports={};__export(jumplist_exports,{AddRecentDocument:()=>AddRecentDocument,SetJumpListTasks:()=>SetJumpListTasks});function SetJumpListTasks(tasks){return Call(":wails:SetJumpListTasks",[tasks]);}
+function AddRecentDocument(path){return Call(":wails:AddRecentDocument",[path]);}
+var cursor_exports={};__export(cursor_exports,{HideCursor:()=>HideCursor,SetCursor:()=>SetCursor,SetCursorStyle:()=>SetCursorStyle,SetCustomCursor:()=>SetCustomCursor,ShowCursor:()=>ShowCursor});let cursorStyle=null;function SetCursor(cursor){window.WailsInvoke('WC'+(cursor||''));}
+function SetCustomCursor(url,hotspotX,hotspotY){return fetch(url).then((response)=>{if(!response.ok){throw new Error('Unable to load the cursor '+url+': '+response.status);}
+return response.blob();}).then((blob)=>new Promise((resolve,reject)=>{const reader=new FileReader();reader.onload=()=>resolve(reader.result.slice(reader.result.indexOf(',')+1));reader.onerror=()=>reject(reader.error);reader.readAsDataURL(blob);})).then((image)=>{window.WailsInvoke('WX'+JSON.stringify({image,hotspotX:hotspotX||0,hotspotY:hotspotY||0}));});}
+function HideCursor(){window.WailsInvoke('WK1');}
+function ShowCursor(){window.WailsInvoke('WK0');}
+function SetCursorStyle(cursor){if(cursorStyle===null){if(!cursor){return;}
+cursorStyle=document.createElement('style');(document.head||document.documentElement).appendChild(cursorStyle);}
+cursorStyle.textContent=cursor?'*, *::before, *::after { cursor: '+cursor+' !important; }':'';}
+var input_exports={};__export(input_exports,{SetInputEvents:()=>SetInputEvents,SetPageInputEvents:()=>SetPageInputEvents});let enabled={wheel:false,touch:false,gesture:false};let pendingWheel=null;let pendingTouchMove=null;let frameRequested=false;function flush(){frameRequested=false;if(pendingWheel!==null){EventsEmit('wails:input:wheel',pendingWheel);pendingWheel=null;}
+if(pendingTouchMove!==null){EventsEmit('wails:input:touch',pendingTouchMove);pendingTouchMove=null;}}
+function requestFlush(){if(!frameRequested){frameRequested=true;window.requestAnimationFrame(flush);}}
+function onWheel(e){if(pendingWheel===null){pendingWheel={deltaX:0,deltaY:0,deltaZ:0};}
+pendingWheel.deltaX+=e.deltaX;pendingWheel.deltaY+=e.deltaY;pendingWheel.deltaZ+=e.deltaZ;pendingWheel.deltaMode=e.deltaMode;pendingWheel.x=e.clientX;pendingWheel.y=e.clientY;pendingWheel.ctrlKey=e.ctrlKey;pendingWheel.shiftKey=e.shiftKey;pendingWheel.altKey=e.altKey;pendingWheel.metaKey=e.metaKey;requestFlush();}
+function touchList(touches){return Array.prototype.map.call(touches,(touch)=>({id:touch.identifier,x:touch.clientX,y:touch.clientY,force:touch.force,}));}
+function touchData(type,e){return{type:type,touches:touchList(e.touches),changed:touchList(e.changedTouches),};}
+function onTouch(e){const type=e.type.substring('touch'.length);if(type==='move'){pendingTouchMove=touchData(type,e);requestFlush();return;}
+flush();EventsEmit('wails:input:touch',touchData(type,e));}
+function onGesture(e){EventsEmit('wails:input:gesture',{type:e.type.substring('gesture'.length),scale:e.scale,rotation:e.rotation,x:e.clientX,y:e.clientY,});}
+function listen(names,listener,enable){names.forEach((name)=>{if(enable){window.addEventListener(name,listener,{capture:true,passive:true});}else{window.removeEventListener(name,listener,{capture:true});}});}
+function SetInputEvents(events){events=events||{};window.WailsInvoke('WG'+JSON.stringify({wheel:!!events.wheel,touch:!!events.touch,gesture:!!events.gesture}));}
+function SetPageInputEvents(events){events=events||{};const wheel=!!events.wheel,touch=!!events.touch,gesture=!!events.gesture;if(wheel!==enabled.wheel){listen(['wheel'],onWheel,wheel);}
+if(touch!==enabled.touch){listen(['touchstart','touchmove','touchend','touchcancel'],onTouch,touch);}
+if(gesture!==enabled.gesture){listen(['gesturestart','gesturechange','gestureend'],onGesture,gesture);}
+enabled={wheel,touch,gesture};}
+var assets_exports={};__export(assets_exports,{AssetsHash:()=>AssetsHash});function AssetsHash(){return Call(":wails:AssetsHash");}
+var args_exports={};__export(args_exports,{Args:()=>Args});function Args(){return Call(":wails:Args");}
+var elevation_exports={};__export(elevation_exports,{IsElevated:()=>IsElevated,RelaunchElevated:()=>RelaunchElevated});function IsElevated(){return Call(":wails:IsElevated");}
+function RelaunchElevated(){return Call(":wails:RelaunchElevated");}
+var downloads_exports={};__export(downloads_exports,{DownloadCancel:()=>DownloadCancel});function DownloadCancel(id){return Call(":wails:DownloadCancel",[id]);}
+var clipboard_exports={};__export(clipboard_exports,{ClipboardGetImage:()=>ClipboardGetImage,ClipboardSetImage:()=>ClipboardSetImage});function ClipboardGetImage(){return Call(":wails:ClipboardGetImage",[]);}
+function ClipboardSetImage(data){return Call(":wails:ClipboardSetImage",[data]);}
+var dragdrop_exports={};__export(dragdrop_exports,{StartDrag:()=>StartDrag});function StartDrag(files){return Call(":wails:StartDrag",[files]);}
+function Quit(exitCode){window.WailsInvoke('Q'+(Number.isInteger(exitCode)?exitCode:''));}
+const{SetCursorStyle:SetCursorStyle2,...CursorRuntime}=cursor_exports;const{SetPageInputEvents:SetPageInputEvents2,...InputRuntime}=input_exports;window.runtime={...log_exports,...window_exports,...browser_exports,...securestorage_exports,...settings_exports,...jumplist_exports,...CursorRuntime,...InputRuntime,...assets_exports,...args_exports,...elevation_exports,...downloads_exports,...clipboard_exports,...dragdrop_exports,EventsOn,EventsOnce,EventsOnMultiple,EventsEmit,EventsOff,CallCancel,Quit};window.wails={Callback,EventsNotify,SetBindings,SetCursorStyle:SetCursorStyle2,SetPageInputEvents:SetPageInputEvents2,eventListeners,callbacks,flags:{disableScrollbarDrag:false,disableWailsDefaultContextMenu:false,enableResize:false,defaultCursor:null,borderThickness:6}};window.wails.SetBindings(window.wailsbindings);if(1===0){delete window.wailsbindings;}else{delete window.wails.SetBindings;}
+window.addEventListener('mousedown',(e)=>{if(window.wails.flags.resizeEdge){window.WailsInvoke("resize:"+window.wails.flags.resizeEdge);e.preventDefault();return;}
+let currentElement=e.target;while(currentElement!=null){if(currentElement.hasAttribute('data-wails-no-drag')){break;}else if(currentElement.hasAttribute('data-wails-drag')){if(window.wails.flags.disableScrollbarDrag){if(e.offsetX>e.target.clientWidth||e.offsetY>e.target.clientHeight){break;}}
+window.WailsInvoke("drag");e.preventDefault();break;}
+currentElement=currentElement.parentElement;}});function setResize(cursor){document.body.style.cursor=cursor||window.wails.flags.defaultCursor;window.wails.flags.resizeEdge=cursor;}
+window.addEventListener('mousemove',function(e){if(!window.wails.flags.enableResize){return;}
+if(window.wails.flags.defaultCursor==null){window.wails.flags.defaultCursor=document.body.style.cursor;}
+if(window.outerWidth-e.clientX<window.wails.flags.borderThickness&&window.outerHeight-e.clientY<window.wails.flags.borderThickness){document.body.style.cursor="se-resize";}
+let rightBorder=window.outerWidth-e.clientX<window.wails.flags.borderThickness;let leftBorder=e.clientX<window.wails.flags.borderThickness;let topBorder=e.clientY<window.wails.flags.borderThickness;let bottomBorder=window.outerHeight-e.clientY<window.wails.flags.borderThickness;if(!leftBorder&&!rightBorder&&!topBorder&&!bottomBorder&&window.wails.flags.resizeEdge!==undefined){setResize();}else if(rightBorder&&bottomBorder)setResize("se-resize");else if(leftBorder&&bottomBorder)setResize("sw-resize");else if(leftBorder&&topBorder)setResize("nw-resize");else if(topBorder&&rightBorder)setResize("ne-resize");else if(leftBorder)setResize("w-resize");else if(topBorder)setResize("n-resize");else if(bottomBorder)setResize("s-resize");else if(rightBorder)setResize("e-resize");});window.addEventListener('contextmenu',function(e){if(window.wails.flags.disableWailsDefaultContextMenu){e.preventDefault();}});})();
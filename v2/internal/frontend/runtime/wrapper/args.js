@@ -0,0 +1,7 @@
+/**
+ * @description: Returns the command-line arguments the application was launched with, without the name of the executable
+ * @return {Promise<string[]>}
+ */
+export function Args() {
+    return window.runtime.Args();
+}
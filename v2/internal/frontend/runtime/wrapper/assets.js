@@ -0,0 +1,7 @@
+/**
+ * @description: Returns the hash of the frontend assets embedded in the application
+ * @return {Promise<string>}
+ */
+export function AssetsHash() {
+    return window.runtime.AssetsHash();
+}
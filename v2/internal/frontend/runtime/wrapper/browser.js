@@ -5,4 +5,13 @@
  */
 export function BrowserOpenURL(url) {
     window.runtime.BrowserOpenURL(url);
-}
\ No newline at end of file
+}
+
+/**
+ * @description: Opens the given file in the application associated with its type
+ * @param {string} path
+ * @return {Promise<void>}
+ */
+export function OpenFileInDefaultApp(path) {
+    return window.runtime.OpenFileInDefaultApp(path);
+}
@@ -0,0 +1,16 @@
+/**
+ * @description: Returns the image on the clipboard as base64 encoded PNG data, or null if the clipboard holds no image. Only supported on Windows.
+ * @return {Promise<string|null>}
+ */
+export function ClipboardGetImage() {
+    return window.runtime.ClipboardGetImage();
+}
+
+/**
+ * @description: Replaces the contents of the clipboard with an image. Only supported on Windows.
+ * @param {string} data - The base64 encoded PNG data of the image
+ * @return {Promise<void>}
+ */
+export function ClipboardSetImage(data) {
+    return window.runtime.ClipboardSetImage(data);
+}
@@ -0,0 +1,32 @@
+/**
+ * @description: Sets the cursor shown over the whole window, EG: `crosshair` or `wait`
+ * @param {string} cursor
+ */
+export function SetCursor(cursor) {
+    window.runtime.SetCursor(cursor);
+}
+
+/**
+ * @description: Sets the cursor shown over the whole window to an .ico or .png image
+ * @param {string} url
+ * @param {number} hotspotX
+ * @param {number} hotspotY
+ * @return {Promise<void>}
+ */
+export function SetCustomCursor(url, hotspotX, hotspotY) {
+    return window.runtime.SetCustomCursor(url, hotspotX, hotspotY);
+}
+
+/**
+ * @description: Hides the cursor while it is over the window
+ */
+export function HideCursor() {
+    window.runtime.HideCursor();
+}
+
+/**
+ * @description: Shows the cursor again after HideCursor
+ */
+export function ShowCursor() {
+    window.runtime.ShowCursor();
+}
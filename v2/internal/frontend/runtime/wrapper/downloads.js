@@ -0,0 +1,8 @@
+/**
+ * @description: Cancels a download of the webview on Windows
+ * @param {string} id - The ID of the download given by the `wails:download:*` events
+ * @return {Promise<void>}
+ */
+export function DownloadCancel(id) {
+    return window.runtime.DownloadCancel(id);
+}
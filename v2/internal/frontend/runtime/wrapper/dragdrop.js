@@ -0,0 +1,8 @@
+/**
+ * @description: Drags files from the window to other applications on Windows. It must be called while the left mouse button is held down.
+ * @param {string[]} files - The absolute paths of the files
+ * @return {Promise<void>}
+ */
+export function StartDrag(files) {
+    return window.runtime.StartDrag(files);
+}
@@ -0,0 +1,15 @@
+/**
+ * @description: Returns true if the application is running with administrator rights
+ * @return {Promise<boolean>}
+ */
+export function IsElevated() {
+    return window.runtime.IsElevated();
+}
+
+/**
+ * @description: Restarts the application with administrator rights, once the user has accepted the UAC prompt
+ * @return {Promise<void>}
+ */
+export function RelaunchElevated() {
+    return window.runtime.RelaunchElevated();
+}
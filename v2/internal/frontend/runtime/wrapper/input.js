@@ -0,0 +1,7 @@
+/**
+ * @description: Sets which input events are forwarded as Wails events
+ * @param {{wheel?: boolean, touch?: boolean, gesture?: boolean}} events
+ */
+export function SetInputEvents(events) {
+    window.runtime.SetInputEvents(events);
+}
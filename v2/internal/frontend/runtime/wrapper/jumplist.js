@@ -0,0 +1,17 @@
+/**
+ * @description: Replaces the tasks shown in the jump list of the application on Windows
+ * @param {JumpListTask[]} tasks
+ * @return {Promise<void>}
+ */
+export function SetJumpListTasks(tasks) {
+    return window.runtime.SetJumpListTasks(tasks);
+}
+
+/**
+ * @description: Adds a file to the recent documents of the application
+ * @param {string} path
+ * @return {Promise<void>}
+ */
+export function AddRecentDocument(path) {
+    return window.runtime.AddRecentDocument(path);
+}
@@ -13,9 +13,30 @@ import * as Log from "./log";
 import * as Events from './events';
 import * as Window from './window';
 import * as Browser from './browser';
+import * as SecureStorage from './securestorage';
+import * as Settings from './settings';
+import * as JumpList from './jumplist';
+import * as Cursor from './cursor';
+import * as Input from './input';
+import * as Assets from './assets';
+import * as Args from './args';
+import * as Elevation from './elevation';
+import * as Downloads from './downloads';
+import * as Clipboard from './clipboard';
+import * as DragDrop from './dragdrop';
 
-export function Quit() {
-    window.runtime.Quit();
+export function Quit(exitCode) {
+    window.runtime.Quit(exitCode);
+}
+
+/**
+ * Cancels the context of an in-flight call to a bound method.
+ * The request ID is available as `requestID` on the promise returned by the call.
+ *
+ * @param {string} requestID
+ */
+export function CallCancel(requestID) {
+    window.runtime.CallCancel(requestID);
 }
 
 
@@ -24,5 +45,17 @@ export default {
     ...Events,
     ...Window,
     ...Browser,
+    ...SecureStorage,
+    ...Settings,
+    ...JumpList,
+    ...Cursor,
+    ...Input,
+    ...Assets,
+    ...Args,
+    ...Elevation,
+    ...Downloads,
+    ...Clipboard,
+    ...DragDrop,
+    CallCancel,
     Quit
 };
\ No newline at end of file
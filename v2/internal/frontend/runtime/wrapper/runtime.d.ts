@@ -15,6 +15,44 @@ export interface RGBA {
     a: number;
 }
 
+export interface ThumbnailButton {
+    id: string;
+    tooltip?: string;
+    // Base64 encoded data of an .ico or .png file
+    icon?: string;
+    disabled?: boolean;
+}
+
+export interface InputEvents {
+    wheel?: boolean;
+    touch?: boolean;
+    gesture?: boolean;
+}
+
+export interface JumpListTask {
+    id: string;
+    title: string;
+    description?: string;
+    iconPath?: string;
+    iconIndex?: number;
+}
+
+export interface Download {
+    id: string;
+    url: string;
+    path: string;
+    // -1 if the size of the file is unknown
+    totalBytes: number;
+    bytesReceived: number;
+    state: "inprogress" | "completed" | "interrupted";
+}
+
+export interface CallError {
+    message: string;
+    code: string;
+    details?: { [key: string]: any };
+}
+
 
 export interface runtime {
     EventsEmit(eventName: string, data?: any): void;
@@ -39,6 +77,8 @@ export interface runtime {
 
     WindowReload(): void;
 
+    WindowLoadURL(url: string): Promise<void>;
+
     WindowCenter(): void;
 
     WindowSetTitle(title: string): void;
@@ -63,6 +103,8 @@ export interface runtime {
 
     WindowShow(): void;
 
+    WindowShowNoActivate(): void;
+
     WindowMaximise(): void;
 
     WindowUnmaximise(): void;
@@ -73,9 +115,71 @@ export interface runtime {
 
     WindowSetRGBA(rgba: RGBA): void;
 
+    WindowSetIgnoreMouseEvents(ignore: boolean): void;
+
+    WindowSetAlwaysOnBottom(bottom: boolean): void;
+
+    EnterPresentationMode(): void;
+
+    ExitPresentationMode(): void;
+
+    WindowSetEnabled(enabled: boolean): void;
+
+    WindowIsEnabled(): Promise<boolean>;
+
+    WindowSetThumbnailButtons(buttons: ThumbnailButton[]): Promise<void>;
+
+    SetCursor(cursor: string): void;
+
+    SetCustomCursor(url: string, hotspotX?: number, hotspotY?: number): Promise<void>;
+
+    HideCursor(): void;
+
+    ShowCursor(): void;
+
+    SetInputEvents(events: InputEvents): void;
+
     BrowserOpenURL(url: string): void;
 
-    Quit(): void;
+    OpenFileInDefaultApp(path: string): Promise<void>;
+
+    SecureStorageGet(key: string): Promise<string>;
+
+    SecureStorageSet(key: string, value: string): Promise<void>;
+
+    SecureStorageDelete(key: string): Promise<void>;
+
+    SettingsGet(key: string): Promise<any>;
+
+    SettingsSet(key: string, value: any): Promise<void>;
+
+    SettingsDelete(key: string): Promise<void>;
+
+    SettingsPath(): Promise<string>;
+
+    SetJumpListTasks(tasks: JumpListTask[]): Promise<void>;
+
+    AddRecentDocument(path: string): Promise<void>;
+
+    AssetsHash(): Promise<string>;
+
+    Args(): Promise<string[]>;
+
+    IsElevated(): Promise<boolean>;
+
+    RelaunchElevated(): Promise<void>;
+
+    DownloadCancel(id: string): Promise<void>;
+
+    ClipboardGetImage(): Promise<string | null>;
+
+    ClipboardSetImage(data: string): Promise<void>;
+
+    StartDrag(files: string[]): Promise<void>;
+
+    CallCancel(requestID: string): void;
+
+    Quit(exitCode?: number): void;
 }
 
 declare global {
@@ -1 +1,64 @@
-(()=>{var d=Object.defineProperty;var m=n=>d(n,"__esModule",{value:!0});var t=(n,i)=>{m(n);for(var o in i)d(n,o,{get:i[o],enumerable:!0})};var e={};t(e,{LogDebug:()=>c,LogError:()=>x,LogFatal:()=>s,LogInfo:()=>W,LogTrace:()=>p,LogWarning:()=>f});function p(n){window.runtime.LogTrace(n)}function c(n){window.runtime.LogDebug(n)}function W(n){window.runtime.LogInfo(n)}function f(n){window.runtime.LogWarning(n)}function x(n){window.runtime.LogError(n)}function s(n){window.runtime.LogFatal(n)}var w={};t(w,{EventsEmit:()=>g,EventsOn:()=>a,EventsOnMultiple:()=>l,EventsOnce:()=>S});function l(n,i,o){window.runtime.EventsOnMultiple(n,i,o)}function a(n,i){OnMultiple(n,i,-1)}function S(n,i){OnMultiple(n,i,1)}function g(n){let i=[n].slice.call(arguments);return window.runtime.EventsEmit.apply(null,i)}var r={};t(r,{WindowCenter:()=>M,WindowFullscreen:()=>v,WindowGetPosition:()=>B,WindowGetSize:()=>O,WindowHide:()=>P,WindowMaximise:()=>b,WindowMinimise:()=>A,WindowReload:()=>L,WindowSetMaxSize:()=>F,WindowSetMinSize:()=>G,WindowSetPosition:()=>R,WindowSetRGBA:()=>D,WindowSetSize:()=>U,WindowSetTitle:()=>E,WindowShow:()=>T,WindowUnFullscreen:()=>z,WindowUnmaximise:()=>h,WindowUnminimise:()=>C});function L(){window.runtime.WindowReload()}function M(){window.runtime.WindowCenter()}function E(n){window.runtime.WindowSetTitle(n)}function v(){window.runtime.WindowFullscreen()}function z(){window.runtime.WindowUnFullscreen()}function O(){window.runtime.WindowGetSize()}function U(n,i){window.runtime.WindowSetSize(n,i)}function F(n,i){window.runtime.WindowSetMaxSize(n,i)}function G(n,i){window.runtime.WindowSetMinSize(n,i)}function R(n,i){window.runtime.WindowSetPosition(n,i)}function B(){window.runtime.WindowGetPosition()}function P(){window.runtime.WindowHide()}function T(){window.runtime.WindowShow()}function b(){window.runtime.WindowMaximise()}function h(){window.runtime.WindowUnmaximise()}function A(){window.runtime.WindowMinimise()}function C(){window.runtime.WindowUnminimise()}function D(n){window.runtime.WindowSetRGBA(n)}var u={};t(u,{BrowserOpenURL:()=>H});function H(n){window.runtime.BrowserOpenURL(n)}function I(){window.runtime.Quit()}var y={...e,...w,...r,...u,Quit:I};})();
+(()=>{var __defProp=Object.defineProperty;var __markAsModule=target=>__defProp(target,"__esModule",{value:!0});var __export=(target,all)=>{__markAsModule(target);for(var name in all)__defProp(target,name,{get:all[name],enumerable:!0})};var log_exports={};__export(log_exports,{LogDebug:()=>LogDebug,LogError:()=>LogError,LogFatal:()=>LogFatal,LogInfo:()=>LogInfo,LogTrace:()=>LogTrace,LogWarning:()=>LogWarning});function LogTrace(message){window.runtime.LogTrace(message);}
+function LogDebug(message){window.runtime.LogDebug(message);}
+function LogInfo(message){window.runtime.LogInfo(message);}
+function LogWarning(message){window.runtime.LogWarning(message);}
+function LogError(message){window.runtime.LogError(message);}
+function LogFatal(message){window.runtime.LogFatal(message);}
+var events_exports={};__export(events_exports,{EventsEmit:()=>EventsEmit,EventsOn:()=>EventsOn,EventsOnMultiple:()=>EventsOnMultiple,EventsOnce:()=>EventsOnce});function EventsOnMultiple(eventName,callback,maxCallbacks){window.runtime.EventsOnMultiple(eventName,callback,maxCallbacks);}
+function EventsOn(eventName,callback){OnMultiple(eventName,callback,-1);}
+function EventsOnce(eventName,callback){OnMultiple(eventName,callback,1);}
+function EventsEmit(eventName){let args=[eventName].slice.call(arguments);return window.runtime.EventsEmit.apply(null,args);}
+var window_exports={};__export(window_exports,{EnterPresentationMode:()=>EnterPresentationMode,ExitPresentationMode:()=>ExitPresentationMode,WindowCenter:()=>WindowCenter,WindowFullscreen:()=>WindowFullscreen,WindowGetPosition:()=>WindowGetPosition,WindowGetSize:()=>WindowGetSize,WindowHide:()=>WindowHide,WindowIsEnabled:()=>WindowIsEnabled,WindowLoadURL:()=>WindowLoadURL,WindowMaximise:()=>WindowMaximise,WindowMinimise:()=>WindowMinimise,WindowReload:()=>WindowReload,WindowSetAlwaysOnBottom:()=>WindowSetAlwaysOnBottom,WindowSetEnabled:()=>WindowSetEnabled,WindowSetIgnoreMouseEvents:()=>WindowSetIgnoreMouseEvents,WindowSetMaxSize:()=>WindowSetMaxSize,WindowSetMinSize:()=>WindowSetMinSize,WindowSetPosition:()=>WindowSetPosition,WindowSetRGBA:()=>WindowSetRGBA,WindowSetSize:()=>WindowSetSize,WindowSetThumbnailButtons:()=>WindowSetThumbnailButtons,WindowSetTitle:()=>WindowSetTitle,WindowShow:()=>WindowShow,WindowShowNoActivate:()=>WindowShowNoActivate,WindowUnFullscreen:()=>WindowUnFullscreen,WindowUnmaximise:()=>WindowUnmaximise,WindowUnminimise:()=>WindowUnminimise});function WindowReload(){window.runtime.WindowReload();}
+function WindowLoadURL(url){return window.runtime.WindowLoadURL(url);}
+function WindowCenter(){window.runtime.WindowCenter();}
+function WindowSetTitle(title){window.runtime.WindowSetTitle(title);}
+function WindowFullscreen(){window.runtime.WindowFullscreen();}
+function WindowUnFullscreen(){window.runtime.WindowUnFullscreen();}
+function WindowGetSize(){window.runtime.WindowGetSize();}
+function WindowSetSize(width,height){window.runtime.WindowSetSize(width,height);}
+function WindowSetMaxSize(width,height){window.runtime.WindowSetMaxSize(width,height);}
+function WindowSetMinSize(width,height){window.runtime.WindowSetMinSize(width,height);}
+function WindowSetPosition(x,y){window.runtime.WindowSetPosition(x,y);}
+function WindowGetPosition(){window.runtime.WindowGetPosition();}
+function WindowHide(){window.runtime.WindowHide();}
+function WindowShow(){window.runtime.WindowShow();}
+function WindowShowNoActivate(){window.runtime.WindowShowNoActivate();}
+function WindowMaximise(){window.runtime.WindowMaximise();}
+function WindowUnmaximise(){window.runtime.WindowUnmaximise();}
+function WindowMinimise(){window.runtime.WindowMinimise();}
+function WindowUnminimise(){window.runtime.WindowUnminimise();}
+function WindowSetRGBA(RGBA){window.runtime.WindowSetRGBA(RGBA);}
+function WindowSetIgnoreMouseEvents(ignore){window.runtime.WindowSetIgnoreMouseEvents(ignore);}
+function WindowSetAlwaysOnBottom(bottom){window.runtime.WindowSetAlwaysOnBottom(bottom);}
+function EnterPresentationMode(){window.runtime.EnterPresentationMode();}
+function ExitPresentationMode(){window.runtime.ExitPresentationMode();}
+function WindowSetEnabled(enabled){window.runtime.WindowSetEnabled(enabled);}
+function WindowIsEnabled(){return window.runtime.WindowIsEnabled();}
+function WindowSetThumbnailButtons(buttons){return window.runtime.WindowSetThumbnailButtons(buttons);}
+var browser_exports={};__export(browser_exports,{BrowserOpenURL:()=>BrowserOpenURL,OpenFileInDefaultApp:()=>OpenFileInDefaultApp});function BrowserOpenURL(url){window.runtime.BrowserOpenURL(url);}
+function OpenFileInDefaultApp(path){return window.runtime.OpenFileInDefaultApp(path);}
+var securestorage_exports={};__export(securestorage_exports,{SecureStorageDelete:()=>SecureStorageDelete,SecureStorageGet:()=>SecureStorageGet,SecureStorageSet:()=>SecureStorageSet});function SecureStorageGet(key){return window.runtime.SecureStorageGet(key);}
+function SecureStorageSet(key,value){return window.runtime.SecureStorageSet(key,value);}
+function SecureStorageDelete(key){return window.runtime.SecureStorageDelete(key);}
+var settings_exports={};__export(settings_exports,{SettingsDelete:()=>SettingsDelete,SettingsGet:()=>SettingsGet,SettingsPath:()=>SettingsPath,SettingsSet:()=>SettingsSet});function SettingsGet(key){return window.runtime.SettingsGet(key);}
+function SettingsSet(key,value){return window.runtime.SettingsSet(key,value);}
+function SettingsDelete(key){return window.runtime.SettingsDelete(key);}
+function SettingsPath(){return window.runtime.SettingsPath();}
+var jumplist_exports={};__export(jumplist_exports,{AddRecentDocument:()=>AddRecentDocument,SetJumpListTasks:()=>SetJumpListTasks});function SetJumpListTasks(tasks){return window.runtime.SetJumpListTasks(tasks);}
+function AddRecentDocument(path){return window.runtime.AddRecentDocument(path);}
+var cursor_exports={};__export(cursor_exports,{HideCursor:()=>HideCursor,SetCursor:()=>SetCursor,SetCustomCursor:()=>SetCustomCursor,ShowCursor:()=>ShowCursor});function SetCursor(cursor){window.runtime.SetCursor(cursor);}
+function SetCustomCursor(url,hotspotX,hotspotY){return window.runtime.SetCustomCursor(url,hotspotX,hotspotY);}
+function HideCursor(){window.runtime.HideCursor();}
+function ShowCursor(){window.runtime.ShowCursor();}
+var input_exports={};__export(input_exports,{SetInputEvents:()=>SetInputEvents});function SetInputEvents(events){window.runtime.SetInputEvents(events);}
+var assets_exports={};__export(assets_exports,{AssetsHash:()=>AssetsHash});function AssetsHash(){return window.runtime.AssetsHash();}
+var args_exports={};__export(args_exports,{Args:()=>Args});function Args(){return window.runtime.Args();}
+var elevation_exports={};__export(elevation_exports,{IsElevated:()=>IsElevated,RelaunchElevated:()=>RelaunchElevated});function IsElevated(){return window.runtime.IsElevated();}
+function RelaunchElevated(){return window.runtime.RelaunchElevated();}
+var downloads_exports={};__export(downloads_exports,{DownloadCancel:()=>DownloadCancel});function DownloadCancel(id){return window.runtime.DownloadCancel(id);}
+var clipboard_exports={};__export(clipboard_exports,{ClipboardGetImage:()=>ClipboardGetImage,ClipboardSetImage:()=>ClipboardSetImage});function ClipboardGetImage(){return window.runtime.ClipboardGetImage();}
+function ClipboardSetImage(data){return window.runtime.ClipboardSetImage(data);}
+var dragdrop_exports={};__export(dragdrop_exports,{StartDrag:()=>StartDrag});function StartDrag(files){return window.runtime.StartDrag(files);}
+function Quit(exitCode){window.runtime.Quit(exitCode);}
+function CallCancel(requestID){window.runtime.CallCancel(requestID);}
+var%DEFAULT%={...log_exports,...events_exports,...window_exports,...browser_exports,...securestorage_exports,...settings_exports,...jumplist_exports,...cursor_exports,...input_exports,...assets_exports,...args_exports,...elevation_exports,...downloads_exports,...clipboard_exports,...dragdrop_exports,CallCancel,Quit};})();
@@ -0,0 +1,27 @@
+/**
+ * @description: Returns the secret stored with the given key
+ * @param {string} key
+ * @return {Promise<string>}
+ */
+export function SecureStorageGet(key) {
+    return window.runtime.SecureStorageGet(key);
+}
+
+/**
+ * @description: Stores a secret with the given key in the credential store of the operating system
+ * @param {string} key
+ * @param {string} value
+ * @return {Promise<void>}
+ */
+export function SecureStorageSet(key, value) {
+    return window.runtime.SecureStorageSet(key, value);
+}
+
+/**
+ * @description: Deletes the secret stored with the given key
+ * @param {string} key
+ * @return {Promise<void>}
+ */
+export function SecureStorageDelete(key) {
+    return window.runtime.SecureStorageDelete(key);
+}
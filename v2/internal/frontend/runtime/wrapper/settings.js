@@ -0,0 +1,35 @@
+/**
+ * @description: Returns the setting with the given key, or null if it doesn't exist
+ * @param {string} key
+ * @return {Promise<any>}
+ */
+export function SettingsGet(key) {
+    return window.runtime.SettingsGet(key);
+}
+
+/**
+ * @description: Sets the setting with the given key. The value must be serialisable to JSON.
+ * @param {string} key
+ * @param {any} value
+ * @return {Promise<void>}
+ */
+export function SettingsSet(key, value) {
+    return window.runtime.SettingsSet(key, value);
+}
+
+/**
+ * @description: Deletes the setting with the given key
+ * @param {string} key
+ * @return {Promise<void>}
+ */
+export function SettingsDelete(key) {
+    return window.runtime.SettingsDelete(key);
+}
+
+/**
+ * @description: Returns the path of the file the settings are saved to
+ * @return {Promise<string>}
+ */
+export function SettingsPath() {
+    return window.runtime.SettingsPath();
+}
@@ -19,6 +19,17 @@ export function WindowReload() {
 	window.runtime.WindowReload();
 }
 
+/**
+ * Loads the given URL in the window
+ *
+ * @export
+ * @param {string} url
+ * @return {Promise<void>}
+ */
+export function WindowLoadURL(url) {
+	return window.runtime.WindowLoadURL(url);
+}
+
 /**
  * Place the window in the center of the screen
  *
@@ -140,6 +151,15 @@ export function WindowShow() {
 	window.runtime.WindowShow();
 }
 
+/**
+ * Show the Window without taking the focus from the application the user is in
+ *
+ * @export
+ */
+export function WindowShowNoActivate() {
+	window.runtime.WindowShowNoActivate();
+}
+
 /**
  * Maximise the Window
  *
@@ -185,3 +205,72 @@ export function WindowUnminimise() {
 export function WindowSetRGBA(RGBA) {
 	window.runtime.WindowSetRGBA(RGBA);
 }
+
+/**
+ * Sets whether mouse events pass through the window to the windows beneath it
+ *
+ * @export
+ * @param {boolean} ignore
+ */
+export function WindowSetIgnoreMouseEvents(ignore) {
+	window.runtime.WindowSetIgnoreMouseEvents(ignore);
+}
+
+/**
+ * Sets whether the window is kept beneath all other windows, EG: for desktop widgets
+ *
+ * @export
+ * @param {boolean} bottom
+ */
+export function WindowSetAlwaysOnBottom(bottom) {
+	window.runtime.WindowSetAlwaysOnBottom(bottom);
+}
+
+/**
+ * Makes the window fullscreen, keeps the display on and hides the cursor, EG: for a slideshow
+ *
+ * @export
+ */
+export function EnterPresentationMode() {
+	window.runtime.EnterPresentationMode();
+}
+
+/**
+ * Leaves presentation mode, restoring the state of the window from before it was entered
+ *
+ * @export
+ */
+export function ExitPresentationMode() {
+	window.runtime.ExitPresentationMode();
+}
+
+/**
+ * Enables or disables mouse and keyboard input to the window, EG: while a native dialog is shown
+ *
+ * @export
+ * @param {boolean} enabled
+ */
+export function WindowSetEnabled(enabled) {
+	window.runtime.WindowSetEnabled(enabled);
+}
+
+/**
+ * Returns true if the window accepts mouse and keyboard input
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WindowIsEnabled() {
+	return window.runtime.WindowIsEnabled();
+}
+
+/**
+ * Replaces the buttons in the toolbar of the taskbar thumbnail of the window on Windows
+ *
+ * @export
+ * @param {ThumbnailButton[]} buttons
+ * @return {Promise<void>}
+ */
+export function WindowSetThumbnailButtons(buttons) {
+	return window.runtime.WindowSetThumbnailButtons(buttons);
+}
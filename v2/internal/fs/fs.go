@@ -1,6 +1,7 @@
 package fs
 
 import (
+	"bytes"
 	"crypto/md5"
 	"fmt"
 	"io"
@@ -397,6 +398,57 @@ func MoveDirExtended(src string, dst string, ignore []string) (err error) {
 	return
 }
 
+// SyncDir updates the files in the destination directory to match the files in the
+// source directory. Only files that are new or have changed are written and files
+// that aren't in the source directory are removed, so that file watchers are only
+// triggered for actual changes. Subdirectories are not synced.
+func SyncDir(src string, dst string) error {
+	err := MkDirs(dst)
+	if err != nil {
+		return err
+	}
+
+	srcFiles := map[string]struct{}{}
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		srcFiles[entry.Name()] = struct{}{}
+		content, err := os.ReadFile(filepath.Join(src, entry.Name()))
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dst, entry.Name())
+		existing, err := os.ReadFile(dstPath)
+		if err == nil && bytes.Equal(existing, content) {
+			continue
+		}
+		err = os.WriteFile(dstPath, content, 0755)
+		if err != nil {
+			return err
+		}
+	}
+
+	entries, err = os.ReadDir(dst)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if _, exists := srcFiles[entry.Name()]; exists || !entry.Type().IsRegular() {
+			continue
+		}
+		err = os.Remove(filepath.Join(dst, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func FindPathToFile(fsys fs.FS, file string) (string, error) {
 	stat, _ := fs.Stat(fsys, file)
 	if stat != nil {
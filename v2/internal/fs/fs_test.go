@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matryer/is"
 )
@@ -29,3 +30,33 @@ func TestRelativePath(t *testing.T) {
 	is.Equal(actual, expected)
 
 }
+
+func TestSyncDir(t *testing.T) {
+
+	is := is.New(t)
+
+	src := t.TempDir()
+	dst := t.TempDir()
+	is.NoErr(os.WriteFile(filepath.Join(src, "changed.js"), []byte("new"), 0644))
+	is.NoErr(os.WriteFile(filepath.Join(src, "unchanged.js"), []byte("same"), 0644))
+	is.NoErr(os.WriteFile(filepath.Join(src, "added.js"), []byte("added"), 0644))
+	is.NoErr(os.WriteFile(filepath.Join(dst, "changed.js"), []byte("old"), 0644))
+	is.NoErr(os.WriteFile(filepath.Join(dst, "unchanged.js"), []byte("same"), 0644))
+	is.NoErr(os.WriteFile(filepath.Join(dst, "removed.js"), []byte("removed"), 0644))
+
+	// Unchanged files should not be rewritten
+	past := time.Now().Add(-time.Hour)
+	is.NoErr(os.Chtimes(filepath.Join(dst, "unchanged.js"), past, past))
+
+	is.NoErr(SyncDir(src, dst))
+
+	for name, want := range map[string]string{"changed.js": "new", "unchanged.js": "same", "added.js": "added"} {
+		got, err := os.ReadFile(filepath.Join(dst, name))
+		is.NoErr(err)
+		is.Equal(string(got), want)
+	}
+	is.True(!FileExists(filepath.Join(dst, "removed.js")))
+	info, err := os.Stat(filepath.Join(dst, "unchanged.js"))
+	is.NoErr(err)
+	is.True(info.ModTime().Before(time.Now().Add(-time.Minute)))
+}
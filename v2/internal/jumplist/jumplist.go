@@ -0,0 +1,63 @@
+// Package jumplist manages the jump list shown when the taskbar button of the application is right-clicked
+package jumplist
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// TaskEvent is emitted with the ID of the task when the application is launched from a jump list task
+const TaskEvent = "wails:jumplist:task"
+
+// taskFlag is passed to the application, with the ID of the task, when it is launched from a jump list task
+const taskFlag = "--wails-jumplist-task="
+
+// ErrNotSupported is returned on platforms without jump lists
+var ErrNotSupported = errors.New("jump lists are only supported on Windows")
+
+// Task is an entry in the Tasks category of the jump list. Selecting it launches the application.
+type Task struct {
+	// ID is emitted with TaskEvent when the application is launched from the task
+	ID string `json:"id"`
+	// Title is the text of the entry
+	Title string `json:"title"`
+	// Description is shown as the tooltip of the entry
+	Description string `json:"description"`
+	// IconPath is the file containing the icon of the entry. Defaults to the application executable.
+	IconPath string `json:"iconPath"`
+	// IconIndex is the index of the icon in IconPath
+	IconIndex int `json:"iconIndex"`
+}
+
+// SetTasks replaces the tasks of the jump list. The Recent category, populated by AddRecentDocument,
+// is shown above them.
+func SetTasks(tasks []Task) error {
+	for _, task := range tasks {
+		if task.ID == "" || task.Title == "" {
+			return fmt.Errorf("jump list tasks require an ID and a title")
+		}
+	}
+	return setTasks(tasks)
+}
+
+// AddRecentDocument adds a file to the recent documents of the application
+func AddRecentDocument(path string) error {
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("recent documents require an absolute path: %s", path)
+	}
+	return addRecentDocument(path)
+}
+
+// TaskFromArgs returns the ID of the jump list task the application was launched from, if any,
+// and the arguments without the flag used to pass it
+func TaskFromArgs(args []string) (string, []string) {
+	for index, arg := range args {
+		if strings.HasPrefix(arg, taskFlag) {
+			remaining := append(append([]string{}, args[:index]...), args[index+1:]...)
+			return strings.TrimPrefix(arg, taskFlag), remaining
+		}
+	}
+	return "", args
+}
@@ -0,0 +1,14 @@
+//go:build wails.nojumplist
+// +build wails.nojumplist
+
+package jumplist
+
+import "github.com/wailsapp/wails/v2/internal/features"
+
+func setTasks(_ []Task) error {
+	return features.Disabled(features.JumpList)
+}
+
+func addRecentDocument(_ string) error {
+	return features.Disabled(features.JumpList)
+}
@@ -0,0 +1,12 @@
+//go:build !windows && !wails.nojumplist
+// +build !windows,!wails.nojumplist
+
+package jumplist
+
+func setTasks(_ []Task) error {
+	return ErrNotSupported
+}
+
+func addRecentDocument(_ string) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,39 @@
+package jumplist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTaskFromArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		wantID        string
+		wantRemaining []string
+	}{
+		{"no task", []string{"app.exe", "-debug"}, "", []string{"app.exe", "-debug"}},
+		{"task", []string{"app.exe", "--wails-jumplist-task=new-window"}, "new-window", []string{"app.exe"}},
+		{"task with other args", []string{"app.exe", "-a", "--wails-jumplist-task=open", "-b"}, "open", []string{"app.exe", "-a", "-b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotID, gotRemaining := TaskFromArgs(tt.args)
+			if gotID != tt.wantID {
+				t.Errorf("TaskFromArgs() id = %s, want %s", gotID, tt.wantID)
+			}
+			if !reflect.DeepEqual(gotRemaining, tt.wantRemaining) {
+				t.Errorf("TaskFromArgs() remaining = %v, want %v", gotRemaining, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestSetTasksValidation(t *testing.T) {
+	if err := SetTasks([]Task{{ID: "open"}}); err == nil {
+		t.Error("SetTasks() should require a title")
+	}
+	if err := SetTasks([]Task{{Title: "Open"}}); err == nil {
+		t.Error("SetTasks() should require an ID")
+	}
+}
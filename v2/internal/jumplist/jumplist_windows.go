@@ -0,0 +1,221 @@
+//go:build windows && !wails.nojumplist
+// +build windows,!wails.nojumplist
+
+package jumplist
+
+import (
+	"os"
+	"runtime"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/com"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modshell32            = windows.NewLazySystemDLL("shell32.dll")
+	procSHAddToRecentDocs = modshell32.NewProc("SHAddToRecentDocs")
+)
+
+const (
+	clsidDestinationList            = "{77F10CF0-3DB5-4966-B520-B7C54FD35ED6}"
+	iidICustomDestinationList       = "{6332DEBF-87B5-4670-90C0-5E57B408A49E}"
+	clsidEnumerableObjectCollection = "{2D3468C1-36A7-43B6-AC24-D3F02FD9607A}"
+	iidIObjectCollection            = "{5632B1A4-E38A-400A-928A-D4CD63230295}"
+	iidIObjectArray                 = "{92CA9DCD-5622-4BBA-A805-5E9F541BD8C9}"
+	clsidShellLink                  = "{00021401-0000-0000-C000-000000000046}"
+	iidIShellLinkW                  = "{000214F9-0000-0000-C000-000000000046}"
+	iidIPropertyStore               = "{886D8EEB-8CF2-4446-8D02-CDBA1DBDCF99}"
+	// The format ID and property ID of PKEY_Title
+	fmtidTitle = "{F29F85E0-4FF9-1068-AB91-08002B27B3D9}"
+	pidTitle   = 2
+)
+
+// The vtable indexes of the methods used
+const (
+	// ICustomDestinationList
+	customDestinationListBeginList           = 4
+	customDestinationListAppendKnownCategory = 6
+	customDestinationListAddUserTasks        = 7
+	customDestinationListCommitList          = 8
+	// IObjectCollection
+	objectCollectionAddObject = 5
+	// IShellLinkW
+	shellLinkSetDescription  = 7
+	shellLinkSetArguments    = 11
+	shellLinkSetIconLocation = 17
+	shellLinkSetPath         = 20
+	// IPropertyStore
+	propertyStoreSetValue = 6
+	propertyStoreCommit   = 7
+)
+
+const (
+	_KDC_RECENT  = 2
+	_SHARD_PATHW = 3
+	_VT_LPWSTR   = 31
+)
+
+type _PROPERTYKEY struct {
+	fmtid windows.GUID
+	pid   uint32
+}
+
+// PROPVARIANT holding a string
+type _PROPVARIANT struct {
+	vt       uint16
+	reserved [3]uint16
+	value    *uint16
+	padding  uintptr
+}
+
+func addRecentDocument(path string) error {
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	procSHAddToRecentDocs.Call(_SHARD_PATHW, uintptr(unsafe.Pointer(pathPtr)))
+	return nil
+}
+
+func setTasks(tasks []Task) error {
+	// COM objects must be used on the thread they were created on
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	uninitialize, err := com.Initialize()
+	if err != nil {
+		return err
+	}
+	defer uninitialize()
+
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	list, err := com.CreateInstance(clsidDestinationList, iidICustomDestinationList)
+	if err != nil {
+		return err
+	}
+	defer list.Release()
+
+	// The list is tied to the AppUserModelID of the process
+	iidRemoved, err := windows.GUIDFromString(iidIObjectArray)
+	if err != nil {
+		return err
+	}
+	var minSlots uint32
+	var removed *com.Object
+	err = list.Call(customDestinationListBeginList,
+		uintptr(unsafe.Pointer(&minSlots)),
+		uintptr(unsafe.Pointer(&iidRemoved)),
+		uintptr(unsafe.Pointer(&removed)))
+	if err != nil {
+		return err
+	}
+	removed.Release()
+
+	// Recent documents are only listed if the application is registered to open their file type,
+	// so failing to add the category isn't an error
+	_ = list.Call(customDestinationListAppendKnownCategory, _KDC_RECENT)
+
+	if len(tasks) > 0 {
+		collection, err := com.CreateInstance(clsidEnumerableObjectCollection, iidIObjectCollection)
+		if err != nil {
+			return err
+		}
+		defer collection.Release()
+
+		for _, task := range tasks {
+			link, err := newTaskLink(exe, task)
+			if err != nil {
+				return err
+			}
+			err = collection.Call(objectCollectionAddObject, uintptr(unsafe.Pointer(link)))
+			link.Release()
+			if err != nil {
+				return err
+			}
+		}
+
+		taskArray, err := collection.QueryInterface(iidIObjectArray)
+		if err != nil {
+			return err
+		}
+		err = list.Call(customDestinationListAddUserTasks, uintptr(unsafe.Pointer(taskArray)))
+		taskArray.Release()
+		if err != nil {
+			return err
+		}
+	}
+
+	return list.Call(customDestinationListCommitList)
+}
+
+// newTaskLink creates a shell link that launches the application with the flag for the given task
+func newTaskLink(exe string, task Task) (*com.Object, error) {
+	link, err := com.CreateInstance(clsidShellLink, iidIShellLinkW)
+	if err != nil {
+		return nil, err
+	}
+
+	iconPath := task.IconPath
+	if iconPath == "" {
+		iconPath = exe
+	}
+	err = setLinkString(link, shellLinkSetPath, exe)
+	if err == nil {
+		err = setLinkString(link, shellLinkSetArguments, taskFlag+task.ID)
+	}
+	if err == nil && task.Description != "" {
+		err = setLinkString(link, shellLinkSetDescription, task.Description)
+	}
+	if err == nil {
+		var iconPathPtr *uint16
+		iconPathPtr, err = windows.UTF16PtrFromString(iconPath)
+		if err == nil {
+			err = link.Call(shellLinkSetIconLocation, uintptr(unsafe.Pointer(iconPathPtr)), uintptr(task.IconIndex))
+		}
+	}
+	if err == nil {
+		err = setLinkTitle(link, task.Title)
+	}
+	if err != nil {
+		link.Release()
+		return nil, err
+	}
+	return link, nil
+}
+
+func setLinkString(link *com.Object, method int, value string) error {
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return err
+	}
+	return link.Call(method, uintptr(unsafe.Pointer(valuePtr)))
+}
+
+// setLinkTitle sets the text shown for a shell link in the jump list
+func setLinkTitle(link *com.Object, title string) error {
+	store, err := link.QueryInterface(iidIPropertyStore)
+	if err != nil {
+		return err
+	}
+	defer store.Release()
+
+	fmtid, err := windows.GUIDFromString(fmtidTitle)
+	if err != nil {
+		return err
+	}
+	key := _PROPERTYKEY{fmtid: fmtid, pid: pidTitle}
+	titlePtr, err := windows.UTF16PtrFromString(title)
+	if err != nil {
+		return err
+	}
+	value := _PROPVARIANT{vt: _VT_LPWSTR, value: titlePtr}
+	err = store.Call(propertyStoreSetValue, uintptr(unsafe.Pointer(&key)), uintptr(unsafe.Pointer(&value)))
+	if err != nil {
+		return err
+	}
+	return store.Call(propertyStoreCommit)
+}
@@ -2,9 +2,11 @@ package project
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 )
 
@@ -15,17 +17,56 @@ type Project struct {
 	Name           string `json:"name"`
 	AssetDirectory string `json:"assetdir,omitempty"`
 
+	// Identifier uniquely identifies the application in reverse-DNS form, EG: "com.mycompany.myapp".
+	// It is used as the macOS bundle ID, the default Windows AppUserModelID and the namespace of the secure storage.
+	Identifier string `json:"identifier,omitempty"`
+
 	ReloadDirectories string `json:"reloaddirs,omitempty"`
 
 	BuildCommand   string `json:"frontend:build"`
 	InstallCommand string `json:"frontend:install"`
 	DevCommand     string `json:"frontend:dev"`
 
+	// Directory, relative to the project directory, that the frontend:build command writes the assets to.
+	// `wails build` uses `assetdir` or `frontend/dist` if it is empty.
+	FrontendBuildDir string `json:"frontend:build:dir,omitempty"`
+
 	// Directory to generate the API Module
 	WailsJSDir string `json:"wailsjsdir"`
 
+	// Generate JSDoc for the bindings from the Go comments of the bound methods and models
+	BindingsJSDoc bool `json:"bindings:jsdoc,omitempty"`
+
 	Version string `json:"version"`
 
+	// Files and directories, relative to the project directory, that are embedded in the
+	// application and read with runtime.Assets
+	EmbedFiles []string `json:"embed,omitempty"`
+
+	// Linker flags and build tags for specific targets, keyed by platform or platform/arch, EG: "windows" or
+	// "darwin/arm64". They are added to the flags given to `wails build`.
+	TargetLDFlags map[string]string `json:"build:ldflags,omitempty"`
+	TargetTags    map[string]string `json:"build:tags,omitempty"`
+
+	// Files copied next to the built application by `wails build`, or into the Resources of the
+	// application bundle on macOS
+	ExtraFiles []ExtraFile `json:"build:extrafiles,omitempty"`
+
+	// Files copied into the Contents directory of the macOS application bundle, such as helpers and frameworks.
+	// The destinations are relative to Contents and must be in MacOS, Resources, Frameworks or Helpers.
+	DarwinContents []ExtraFile `json:"build:darwin:contents,omitempty"`
+
+	// Glob patterns of the files excluded from the embedded frontend assets by `wails build -excludeassets`,
+	// EG: "*.map". Source maps are excluded if it is empty.
+	AssetExcludes []string `json:"build:excludeassets,omitempty"`
+
+	// The runtime features included in the application, EG: "clipboard" or "dialogs". The others are
+	// compiled out, to reduce the size of the application. Every feature is included if it isn't set.
+	RuntimeFeatures *[]string `json:"runtime:features,omitempty"`
+
+	// Information about the application
+	Info Info `json:"info"`
+
 	/*** Internal Data ***/
 
 	// The path to the project directory
@@ -52,7 +93,7 @@ type Project struct {
 	// The debounce time for hot-reload of the built-in dev server. Default 100
 	DebounceMS int `json:"debounceMS"`
 
-	// The url to use to server assets. Default "https://localhost:34115"
+	// The url to use to server assets. Default "http://localhost:34115"
 	DevServerURL string `json:"devserverurl"`
 
 	// Arguments that are forwared to the application in dev mode
@@ -67,6 +108,75 @@ func (p *Project) Save() error {
 	return os.WriteFile(p.filename, data, 0755)
 }
 
+// LDFlags returns the linker flags configured for the given target. The flags for the
+// platform are followed by the flags for the platform and architecture.
+func (p *Project) LDFlags(platform string, arch string) string {
+	var result []string
+	for _, key := range targetKeys(platform, arch) {
+		if flags := strings.TrimSpace(p.TargetLDFlags[key]); flags != "" {
+			result = append(result, flags)
+		}
+	}
+	return strings.Join(result, " ")
+}
+
+// Tags returns the build tags configured for the given target. The tags for the
+// platform are followed by the tags for the platform and architecture.
+func (p *Project) Tags(platform string, arch string) []string {
+	var result []string
+	for _, key := range targetKeys(platform, arch) {
+		result = append(result, strings.Fields(p.TargetTags[key])...)
+	}
+	return result
+}
+
+// targetKeys returns the keys of the target config for the given target
+func targetKeys(platform string, arch string) []string {
+	return []string{platform, platform + "/" + arch}
+}
+
+// ExtraFile is a file, directory or glob pattern copied next to the built application
+type ExtraFile struct {
+	// The path or glob pattern of the files, relative to the project directory, EG: "config.yaml" or "licenses/*"
+	Source string `json:"source"`
+	// The path the files are copied to, relative to the output directory. A file or directory is renamed to it,
+	// and the files matched by a glob pattern are copied into it. Defaults to the name of the source, or the
+	// output directory for glob patterns.
+	Destination string `json:"destination,omitempty"`
+	// The targets the files are copied for, EG: "windows" or "darwin/arm64". They are copied for every target
+	// if it is empty.
+	Platforms []string `json:"platforms,omitempty"`
+}
+
+// IncludedFor returns true if the file is copied when building for the given target
+func (e ExtraFile) IncludedFor(platform string, arch string) bool {
+	if len(e.Platforms) == 0 {
+		return true
+	}
+	keys := targetKeys(platform, arch)
+	for _, target := range e.Platforms {
+		target = strings.TrimSpace(target)
+		if target == keys[0] || target == keys[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// Info stores information about the application
+type Info struct {
+	// The version of the application, EG: 1.0.0
+	ProductVersion string `json:"productVersion,omitempty"`
+	// The copyright notice shown in the about dialog
+	Copyright string `json:"copyright,omitempty"`
+	// The version shown to users, EG: 1.2.3. It is the CFBundleShortVersionString on macOS and the product
+	// version on Windows.
+	ShortVersion string `json:"shortVersion,omitempty"`
+	// The number of the build, which increases with each release, EG: 42. It is the CFBundleVersion on macOS and
+	// the last part of the file version on Windows. Defaults to the build number of the CI service.
+	BuildNumber int `json:"buildNumber,omitempty"`
+}
+
 // Author stores details about the application author
 type Author struct {
 	Name  string `json:"name"`
@@ -117,3 +227,62 @@ func Load(projectPath string) (*Project, error) {
 	// Return our project data
 	return &result, nil
 }
+
+// The maximum length of an identifier, limited by the AppUserModelID on Windows
+const maxIdentifierLength = 128
+
+// ValidateIdentifier checks that the identifier is in reverse-DNS form, EG: "com.mycompany.myapp".
+// It must have at least two parts separated by dots, made of letters, digits and hyphens.
+func ValidateIdentifier(identifier string) error {
+	if len(identifier) > maxIdentifierLength {
+		return fmt.Errorf("invalid identifier '%s': it is longer than %d characters", identifier, maxIdentifierLength)
+	}
+	parts := strings.Split(identifier, ".")
+	if len(parts) < 2 {
+		return fmt.Errorf("invalid identifier '%s': it should be in reverse-DNS form, EG: com.mycompany.myapp", identifier)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return fmt.Errorf("invalid identifier '%s': it has an empty part", identifier)
+		}
+		for _, r := range part {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return fmt.Errorf("invalid identifier '%s': it may only contain letters, digits, hyphens and dots", identifier)
+			}
+		}
+	}
+	return nil
+}
+
+// The maximum value of a part of a version, limited by the version information of Windows binaries
+const maxVersionPart = 65535
+
+// ValidateShortVersion checks that the short version is made of one to three numbers separated by dots, EG: "1.2.3"
+func ValidateShortVersion(version string) error {
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 {
+		return fmt.Errorf("invalid short version '%s': it should have at most 3 parts, EG: 1.2.3", version)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return fmt.Errorf("invalid short version '%s': it has an empty part", version)
+		}
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return fmt.Errorf("invalid short version '%s': it may only contain digits and dots", version)
+			}
+		}
+		if number, err := strconv.Atoi(part); err != nil || number > maxVersionPart {
+			return fmt.Errorf("invalid short version '%s': each part must be at most %d", version, maxVersionPart)
+		}
+	}
+	return nil
+}
+
+// ValidateBuildNumber checks that the build number is positive
+func ValidateBuildNumber(buildNumber int) error {
+	if buildNumber < 1 {
+		return fmt.Errorf("invalid build number %d: it must be a positive integer", buildNumber)
+	}
+	return nil
+}
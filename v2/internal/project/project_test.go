@@ -0,0 +1,78 @@
+package project
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateIdentifier(t *testing.T) {
+	tests := []struct {
+		identifier string
+		wantErr    bool
+	}{
+		{"com.mycompany.myapp", false},
+		{"io.github.my-app", false},
+		{"com.Company2.App", false},
+		{"myapp", true},
+		{"com..myapp", true},
+		{".com.myapp", true},
+		{"com.myapp.", true},
+		{"com.my app", true},
+		{"com.my_app", true},
+		{"com." + strings.Repeat("a", 130), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			if err := ValidateIdentifier(tt.identifier); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateIdentifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtraFileIncludedFor(t *testing.T) {
+	tests := []struct {
+		platforms []string
+		platform  string
+		arch      string
+		want      bool
+	}{
+		{nil, "windows", "amd64", true},
+		{[]string{"windows"}, "windows", "arm64", true},
+		{[]string{"windows"}, "darwin", "arm64", false},
+		{[]string{"darwin/arm64"}, "darwin", "arm64", true},
+		{[]string{"darwin/arm64"}, "darwin", "amd64", false},
+		{[]string{"linux", "darwin/universal"}, "darwin", "universal", true},
+	}
+	for _, tt := range tests {
+		file := ExtraFile{Source: "config.yaml", Platforms: tt.platforms}
+		if got := file.IncludedFor(tt.platform, tt.arch); got != tt.want {
+			t.Errorf("%v for %s/%s: expected %t, got %t", tt.platforms, tt.platform, tt.arch, tt.want, got)
+		}
+	}
+}
+
+func TestValidateShortVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		wantErr bool
+	}{
+		{"1", false},
+		{"1.2", false},
+		{"1.2.3", false},
+		{"10.0.65535", false},
+		{"1.2.3.4", true},
+		{"1..3", true},
+		{"v1.2.3", true},
+		{"1.2.3-beta", true},
+		{"1.2.65536", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if err := ValidateShortVersion(tt.version); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateShortVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Package securestorage stores secrets in the credential store of the operating system
+package securestorage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// The codes of the errors returned by the secure storage
+const (
+	CodeNotFound     = "NotFound"
+	CodeAccessDenied = "AccessDenied"
+	CodeNotSupported = "NotSupported"
+	CodeInvalidKey   = "InvalidKey"
+)
+
+// Error is returned by the secure storage. It is sent to the frontend with its code,
+// so the frontend can tell a missing secret from a failure.
+type Error struct {
+	Code    string
+	Key     string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Key == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("secure storage '%s': %s", e.Key, e.Message)
+}
+
+// Is reports whether the target is an Error with the same code, so errors.Is(err, ErrNotFound) works
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// ErrorCode implements runtime.CallError
+func (e *Error) ErrorCode() string {
+	return e.Code
+}
+
+// ErrorDetails implements runtime.CallError
+func (e *Error) ErrorDetails() map[string]interface{} {
+	if e.Key == "" {
+		return nil
+	}
+	return map[string]interface{}{"key": e.Key}
+}
+
+// The errors returned by the secure storage, for use with errors.Is
+var (
+	ErrNotFound     = &Error{Code: CodeNotFound, Message: "secret not found"}
+	ErrAccessDenied = &Error{Code: CodeAccessDenied, Message: "access denied"}
+	ErrNotSupported = &Error{Code: CodeNotSupported, Message: "secure storage is not supported on this platform yet"}
+)
+
+func newError(code string, key string, message string) *Error {
+	return &Error{Code: code, Key: key, Message: message}
+}
+
+// Get returns the secret stored with the given key
+func Get(key string) (string, error) {
+	target, err := targetName(key)
+	if err != nil {
+		return "", err
+	}
+	value, err := get(target, key)
+	if errors.Is(err, ErrNotFound) {
+		if legacy := legacyTargetName(key); legacy != "" {
+			return migrate(legacy, target, key)
+		}
+	}
+	return value, err
+}
+
+// Set stores the secret with the given key, replacing any existing secret
+func Set(key string, value string) error {
+	target, err := targetName(key)
+	if err != nil {
+		return err
+	}
+	return set(target, key, value)
+}
+
+// Delete deletes the secret stored with the given key
+func Delete(key string) error {
+	target, err := targetName(key)
+	if err != nil {
+		return err
+	}
+	err = remove(target, key)
+	if legacy := legacyTargetName(key); legacy != "" && (err == nil || errors.Is(err, ErrNotFound)) {
+		// The secret may not have been migrated yet
+		if legacyErr := remove(legacy, key); !errors.Is(legacyErr, ErrNotFound) {
+			err = legacyErr
+		}
+	}
+	return err
+}
+
+// migrate moves the secret stored with the name used before the namespace was set to its target name.
+// The secret is returned even if it can't be moved: the old copy is only read while the secret is missing,
+// and it is deleted by Delete.
+func migrate(legacy string, target string, key string) (string, error) {
+	value, err := get(legacy, key)
+	if err != nil {
+		return "", err
+	}
+	if set(target, key, value) == nil {
+		remove(legacy, key)
+	}
+	return value, nil
+}
+
+// namespace is the prefix of the names the secrets are stored as. The name of the
+// executable is used when it is empty.
+var namespace string
+
+// SetNamespace sets the prefix of the names the secrets are stored as, EG: the identifier
+// of the application. It must be called before any secret is read or written.
+// The secrets stored with the name of the executable before it was set are moved when they are read.
+func SetNamespace(name string) {
+	namespace = name
+}
+
+// targetName returns the name the secret is stored as. Secrets are namespaced by the
+// identifier of the application, or the name of the executable if it isn't set, so
+// applications don't share secrets, EG: `myapp/token`.
+func targetName(key string) (string, error) {
+	if key == "" {
+		return "", newError(CodeInvalidKey, key, "key is empty")
+	}
+	if namespace != "" {
+		return namespace + "/" + key, nil
+	}
+	name, err := executableName()
+	if err != nil {
+		return "", err
+	}
+	return name + "/" + key, nil
+}
+
+// legacyTargetName returns the name the secret was stored as before the namespace was set,
+// or an empty string if it is the same as the target name
+func legacyTargetName(key string) string {
+	name, err := executableName()
+	if err != nil || namespace == "" || namespace == name {
+		return ""
+	}
+	return name + "/" + key
+}
+
+func executableName() (string, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(filepath.Base(executable), ".exe"), nil
+}
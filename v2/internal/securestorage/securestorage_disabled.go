@@ -0,0 +1,18 @@
+//go:build wails.nosecurestorage
+// +build wails.nosecurestorage
+
+package securestorage
+
+import "github.com/wailsapp/wails/v2/internal/features"
+
+func get(_ string, _ string) (string, error) {
+	return "", features.Disabled(features.SecureStorage)
+}
+
+func set(_ string, _ string, _ string) error {
+	return features.Disabled(features.SecureStorage)
+}
+
+func remove(_ string, _ string) error {
+	return features.Disabled(features.SecureStorage)
+}
@@ -0,0 +1,18 @@
+//go:build !windows && !wails.nosecurestorage
+// +build !windows,!wails.nosecurestorage
+
+package securestorage
+
+// The Keychain on macOS and the Secret Service on Linux are planned
+
+func get(_ string, _ string) (string, error) {
+	return "", ErrNotSupported
+}
+
+func set(_ string, _ string, _ string) error {
+	return ErrNotSupported
+}
+
+func remove(_ string, _ string) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,58 @@
+package securestorage
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestError_Is(t *testing.T) {
+	err := error(newError(CodeNotFound, "token", ErrNotFound.Message))
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("expected error to be ErrNotFound")
+	}
+	if errors.Is(err, ErrAccessDenied) {
+		t.Error("expected error not to be ErrAccessDenied")
+	}
+	if got := err.Error(); got != "secure storage 'token': secret not found" {
+		t.Errorf("Error() = %s", got)
+	}
+}
+
+func TestGet_emptyKey(t *testing.T) {
+	_, err := Get("")
+	var storageErr *Error
+	if !errors.As(err, &storageErr) || storageErr.ErrorCode() != CodeInvalidKey {
+		t.Errorf("Get() error = %v, want %s", err, CodeInvalidKey)
+	}
+}
+
+func TestTargetName_namespace(t *testing.T) {
+	SetNamespace("com.wails.myapp")
+	defer SetNamespace("")
+	got, err := targetName("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "com.wails.myapp/token" {
+		t.Errorf("targetName() = %s, want com.wails.myapp/token", got)
+	}
+}
+
+func TestLegacyTargetName(t *testing.T) {
+	if got := legacyTargetName("token"); got != "" {
+		t.Errorf("legacyTargetName() without a namespace = %s, want an empty string", got)
+	}
+	name, err := executableName()
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetNamespace("com.wails.myapp")
+	defer SetNamespace("")
+	if got := legacyTargetName("token"); got != name+"/token" {
+		t.Errorf("legacyTargetName() = %s, want %s/token", got, name)
+	}
+	SetNamespace(name)
+	if got := legacyTargetName("token"); got != "" {
+		t.Errorf("legacyTargetName() with the executable name = %s, want an empty string", got)
+	}
+}
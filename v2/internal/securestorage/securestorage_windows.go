@@ -0,0 +1,109 @@
+//go:build windows && !wails.nosecurestorage
+// +build windows,!wails.nosecurestorage
+
+package securestorage
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modadvapi32    = windows.NewLazySystemDLL("advapi32.dll")
+	procCredReadW  = modadvapi32.NewProc("CredReadW")
+	procCredWriteW = modadvapi32.NewProc("CredWriteW")
+	procCredDelete = modadvapi32.NewProc("CredDeleteW")
+	procCredFree   = modadvapi32.NewProc("CredFree")
+)
+
+const (
+	_CRED_TYPE_GENERIC          = 1
+	_CRED_PERSIST_LOCAL_MACHINE = 2
+	// The maximum size of a generic credential
+	_CRED_MAX_CREDENTIAL_BLOB_SIZE = 5 * 512
+)
+
+// CREDENTIALW struct
+type _CREDENTIAL struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        windows.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+func get(target string, key string) (string, error) {
+	targetName, err := windows.UTF16PtrFromString(target)
+	if err != nil {
+		return "", err
+	}
+	var credential *_CREDENTIAL
+	ret, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(targetName)), _CRED_TYPE_GENERIC, 0, uintptr(unsafe.Pointer(&credential)))
+	if ret == 0 {
+		return "", credentialError(err, key)
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(credential)))
+
+	if credential.CredentialBlobSize == 0 {
+		return "", nil
+	}
+	blob := unsafe.Slice(credential.CredentialBlob, credential.CredentialBlobSize)
+	return string(blob), nil
+}
+
+func set(target string, key string, value string) error {
+	if len(value) > _CRED_MAX_CREDENTIAL_BLOB_SIZE {
+		return fmt.Errorf("secure storage '%s': the secret is larger than %d bytes", key, _CRED_MAX_CREDENTIAL_BLOB_SIZE)
+	}
+	targetName, err := windows.UTF16PtrFromString(target)
+	if err != nil {
+		return err
+	}
+	credential := _CREDENTIAL{
+		Type:               _CRED_TYPE_GENERIC,
+		TargetName:         targetName,
+		CredentialBlobSize: uint32(len(value)),
+		Persist:            _CRED_PERSIST_LOCAL_MACHINE,
+	}
+	if len(value) > 0 {
+		blob := []byte(value)
+		credential.CredentialBlob = &blob[0]
+	}
+	ret, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&credential)), 0)
+	if ret == 0 {
+		return credentialError(err, key)
+	}
+	return nil
+}
+
+func remove(target string, key string) error {
+	targetName, err := windows.UTF16PtrFromString(target)
+	if err != nil {
+		return err
+	}
+	ret, _, err := procCredDelete.Call(uintptr(unsafe.Pointer(targetName)), _CRED_TYPE_GENERIC, 0)
+	if ret == 0 {
+		return credentialError(err, key)
+	}
+	return nil
+}
+
+// credentialError converts the error of a Cred* function to an Error
+func credentialError(err error, key string) error {
+	switch err {
+	case windows.ERROR_NOT_FOUND:
+		return newError(CodeNotFound, key, ErrNotFound.Message)
+	case windows.ERROR_ACCESS_DENIED, windows.ERROR_NO_SUCH_LOGON_SESSION:
+		return newError(CodeAccessDenied, key, ErrAccessDenied.Message)
+	}
+	return fmt.Errorf("secure storage '%s': %s", key, err.Error())
+}
@@ -0,0 +1,132 @@
+// Package settings persists the settings of an application as JSON in the user's config directory
+package settings
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Store is a key-value store of settings, saved to a JSON file.
+// The file is read again before each change, so changes made by other instances of the
+// application aren't lost, and written atomically.
+type Store struct {
+	path string
+
+	lock     sync.Mutex
+	settings map[string]json.RawMessage
+}
+
+// New creates a store for the given application, saved to `<user config dir>/<appName>/settings.json`,
+// EG: `%AppData%\myapp\settings.json` on Windows
+func New(appName string) (*Store, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return NewWithPath(filepath.Join(configDir, appName, "settings.json")), nil
+}
+
+// NewWithPath creates a store saved to the given file
+func NewWithPath(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the path of the settings file
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Get returns the JSON value of the given key and whether it exists
+func (s *Store) Get(key string) (json.RawMessage, bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.settings == nil {
+		err := s.load()
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	value, exists := s.settings[key]
+	return value, exists, nil
+}
+
+// Set sets the value of the given key. The value is marshalled to JSON.
+func (s *Store) Set(key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return s.update(func(settings map[string]json.RawMessage) {
+		settings[key] = data
+	})
+}
+
+// Delete deletes the given key
+func (s *Store) Delete(key string) error {
+	return s.update(func(settings map[string]json.RawMessage) {
+		delete(settings, key)
+	})
+}
+
+// update loads the latest settings, applies the given change and saves them
+func (s *Store) update(change func(settings map[string]json.RawMessage)) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	err := s.load()
+	if err != nil {
+		return err
+	}
+	change(s.settings)
+	return s.save()
+}
+
+func (s *Store) load() error {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		s.settings = map[string]json.RawMessage{}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	settings := map[string]json.RawMessage{}
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &settings)
+		if err != nil {
+			return err
+		}
+	}
+	s.settings = settings
+	return nil
+}
+
+// save writes the settings to a temporary file which then replaces the settings file,
+// so the file is never partially written
+func (s *Store) save() error {
+	data, err := json.MarshalIndent(s.settings, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(s.path)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(dir, "settings-*.json")
+	if err != nil {
+		return err
+	}
+	_, err = temp.Write(data)
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(temp.Name(), s.path)
+	}
+	if err != nil {
+		_ = os.Remove(temp.Name())
+	}
+	return err
+}
@@ -0,0 +1,59 @@
+package settings
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "myapp", "settings.json")
+	store := NewWithPath(path)
+
+	if _, exists, err := store.Get("theme"); err != nil || exists {
+		t.Fatalf("Get() on a new store = %v, %v", exists, err)
+	}
+	if err := store.Set("theme", "dark"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Changes made by another instance are kept
+	other := NewWithPath(path)
+	if err := other.Set("volume", 11); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("missing"); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := NewWithPath(path)
+	for key, want := range map[string]string{"theme": `"dark"`, "volume": "11"} {
+		value, exists, err := reloaded.Get(key)
+		if err != nil || !exists || string(value) != want {
+			t.Errorf("Get(%s) = %s, %v, %v, want %s", key, value, exists, err, want)
+		}
+	}
+}
+
+func TestStore_concurrentWrites(t *testing.T) {
+	store := NewWithPath(filepath.Join(t.TempDir(), "settings.json"))
+	keys := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			if err := store.Set(key, true); err != nil {
+				t.Error(err)
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	reloaded := NewWithPath(store.Path())
+	for _, key := range keys {
+		if _, exists, _ := reloaded.Get(key); !exists {
+			t.Errorf("%s was lost", key)
+		}
+	}
+}
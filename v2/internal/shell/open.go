@@ -0,0 +1,61 @@
+package shell
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// allowedSchemes are the URL schemes that may be opened. Other schemes, EG: `file` or custom
+// protocol handlers, could be used to launch arbitrary executables.
+var allowedSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// ValidateURL returns an error if the given URL can't be opened safely
+func ValidateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL '%s': %s", rawURL, err.Error())
+	}
+	if !allowedSchemes[strings.ToLower(parsed.Scheme)] {
+		return fmt.Errorf("cannot open URL '%s': only http, https and mailto URLs may be opened", rawURL)
+	}
+	if parsed.Scheme != "mailto" && parsed.Host == "" {
+		return fmt.Errorf("invalid URL '%s': no host", rawURL)
+	}
+	return nil
+}
+
+// OpenURL opens the given URL in the default browser, or email client for mailto URLs
+func OpenURL(rawURL string) error {
+	err := ValidateURL(rawURL)
+	if err != nil {
+		return err
+	}
+	return openURL(rawURL)
+}
+
+// OpenFile opens the given file in the application associated with its type.
+// Executables, scripts and directories are not opened.
+func OpenFile(path string) error {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("cannot open '%s': it is a directory", path)
+	}
+	if isExecutable(path, info) {
+		return fmt.Errorf("cannot open '%s': it is an executable", path)
+	}
+	return openFile(path)
+}
@@ -0,0 +1,25 @@
+//go:build !windows
+// +build !windows
+
+package shell
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/browser"
+)
+
+func openURL(url string) error {
+	return browser.OpenURL(url)
+}
+
+func openFile(path string) error {
+	return browser.OpenFile(path)
+}
+
+// isExecutable returns true for files with an executable bit, which may be run rather than opened,
+// and application bundles
+func isExecutable(path string, info os.FileInfo) bool {
+	return info.Mode()&0111 != 0 || strings.HasSuffix(strings.TrimSuffix(path, "/"), ".app")
+}
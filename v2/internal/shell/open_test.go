@@ -0,0 +1,29 @@
+package shell
+
+import "testing"
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://wails.io", false},
+		{"http://localhost:3000/docs", false},
+		{"HTTPS://wails.io", false},
+		{"mailto:someone@example.com", false},
+		{"file:///C:/Windows/System32/calc.exe", true},
+		{"C:\\Windows\\System32\\calc.exe", true},
+		{"/usr/bin/xterm", true},
+		{"ms-settings:privacy", true},
+		{"javascript:alert(1)", true},
+		{"https://", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if err := ValidateURL(tt.url); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
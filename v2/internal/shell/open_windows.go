@@ -0,0 +1,51 @@
+//go:build windows
+// +build windows
+
+package shell
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+func openURL(url string) error {
+	return shellExecute(url)
+}
+
+func openFile(path string) error {
+	return shellExecute(path)
+}
+
+func shellExecute(file string) error {
+	verb, err := windows.UTF16PtrFromString("open")
+	if err != nil {
+		return err
+	}
+	target, err := windows.UTF16PtrFromString(file)
+	if err != nil {
+		return err
+	}
+	return windows.ShellExecute(0, verb, target, nil, nil, windows.SW_SHOWNORMAL)
+}
+
+// isExecutable returns true for files that Windows runs rather than opens, EG: `.exe`, `.bat`,
+// shortcuts and the other extensions in PATHEXT
+func isExecutable(path string, _ os.FileInfo) bool {
+	extensions := []string{".exe", ".com", ".bat", ".cmd", ".vbs", ".vbe", ".js", ".jse", ".wsf", ".wsh",
+		".msc", ".msi", ".msp", ".ps1", ".scr", ".pif", ".lnk", ".url", ".cpl", ".hta", ".reg", ".appref-ms"}
+	for _, extension := range strings.Split(os.Getenv("PATHEXT"), ";") {
+		if extension != "" {
+			extensions = append(extensions, extension)
+		}
+	}
+	pathExtension := filepath.Ext(path)
+	for _, extension := range extensions {
+		if strings.EqualFold(pathExtension, extension) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+//go:build !windows
+// +build !windows
+
+package startup
+
+import (
+	"runtime"
+	"syscall"
+)
+
+// peakMemory returns the maximum resident set size of the process in bytes
+func peakMemory() uint64 {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return 0
+	}
+	// Linux reports kilobytes and macOS reports bytes
+	if runtime.GOOS == "darwin" {
+		return uint64(usage.Maxrss)
+	}
+	return uint64(usage.Maxrss) * 1024
+}
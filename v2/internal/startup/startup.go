@@ -0,0 +1,124 @@
+// Package startup records how long the phases of starting the application take, for performance testing.
+package startup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+)
+
+// processStart is the time the package was initialised, before main is called
+var processStart = time.Now()
+
+// ErrNotRecorded is returned when the metrics are requested from an application that doesn't record them
+var ErrNotRecorded = errors.New("startup metrics are only recorded in debug builds and builds made with -profile")
+
+// Phase is a phase of starting the application
+type Phase int
+
+const (
+	// WindowCreated is when the native window has been created
+	WindowCreated Phase = iota
+	// WebviewReady is when the webview has been created in the window. On macOS and Linux, where the webview
+	// starts its content process when it loads the first page, it is when the first page is committed.
+	WebviewReady
+	// DomReady is when the DOM of the first page has loaded
+	DomReady
+)
+
+func (p Phase) String() string {
+	switch p {
+	case WindowCreated:
+		return "Window created"
+	case WebviewReady:
+		return "Webview ready"
+	case DomReady:
+		return "DOM ready"
+	}
+	return fmt.Sprintf("Phase(%d)", int(p))
+}
+
+// Metrics are the times at which the phases of starting the application were reached, since the process
+// started. The time of a phase is zero if it hasn't been reached.
+type Metrics struct {
+	ProcessStart  time.Time     `json:"processStart"`
+	WindowCreated time.Duration `json:"windowCreated"`
+	WebviewReady  time.Duration `json:"webviewReady"`
+	DomReady      time.Duration `json:"domReady"`
+	// The peak resident memory of the process in bytes, when the last phase was reached
+	PeakMemory uint64 `json:"peakMemory"`
+}
+
+// Recorder records the metrics of the application. It is safe for concurrent use.
+type Recorder struct {
+	logger *logger.Logger
+
+	lock    sync.Mutex
+	metrics Metrics
+}
+
+// NewRecorder returns a recorder that logs each phase as it is reached
+func NewRecorder(logger *logger.Logger) *Recorder {
+	return &Recorder{
+		logger:  logger,
+		metrics: Metrics{ProcessStart: processStart},
+	}
+}
+
+// Record records the time the phase was reached. Only the first time each phase is reached is recorded.
+func (r *Recorder) Record(phase Phase) {
+	elapsed := time.Since(processStart)
+	memory := peakMemory()
+
+	r.lock.Lock()
+	var field *time.Duration
+	switch phase {
+	case WindowCreated:
+		field = &r.metrics.WindowCreated
+	case WebviewReady:
+		field = &r.metrics.WebviewReady
+	case DomReady:
+		field = &r.metrics.DomReady
+	default:
+		r.lock.Unlock()
+		return
+	}
+	if *field != 0 {
+		r.lock.Unlock()
+		return
+	}
+	*field = elapsed
+	if memory > r.metrics.PeakMemory {
+		r.metrics.PeakMemory = memory
+	}
+	r.lock.Unlock()
+
+	r.logger.Info("Startup: %s after %s (peak memory %.1f MB)", phase, elapsed.Round(time.Millisecond), float64(memory)/(1<<20))
+}
+
+// Metrics returns the metrics recorded so far
+func (r *Recorder) Metrics() Metrics {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.metrics
+}
+
+// Record records the phase with the recorder of the context, if the application records its startup metrics
+func Record(ctx context.Context, phase Phase) {
+	if recorder, ok := ctx.Value("startup").(*Recorder); ok {
+		recorder.Record(phase)
+	}
+}
+
+// MetricsFromContext returns the metrics recorded by the recorder of the context
+func MetricsFromContext(ctx context.Context) (Metrics, error) {
+	recorder, ok := ctx.Value("startup").(*Recorder)
+	if !ok {
+		return Metrics{}, ErrNotRecorded
+	}
+	return recorder.Metrics(), nil
+}
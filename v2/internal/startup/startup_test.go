@@ -0,0 +1,43 @@
+package startup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+)
+
+func TestRecorder(t *testing.T) {
+	recorder := NewRecorder(logger.New(nil))
+	ctx := context.WithValue(context.Background(), "startup", recorder)
+
+	Record(ctx, WindowCreated)
+	Record(ctx, DomReady)
+	metrics, err := MetricsFromContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metrics.WindowCreated <= 0 || metrics.DomReady < metrics.WindowCreated {
+		t.Errorf("unexpected phase times: %+v", metrics)
+	}
+	if metrics.WebviewReady != 0 {
+		t.Errorf("expected the webview phase not to be recorded, got %s", metrics.WebviewReady)
+	}
+	if metrics.ProcessStart != processStart {
+		t.Errorf("expected the process start %s, got %s", processStart, metrics.ProcessStart)
+	}
+
+	// Only the first time a phase is reached is recorded
+	Record(ctx, DomReady)
+	if again := recorder.Metrics(); again.DomReady != metrics.DomReady {
+		t.Errorf("expected the DOM ready time %s to be kept, got %s", metrics.DomReady, again.DomReady)
+	}
+}
+
+func TestMetricsNotRecorded(t *testing.T) {
+	ctx := context.Background()
+	Record(ctx, WindowCreated)
+	if _, err := MetricsFromContext(ctx); err != ErrNotRecorded {
+		t.Errorf("expected ErrNotRecorded, got %v", err)
+	}
+}
@@ -110,7 +110,7 @@ func (c *Call) processCall(callMessage *servicebus.Message) {
 		c.sendError(fmt.Errorf("Error parsing arguments: %s", err.Error()), payload, callMessage.Target())
 	}
 
-	result, err := registeredMethod.Call(args)
+	result, err := registeredMethod.Call(c.ctx, args)
 	if err != nil {
 		c.sendError(err, payload, callMessage.Target())
 		return
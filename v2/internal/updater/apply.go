@@ -0,0 +1,121 @@
+package updater
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// ProgressEvent is emitted with the Progress of Apply
+const ProgressEvent = "wails:update:progress"
+
+// The stages of applying an update
+const (
+	StageDownload = "download"
+	StageVerify   = "verify"
+	StageInstall  = "install"
+	StageDone     = "done"
+)
+
+// Progress describes the progress of applying an update
+type Progress struct {
+	Stage string `json:"stage"`
+	// The number of bytes downloaded, and the size of the update if known, during the download stage
+	Downloaded int64 `json:"downloaded"`
+	Total      int64 `json:"total"`
+}
+
+// elevatedApplyFlag is passed to the application when it is relaunched elevated to install an update
+const elevatedApplyFlag = "--wails-apply-update"
+
+// Apply downloads and verifies the latest update found by Check, then replaces the running executable
+// with it. The new version runs once the application is relaunched.
+// If the executable can't be replaced by the current user, the installation is retried elevated.
+// Progress is emitted in the ProgressEvent.
+func (u *Updater) Apply(ctx context.Context) error {
+	u.lock.Lock()
+	update := u.latest
+	u.lock.Unlock()
+	if update == nil {
+		return fmt.Errorf("no update available")
+	}
+
+	u.emitProgress(Progress{Stage: StageDownload})
+	staged, err := u.stage(ctx, func(downloaded, total int64) {
+		u.emitProgress(Progress{Stage: StageDownload, Downloaded: downloaded, Total: total})
+	})
+	if err != nil {
+		return err
+	}
+
+	u.emitProgress(Progress{Stage: StageVerify})
+	err = verifySignature(staged)
+	if err != nil {
+		return err
+	}
+
+	u.emitProgress(Progress{Stage: StageInstall})
+	executable, err := currentExecutable()
+	if err != nil {
+		return err
+	}
+	err = replaceExecutable(staged, executable)
+	if err != nil && os.IsPermission(err) {
+		u.log.Info("Installing the update requires elevation")
+		err = replaceExecutableElevated(staged, update.SHA256, executable)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to install update %s: %s", update.Version, err.Error())
+	}
+
+	u.emitProgress(Progress{Stage: StageDone})
+	return nil
+}
+
+func (u *Updater) emitProgress(progress Progress) {
+	if u.events != nil {
+		u.events.Emit(ProgressEvent, progress)
+	}
+}
+
+// Relaunch starts a new instance of the application with the same arguments.
+// The running instance should quit afterwards.
+func Relaunch() error {
+	executable, err := currentExecutable()
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(executable, os.Args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Start()
+}
+
+func currentExecutable() (string, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(executable)
+}
+
+// signer is the certificate that signed an executable
+type signer struct {
+	thumbprint string // The SHA-256 hash of the certificate
+	subject    string // The DER encoded subject of the certificate
+}
+
+// checkSigner returns an error unless the update is signed by the publisher of the running executable. The checksum
+// comes from the same manifest as the update, so any valid signature isn't enough. An update signed with a renewed
+// certificate of the same subject is accepted, as certificates expire.
+func checkSigner(current *signer, update *signer) error {
+	if update.thumbprint == current.thumbprint {
+		return nil
+	}
+	if update.subject != "" && update.subject == current.subject {
+		return nil
+	}
+	return fmt.Errorf("the update isn't signed by the publisher of the application")
+}
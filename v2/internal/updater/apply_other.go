@@ -0,0 +1,27 @@
+//go:build !windows
+// +build !windows
+
+package updater
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func verifySignature(_ string) error {
+	return nil
+}
+
+func replaceExecutable(_ string, _ string) error {
+	return fmt.Errorf("applying updates is not supported on %s yet", runtime.GOOS)
+}
+
+func replaceExecutableElevated(_ string, _ string, _ string) error {
+	return fmt.Errorf("applying updates is not supported on %s yet", runtime.GOOS)
+}
+
+// HandleElevatedApply installs an update if the application was relaunched elevated to do so
+func HandleElevatedApply() {}
+
+// RemovePreviousVersion removes the executable replaced by the last update
+func RemovePreviousVersion() {}
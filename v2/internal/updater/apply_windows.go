@@ -0,0 +1,201 @@
+//go:build windows
+// +build windows
+
+package updater
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/webview2runtime"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	modwintrust                        = windows.NewLazySystemDLL("wintrust.dll")
+	procWTHelperProvDataFromStateData  = modwintrust.NewProc("WTHelperProvDataFromStateData")
+	procWTHelperGetProvSignerFromChain = modwintrust.NewProc("WTHelperGetProvSignerFromChain")
+	procWTHelperGetProvCertFromChain   = modwintrust.NewProc("WTHelperGetProvCertFromChain")
+)
+
+// cryptProviderCert is the start of the CRYPT_PROVIDER_CERT structure
+type cryptProviderCert struct {
+	size uint32
+	cert *windows.CertContext
+}
+
+// verifySignature verifies the Authenticode signature of the update, and that it is signed by the publisher of the
+// running executable, if the executable is signed
+func verifySignature(filename string) error {
+	executable, err := currentExecutable()
+	if err != nil {
+		return err
+	}
+	current, err := authenticodeSigner(executable)
+	if err != nil {
+		// Unsigned applications are only verified by their checksum
+		return nil
+	}
+	update, err := authenticodeSigner(filename)
+	if err != nil {
+		return fmt.Errorf("the signature of the update is invalid: %s", err.Error())
+	}
+	return checkSigner(current, update)
+}
+
+// authenticodeSigner verifies the Authenticode signature of the file and returns the certificate that signed it
+func authenticodeSigner(filename string) (*signer, error) {
+	path, err := windows.UTF16PtrFromString(filename)
+	if err != nil {
+		return nil, err
+	}
+	data := &windows.WinTrustData{
+		Size:             uint32(unsafe.Sizeof(windows.WinTrustData{})),
+		UIChoice:         windows.WTD_UI_NONE,
+		RevocationChecks: windows.WTD_REVOKE_NONE,
+		UnionChoice:      windows.WTD_CHOICE_FILE,
+		StateAction:      windows.WTD_STATEACTION_VERIFY,
+		FileOrCatalogOrBlobOrSgnrOrCert: unsafe.Pointer(&windows.WinTrustFileInfo{
+			Size:     uint32(unsafe.Sizeof(windows.WinTrustFileInfo{})),
+			FilePath: path,
+		}),
+	}
+	err = windows.WinVerifyTrustEx(windows.InvalidHWND, &windows.WINTRUST_ACTION_GENERIC_VERIFY_V2, data)
+	// The state holds the signer until it is closed
+	defer func() {
+		data.StateAction = windows.WTD_STATEACTION_CLOSE
+		_ = windows.WinVerifyTrustEx(windows.InvalidHWND, &windows.WINTRUST_ACTION_GENERIC_VERIFY_V2, data)
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	providerData, _, _ := procWTHelperProvDataFromStateData.Call(uintptr(data.StateData))
+	if providerData == 0 {
+		return nil, fmt.Errorf("unable to read the signature")
+	}
+	providerSigner, _, _ := procWTHelperGetProvSignerFromChain.Call(providerData, 0, 0, 0)
+	if providerSigner == 0 {
+		return nil, fmt.Errorf("unable to read the signer")
+	}
+	providerCert, _, _ := procWTHelperGetProvCertFromChain.Call(providerSigner, 0)
+	if providerCert == 0 {
+		return nil, fmt.Errorf("unable to read the certificate of the signer")
+	}
+	// The structure is owned by the state, which is closed once the certificate has been read
+	cert := (*(**cryptProviderCert)(unsafe.Pointer(&providerCert))).cert
+	if cert == nil || cert.CertInfo == nil {
+		return nil, fmt.Errorf("unable to read the certificate of the signer")
+	}
+
+	thumbprint := sha256.Sum256(unsafe.Slice(cert.EncodedCert, cert.Length))
+	subject := unsafe.Slice(cert.CertInfo.Subject.Data, cert.CertInfo.Subject.Size)
+	return &signer{
+		thumbprint: hex.EncodeToString(thumbprint[:]),
+		subject:    string(subject),
+	}, nil
+}
+
+// replaceExecutable replaces the executable with the update. A running executable can't be
+// overwritten or deleted, but it can be renamed, so it is moved aside before the update is moved in.
+func replaceExecutable(update string, executable string) error {
+	newExecutable := executable + ".new"
+	oldExecutable := executable + ".old"
+
+	// The update is copied next to the executable so it can be renamed into place
+	err := copyFile(update, newExecutable)
+	if err != nil {
+		return err
+	}
+
+	// Left by a previous update
+	_ = os.Remove(oldExecutable)
+
+	err = os.Rename(executable, oldExecutable)
+	if err != nil {
+		_ = os.Remove(newExecutable)
+		return err
+	}
+	err = os.Rename(newExecutable, executable)
+	if err != nil {
+		// Restore the current version
+		_ = os.Rename(oldExecutable, executable)
+		_ = os.Remove(newExecutable)
+		return err
+	}
+
+	// The old executable can't be removed while it is running. It is removed on the next launch.
+	_ = os.Remove(oldExecutable)
+	return nil
+}
+
+// replaceExecutableElevated relaunches the application elevated to replace the executable
+func replaceExecutableElevated(update string, checksum string, executable string) error {
+	parameters := fmt.Sprintf(`%s "%s" %s`, elevatedApplyFlag, update, checksum)
+	err := webview2runtime.ShellExecuteAndWait(0, "runas", executable, parameters, filepath.Dir(executable), syscall.SW_HIDE)
+	if err != nil {
+		return err
+	}
+	// The elevated process has no way of reporting errors, so check the executable has been replaced
+	installed, err := fileChecksum(executable)
+	if err != nil {
+		return err
+	}
+	if installed != checksum {
+		return fmt.Errorf("the elevated installation failed or was cancelled")
+	}
+	return nil
+}
+
+// HandleElevatedApply installs an update if the application was relaunched elevated to do so, then exits
+func HandleElevatedApply() {
+	if len(os.Args) != 4 || os.Args[1] != elevatedApplyFlag {
+		return
+	}
+	update, checksum := os.Args[2], os.Args[3]
+
+	// The update is verified again as it is installed with elevated privileges
+	exitCode := 1
+	if updateChecksum, err := fileChecksum(update); err == nil && updateChecksum == checksum && verifySignature(update) == nil {
+		executable, err := currentExecutable()
+		if err == nil && replaceExecutable(update, executable) == nil {
+			exitCode = 0
+		}
+	}
+	os.Exit(exitCode)
+}
+
+// RemovePreviousVersion removes the executable replaced by the last update
+func RemovePreviousVersion() {
+	executable, err := currentExecutable()
+	if err != nil {
+		return
+	}
+	_ = os.Remove(executable + ".old")
+}
+
+func copyFile(source string, target string) error {
+	input, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+	output, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(output, input)
+	if closeErr := output.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(target)
+	}
+	return err
+}
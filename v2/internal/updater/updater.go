@@ -0,0 +1,318 @@
+package updater
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// AvailableEvent is emitted with the Update when a newer version is found
+const AvailableEvent = "wails:update:available"
+
+// DefaultInterval is the default time between update checks
+const DefaultInterval = 24 * time.Hour
+
+// Manifest describes the latest version of the application
+type Manifest struct {
+	Version string `json:"version"`
+	Notes   string `json:"notes,omitempty"`
+	// The artifacts of each platform, keyed by GOOS/GOARCH, EG: windows/amd64
+	Artifacts map[string]Artifact `json:"artifacts"`
+}
+
+// Artifact is the file to download to update the application on a platform
+type Artifact struct {
+	URL    string `json:"url"`
+	SHA256 string `json:"sha256"`
+}
+
+// Update describes a newer version of the application for the current platform
+type Update struct {
+	Version string `json:"version"`
+	Notes   string `json:"notes"`
+	URL     string `json:"url"`
+	SHA256  string `json:"sha256"`
+}
+
+// Updater checks for updates of the application and stages them
+type Updater struct {
+	manifestURL    string
+	interval       time.Duration
+	currentVersion string
+	platform       string
+	client         *http.Client
+	events         frontend.Events
+	log            *logger.Logger
+
+	// The directory updates are downloaded to
+	stagingDir string
+
+	lock   sync.Mutex
+	latest *Update
+}
+
+// New creates an Updater for the given options. The current version is the version of the running application.
+func New(options *options.AutoUpdate, currentVersion string, events frontend.Events, log *logger.Logger) *Updater {
+	interval := options.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Updater{
+		manifestURL:    options.ManifestURL,
+		interval:       interval,
+		currentVersion: currentVersion,
+		platform:       runtime.GOOS + "/" + runtime.GOARCH,
+		client:         &http.Client{Timeout: time.Minute},
+		events:         events,
+		log:            log,
+	}
+}
+
+// Start checks for updates until the given context is cancelled. The first check is made immediately.
+func (u *Updater) Start(ctx context.Context) {
+	if u.currentVersion == "" {
+		u.log.Warning("Update checks are disabled: the application version is unknown. Please set info.productVersion in wails.json")
+		return
+	}
+	ticker := time.NewTicker(u.interval)
+	defer ticker.Stop()
+	for {
+		update, err := u.Check(ctx)
+		if err != nil {
+			u.log.Warning("Unable to check for updates: %s", err.Error())
+		} else if update != nil {
+			u.events.Emit(AvailableEvent, update)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check fetches the manifest and returns the update for the current platform, or nil if there is no newer version
+func (u *Updater) Check(ctx context.Context) (*Update, error) {
+	current, err := semver.NewVersion(u.currentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid application version '%s': %s", u.currentVersion, err.Error())
+	}
+
+	manifest, err := u.fetchManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := semver.NewVersion(manifest.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version '%s' in update manifest: %s", manifest.Version, err.Error())
+	}
+	if !latest.GreaterThan(current) {
+		return nil, nil
+	}
+	artifact, exists := manifest.Artifacts[u.platform]
+	if !exists {
+		u.log.Debug("Version %s has no update for %s", manifest.Version, u.platform)
+		return nil, nil
+	}
+	if artifact.URL == "" || artifact.SHA256 == "" {
+		return nil, fmt.Errorf("the update for %s requires a url and sha256", u.platform)
+	}
+
+	update := &Update{
+		Version: manifest.Version,
+		Notes:   manifest.Notes,
+		URL:     u.resolve(artifact.URL),
+		SHA256:  strings.ToLower(artifact.SHA256),
+	}
+	u.lock.Lock()
+	u.latest = update
+	u.lock.Unlock()
+	return update, nil
+}
+
+func (u *Updater) fetchManifest(ctx context.Context) (*Manifest, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := u.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch update manifest: %s", response.Status)
+	}
+	var manifest Manifest
+	err = json.NewDecoder(response.Body).Decode(&manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid update manifest: %s", err.Error())
+	}
+	return &manifest, nil
+}
+
+// resolve returns the given artifact URL relative to the manifest URL
+func (u *Updater) resolve(artifactURL string) string {
+	base, err := url.Parse(u.manifestURL)
+	if err != nil {
+		return artifactURL
+	}
+	ref, err := url.Parse(artifactURL)
+	if err != nil {
+		return artifactURL
+	}
+	return base.ResolveReference(ref).String()
+}
+
+// Download downloads the latest update found by Check, verifies its checksum and stages it.
+// Returns the path of the staged file. Applying the update is left to the application.
+func (u *Updater) Download(ctx context.Context) (string, error) {
+	return u.stage(ctx, nil)
+}
+
+// stage downloads and verifies the latest update, reporting the progress of the download if given
+func (u *Updater) stage(ctx context.Context, progress func(downloaded, total int64)) (string, error) {
+	u.lock.Lock()
+	update := u.latest
+	u.lock.Unlock()
+	if update == nil {
+		return "", fmt.Errorf("no update available")
+	}
+
+	dir, err := u.updateDirectory(update.Version)
+	if err != nil {
+		return "", err
+	}
+	name := path.Base(update.URL)
+	if parsed, err := url.Parse(update.URL); err == nil {
+		name = path.Base(parsed.Path)
+	}
+	if name == "" || name == "/" || name == "." {
+		name = "update"
+	}
+	staged := filepath.Join(dir, name)
+
+	// The update may have been staged already
+	if checksum, err := fileChecksum(staged); err == nil && checksum == update.SHA256 {
+		return staged, nil
+	}
+
+	err = u.download(ctx, update, staged, progress)
+	if err != nil {
+		return "", err
+	}
+	return staged, nil
+}
+
+// download downloads the update to the target file, if its checksum is valid
+func (u *Updater) download(ctx context.Context, update *Update, target string, progress func(downloaded, total int64)) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, update.URL, nil)
+	if err != nil {
+		return err
+	}
+	// Downloads may take longer than the manifest timeout
+	client := *u.client
+	client.Timeout = 0
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to download update: %s", response.Status)
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(target), "download-*")
+	if err != nil {
+		return err
+	}
+	hash := sha256.New()
+	var body io.Reader = response.Body
+	if progress != nil {
+		body = &progressReader{reader: response.Body, total: response.ContentLength, report: progress}
+	}
+	_, err = io.Copy(io.MultiWriter(temp, hash), body)
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != update.SHA256 {
+			err = fmt.Errorf("checksum mismatch for update %s: got %s, expected %s", update.Version, checksum, update.SHA256)
+		}
+	}
+	if err != nil {
+		_ = os.Remove(temp.Name())
+		return err
+	}
+	return os.Rename(temp.Name(), target)
+}
+
+// updateDirectory returns the directory to stage the given version in, EG: ~/.cache/myapp/updates/1.2.0
+func (u *Updater) updateDirectory(version string) (string, error) {
+	dir := u.stagingDir
+	if dir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		executable, err := os.Executable()
+		if err != nil {
+			return "", err
+		}
+		name := strings.TrimSuffix(filepath.Base(executable), ".exe")
+		dir = filepath.Join(cacheDir, name, "updates")
+	}
+	dir = filepath.Join(dir, version)
+	return dir, os.MkdirAll(dir, 0755)
+}
+
+// fileChecksum returns the SHA256 checksum of the given file
+func fileChecksum(filename string) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// progressReader reports the progress of a download, at most every 100ms
+type progressReader struct {
+	reader     io.Reader
+	downloaded int64
+	total      int64
+	lastReport time.Time
+	report     func(downloaded, total int64)
+}
+
+func (p *progressReader) Read(buffer []byte) (int, error) {
+	count, err := p.reader.Read(buffer)
+	p.downloaded += int64(count)
+	if err == io.EOF || time.Since(p.lastReport) >= 100*time.Millisecond {
+		p.lastReport = time.Now()
+		p.report(p.downloaded, p.total)
+	}
+	return count, err
+}
@@ -0,0 +1,131 @@
+package updater
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+func newTestServer(t *testing.T, version string, artifact []byte, checksum string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/manifest.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"version": %q, "notes": "Fixes", "artifacts": {%q: {"url": "app-update.bin", "sha256": %q}}}`,
+			version, runtime.GOOS+"/"+runtime.GOARCH, checksum)
+	})
+	mux.HandleFunc("/app-update.bin", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(artifact)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestUpdater(t *testing.T, server *httptest.Server, currentVersion string) *Updater {
+	result := New(&options.AutoUpdate{ManifestURL: server.URL + "/manifest.json"}, currentVersion, nil, logger.New(nil))
+	result.stagingDir = t.TempDir()
+	return result
+}
+
+func TestUpdater_Check(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentVersion string
+		latestVersion  string
+		wantUpdate     bool
+		wantErr        bool
+	}{
+		{"newer", "1.0.0", "1.1.0", true, false},
+		{"same", "1.1.0", "1.1.0", false, false},
+		{"older", "2.0.0", "1.1.0", false, false},
+		{"prefixed", "v1.0.0", "v1.0.1", true, false},
+		{"invalid current", "dev", "1.1.0", false, true},
+		{"invalid latest", "1.0.0", "latest", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, tt.latestVersion, nil, "abc")
+			update, err := newTestUpdater(t, server, tt.currentVersion).Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (update != nil) != tt.wantUpdate {
+				t.Fatalf("Check() = %v, wantUpdate %v", update, tt.wantUpdate)
+			}
+			if update != nil && update.URL != server.URL+"/app-update.bin" {
+				t.Errorf("URL = %s, want %s", update.URL, server.URL+"/app-update.bin")
+			}
+		})
+	}
+}
+
+func TestUpdater_Download(t *testing.T) {
+	artifact := []byte("new version")
+	sum := sha256.Sum256(artifact)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("valid checksum", func(t *testing.T) {
+		u := newTestUpdater(t, newTestServer(t, "1.1.0", artifact, checksum), "1.0.0")
+		if _, err := u.Download(context.Background()); err == nil {
+			t.Fatal("Download() should fail before an update is found")
+		}
+		if _, err := u.Check(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		staged, err := u.Download(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := os.ReadFile(staged)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != string(artifact) {
+			t.Errorf("staged file = %s, want %s", data, artifact)
+		}
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		u := newTestUpdater(t, newTestServer(t, "1.1.0", artifact, "0123"), "1.0.0")
+		if _, err := u.Check(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := u.Download(context.Background()); err == nil {
+			t.Fatal("Download() should fail when the checksum doesn't match")
+		}
+		entries, _ := os.ReadDir(u.stagingDir + "/1.1.0")
+		if len(entries) != 0 {
+			t.Errorf("expected no staged files, got %d", len(entries))
+		}
+	})
+}
+
+func TestCheckSigner(t *testing.T) {
+	current := &signer{thumbprint: "aa", subject: "CN=My Company"}
+	tests := []struct {
+		name    string
+		update  *signer
+		wantErr bool
+	}{
+		{"same certificate", &signer{thumbprint: "aa", subject: "CN=My Company"}, false},
+		{"renewed certificate", &signer{thumbprint: "bb", subject: "CN=My Company"}, false},
+		{"other publisher", &signer{thumbprint: "cc", subject: "CN=Other Company"}, true},
+		{"no subject", &signer{thumbprint: "dd"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSigner(current, tt.update)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkSigner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
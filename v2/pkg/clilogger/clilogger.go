@@ -1,61 +1,345 @@
 package clilogger
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/wailsapp/wails/v2/internal/colour"
 )
 
+// Format is the format of the log output
+type Format int
+
+const (
+	// Text outputs human readable lines
+	Text Format = iota
+	// JSON outputs a JSON object per line
+	JSON
+)
+
+// ParseFormat returns the Format for the given name: "text" or "json"
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "text":
+		return Text, nil
+	case "json":
+		return JSON, nil
+	default:
+		return Text, fmt.Errorf("unknown log format '%s'. Valid formats: text, json", name)
+	}
+}
+
+// Timestamps defines the timestamp added to each line of text output
+type Timestamps int
+
+const (
+	// NoTimestamps adds no timestamps
+	NoTimestamps Timestamps = iota
+	// ElapsedTimestamps adds the time elapsed since the logger was created
+	ElapsedTimestamps
+	// WallClockTimestamps adds the time of day
+	WallClockTimestamps
+)
+
+// ParseTimestamps returns the Timestamps for the given name: "elapsed" or "wallclock"
+func ParseTimestamps(name string) (Timestamps, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "none":
+		return NoTimestamps, nil
+	case "elapsed":
+		return ElapsedTimestamps, nil
+	case "wallclock":
+		return WallClockTimestamps, nil
+	default:
+		return NoTimestamps, fmt.Errorf("unknown timestamps '%s'. Valid values: elapsed, wallclock", name)
+	}
+}
+
+// ansiRegex matches the colour codes that are stripped from JSON messages
+var ansiRegex = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// jsonLine is a single line of JSON output
+type jsonLine struct {
+	Level     string `json:"level"`
+	Timestamp string `json:"timestamp"`
+	Message   string `json:"message"`
+	Target    string `json:"target,omitempty"`
+	Elapsed   string `json:"elapsed,omitempty"`
+}
+
 // CLILogger is used by the cli
 type CLILogger struct {
 	Writer io.Writer
 	mute   bool
+	format Format
+	target string
+
+	timestamps Timestamps
+	start      time.Time
+	// Whether the last output didn't end the line, EG: with Print
+	midLine bool
+
+	progress    bool
+	stopSpinner chan struct{}
+	spinnerDone chan struct{}
 }
 
 // New cli logger
 func New(writer io.Writer) *CLILogger {
 	return &CLILogger{
 		Writer: writer,
+		start:  time.Now(),
+	}
+}
+
+// WithWriter returns a logger with the same settings that writes to the given writer, EG: to buffer the output of
+// a target built in parallel. It doesn't show a spinner.
+func (c *CLILogger) WithWriter(writer io.Writer) *CLILogger {
+	return &CLILogger{
+		Writer:     writer,
+		mute:       c.mute,
+		format:     c.format,
+		target:     c.target,
+		timestamps: c.timestamps,
+		start:      c.start,
 	}
 }
 
 // Mute sets whether the logger should be muted
 func (c *CLILogger) Mute(value bool) {
+	if value {
+		c.endSpinner()
+	}
 	c.mute = value
 }
 
+// SetFormat sets the format of the log output
+func (c *CLILogger) SetFormat(format Format) {
+	c.format = format
+}
+
+// IsJSON returns true if the logger outputs JSON
+func (c *CLILogger) IsJSON() bool {
+	return c.format == JSON
+}
+
+// SetTimestamps sets the timestamp added to each line of output
+func (c *CLILogger) SetTimestamps(timestamps Timestamps) {
+	c.timestamps = timestamps
+}
+
+// IsTerminal returns true if the given writer is a terminal
+func IsTerminal(writer io.Writer) bool {
+	file, ok := writer.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// SetProgress sets whether an animated spinner is shown while a phase is running.
+// It should only be enabled when writing to a terminal.
+func (c *CLILogger) SetProgress(enabled bool) {
+	c.progress = enabled
+}
+
+// Phase prints the label of a long running phase, EG: "Compiling Go". The phase ends
+// with the next output of the logger, which is usually "Done.".
+func (c *CLILogger) Phase(label string) {
+	c.Print("  - %s: ", label)
+	if c.progress && !c.mute && c.format == Text {
+		c.startSpinner()
+	}
+}
+
+// EndPhase stops the spinner of the current phase. It should be called before
+// output is written directly to stdout during a phase.
+func (c *CLILogger) EndPhase() {
+	if c.endSpinner() {
+		c.midLine = false
+		_, _ = fmt.Fprintln(c.Writer)
+	}
+}
+
+// SetTarget sets the build target, EG: windows/amd64, that is added to JSON output
+func (c *CLILogger) SetTarget(target string) {
+	c.target = target
+}
+
 // Print works like Printf
 func (c *CLILogger) Print(message string, args ...interface{}) {
 	if c.mute {
 		return
 	}
+	c.endSpinner()
+	if c.format == JSON {
+		c.writeJSON("info", fmt.Sprintf(message, args...))
+		return
+	}
 
-	_, err := fmt.Fprintf(c.Writer, message, args...)
+	temp := fmt.Sprintf(message, args...)
+	_, err := fmt.Fprint(c.Writer, c.addTimestamps(temp))
 	if err != nil {
-		c.Fatal("Fatal: ", err)
+		c.Fatal("Fatal: %s", err)
 	}
 }
 
 // Println works like Printf but with a line ending
 func (c *CLILogger) Println(message string, args ...interface{}) {
+	c.println("info", message, args...)
+}
+
+// Warning prints the given message as a warning
+func (c *CLILogger) Warning(message string, args ...interface{}) {
+	c.println("warning", message, args...)
+}
+
+// Error prints the given message as an error
+func (c *CLILogger) Error(message string, args ...interface{}) {
+	c.println("error", message, args...)
+}
+
+func (c *CLILogger) println(level string, message string, args ...interface{}) {
 	if c.mute {
 		return
 	}
+	c.endSpinner()
 	temp := fmt.Sprintf(message, args...)
-	_, err := fmt.Fprintln(c.Writer, temp)
+	if c.format == JSON {
+		c.writeJSON(level, temp)
+		return
+	}
+	switch level {
+	case "warning":
+		temp = colour.DarkYellow(temp)
+	case "error":
+		temp = colour.Red(temp)
+	}
+	_, err := fmt.Fprint(c.Writer, c.addTimestamps(temp+"\n"))
 	if err != nil {
-		c.Fatal("Fatal: ", err)
+		c.Fatal("Fatal: %s", err)
 	}
 }
 
 // Fatal prints the given message then aborts
 func (c *CLILogger) Fatal(message string, args ...interface{}) {
+	c.endSpinner()
 	temp := fmt.Sprintf(message, args...)
-	_, err := fmt.Fprintln(c.Writer, colour.Red("FATAL: "+temp))
+	if c.format == JSON {
+		c.writeJSON("fatal", temp)
+		os.Exit(1)
+	}
+	_, err := fmt.Fprint(c.Writer, c.addTimestamps(colour.Red("FATAL: "+temp)+"\n"))
 	if err != nil {
 		println(colour.Red("FATAL: " + err.Error()))
 	}
 	os.Exit(1)
 }
+
+// writeJSON writes the given message as a JSON line. Blank messages, used
+// to space out text output, are dropped.
+func (c *CLILogger) writeJSON(level string, message string) {
+	message = strings.TrimSpace(ansiRegex.ReplaceAllString(message, ""))
+	if message == "" {
+		return
+	}
+	output := jsonLine{
+		Level:     level,
+		Timestamp: time.Now().Format(time.RFC3339Nano),
+		Message:   message,
+		Target:    c.target,
+	}
+	if c.timestamps == ElapsedTimestamps {
+		output.Elapsed = time.Since(c.start).Round(time.Millisecond).String()
+	}
+	line, err := json.Marshal(output)
+	if err != nil {
+		println("FATAL: " + err.Error())
+		os.Exit(1)
+	}
+	_, err = fmt.Fprintln(c.Writer, string(line))
+	if err != nil {
+		println("FATAL: " + err.Error())
+		os.Exit(1)
+	}
+}
+
+// timestamp returns the timestamp prefix for a line of text output
+func (c *CLILogger) timestamp() string {
+	switch c.timestamps {
+	case ElapsedTimestamps:
+		return fmt.Sprintf("[%8.3fs] ", time.Since(c.start).Seconds())
+	case WallClockTimestamps:
+		return time.Now().Format("[15:04:05.000] ")
+	default:
+		return ""
+	}
+}
+
+// addTimestamps prefixes each line that the given text starts with a timestamp.
+// Text printed without a line ending is continued on the same line.
+func (c *CLILogger) addTimestamps(text string) string {
+	if c.timestamps == NoTimestamps || text == "" {
+		return text
+	}
+	prefix := c.timestamp()
+	var result strings.Builder
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+		if !c.midLine {
+			result.WriteString(prefix)
+		}
+		result.WriteString(line)
+		c.midLine = !strings.HasSuffix(line, "\n")
+	}
+	return result.String()
+}
+
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+// startSpinner animates a spinner at the end of the current line until endSpinner is called
+func (c *CLILogger) startSpinner() {
+	c.endSpinner()
+	c.stopSpinner = make(chan struct{})
+	c.spinnerDone = make(chan struct{})
+	go func(stop chan struct{}, done chan struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		_, _ = fmt.Fprint(c.Writer, spinnerFrames[0])
+		for frame := 1; ; frame++ {
+			select {
+			case <-stop:
+				// Remove the spinner
+				_, _ = fmt.Fprint(c.Writer, "\b \b")
+				return
+			case <-ticker.C:
+				_, _ = fmt.Fprint(c.Writer, "\b"+spinnerFrames[frame%len(spinnerFrames)])
+			}
+		}
+	}(c.stopSpinner, c.spinnerDone)
+}
+
+// endSpinner stops the spinner, if running, and waits for it to be removed.
+// Returns true if the spinner was running.
+func (c *CLILogger) endSpinner() bool {
+	if c.stopSpinner == nil {
+		return false
+	}
+	close(c.stopSpinner)
+	<-c.spinnerDone
+	c.stopSpinner = nil
+	c.spinnerDone = nil
+	return true
+}
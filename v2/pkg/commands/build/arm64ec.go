@@ -0,0 +1,47 @@
+package build
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/shell"
+)
+
+// arm64ecArch is the GOARCH used for windows/arm64 targets built for the ARM64EC ABI
+const arm64ecArch = "arm64ec"
+
+// ABI returns the ABI the target is compiled for. This is the architecture, unless
+// windows/arm64 is built for ARM64EC.
+func (o *Options) ABI() string {
+	if o.useARM64EC() {
+		return arm64ecArch
+	}
+	return o.Arch
+}
+
+func (o *Options) useARM64EC() bool {
+	return o.ARM64EC && o.Platform == "windows" && o.Arch == "arm64"
+}
+
+// checkARM64ECSupport returns an error if the compiler can't build windows/arm64ec
+func checkARM64ECSupport(compiler string) error {
+	stdout, stderr, err := shell.RunCommand(".", compiler, "tool", "dist", "list")
+	if err != nil {
+		return fmt.Errorf("unable to list the targets supported by '%s': %s - %s", compiler, err.Error(), stderr)
+	}
+	target := "windows/" + arm64ecArch
+	if !distListContains(stdout, target) {
+		return fmt.Errorf("the compiler '%s' doesn't support the ARM64EC ABI: '%s' isn't listed by `%s tool dist list`. Use -compiler to select a toolchain that supports it", compiler, target, compiler)
+	}
+	return nil
+}
+
+// distListContains returns true if target is in the output of `go tool dist list`
+func distListContains(distList string, target string) bool {
+	for _, line := range strings.Split(distList, "\n") {
+		if strings.TrimSpace(line) == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package build
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+// The files excluded from the frontend assets when `build:excludeassets` isn't set in the project config
+var defaultAssetExcludes = []string{"*.map"}
+
+// assetDirectory returns the directory of the built frontend assets, which is the `frontend:build:dir` or
+// `assetdir` of the project, or `frontend/dist`
+func assetDirectory(projectData *project.Project) string {
+	assetDir := projectData.FrontendBuildDir
+	if assetDir == "" {
+		assetDir = projectData.AssetDirectory
+	}
+	if assetDir == "" {
+		assetDir = filepath.Join("frontend", "dist")
+	}
+	if !filepath.IsAbs(assetDir) {
+		assetDir = filepath.Join(projectData.Path, assetDir)
+	}
+	return assetDir
+}
+
+// excludedAssets returns the absolute paths of the files in the asset directory matching any of the glob patterns,
+// and their total size. Patterns without a slash match the filename, EG: `*.map`, otherwise they match the path
+// relative to the asset directory, EG: `assets/*.map`.
+func excludedAssets(assetDir string, patterns []string) ([]string, int64, error) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, 0, fmt.Errorf("invalid pattern '%s' in build:excludeassets: %w", pattern, err)
+		}
+	}
+	assetDir, err := filepath.Abs(assetDir)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var files []string
+	var size int64
+	err = filepath.WalkDir(assetDir, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relative, err := filepath.Rel(assetDir, filename)
+		if err != nil {
+			return err
+		}
+		if !matchesAsset(filepath.ToSlash(relative), patterns) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, filename)
+		size += info.Size()
+		return nil
+	})
+	return files, size, err
+}
+
+// writeBuildOverlay writes a `go build -overlay` file that hides the files from the build, including from
+// `go:embed` patterns, and returns its filename
+func writeBuildOverlay(files []string) (string, error) {
+	overlay := struct {
+		Replace map[string]string
+	}{
+		Replace: map[string]string{},
+	}
+	for _, file := range files {
+		overlay.Replace[file] = ""
+	}
+	data, err := json.Marshal(overlay)
+	if err != nil {
+		return "", err
+	}
+	file, err := os.CreateTemp("", "wails-overlay-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	_, err = file.Write(data)
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// excludeFrontendAssets excludes the files matching `build:excludeassets`, or source maps, from the embedded frontend
+// assets with a build overlay, leaving the asset directory untouched. Returns the filename of the overlay, which
+// is empty if no files are excluded.
+func excludeFrontendAssets(options *Options) (string, error) {
+	patterns := options.ProjectData.AssetExcludes
+	if len(patterns) == 0 {
+		patterns = defaultAssetExcludes
+	}
+	options.Logger.Phase("Excluding frontend assets")
+	files, size, err := excludedAssets(assetDirectory(options.ProjectData), patterns)
+	if err != nil {
+		return "", err
+	}
+	options.Logger.Println("Excluded %d files (%.1f KB).", len(files), float64(size)/1024)
+	if len(files) == 0 {
+		return "", nil
+	}
+	return writeBuildOverlay(files)
+}
+
+// matchesAsset returns true if the slash separated path of an asset matches any of the patterns
+func matchesAsset(assetPath string, patterns []string) bool {
+	for _, pattern := range patterns {
+		name := assetPath
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(assetPath)
+		}
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAssetDirectory returns an error if the asset directory doesn't exist or doesn't contain an `index.html`,
+// which means the frontend was built somewhere else and the application would show a blank window
+func checkAssetDirectory(assetDir string) error {
+	info, err := os.Stat(assetDir)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("the frontend build did not create the asset directory '%s'. Check the 'frontend:build' command, and 'frontend:build:dir' or 'assetdir' in wails.json", assetDir)
+	}
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("the asset directory '%s' is not a directory", assetDir)
+	}
+
+	foundIndex := errors.New("found index.html")
+	err = filepath.WalkDir(assetDir, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && entry.Name() == "index.html" {
+			return foundIndex
+		}
+		return nil
+	})
+	if err == foundIndex {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("the asset directory '%s' does not contain an index.html. Check the 'frontend:build' command, and 'frontend:build:dir' or 'assetdir' in wails.json", assetDir)
+}
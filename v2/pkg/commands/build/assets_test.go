@@ -0,0 +1,132 @@
+package build
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func TestAssetDirectory(t *testing.T) {
+	projectDir := filepath.Join("home", "app")
+	tests := []struct {
+		name    string
+		project project.Project
+		want    string
+	}{
+		{"default", project.Project{}, filepath.Join(projectDir, "frontend", "dist")},
+		{"assetdir", project.Project{AssetDirectory: "frontend/build"}, filepath.Join(projectDir, "frontend", "build")},
+		{"frontend:build:dir", project.Project{AssetDirectory: "frontend/src", FrontendBuildDir: "web/out"}, filepath.Join(projectDir, "web", "out")},
+	}
+	for _, tt := range tests {
+		tt.project.Path = projectDir
+		if got := assetDirectory(&tt.project); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestMatchesAsset(t *testing.T) {
+	tests := []struct {
+		path     string
+		patterns []string
+		want     bool
+	}{
+		{"main.js.map", []string{"*.map"}, true},
+		{"assets/main.js.map", []string{"*.map"}, true},
+		{"assets/main.js", []string{"*.map"}, false},
+		{"assets/main.js.map", []string{"assets/*.map"}, true},
+		{"main.js.map", []string{"assets/*.map"}, false},
+		{"stats.html", []string{"*.map", "stats.html"}, true},
+	}
+	for _, tt := range tests {
+		if got := matchesAsset(tt.path, tt.patterns); got != tt.want {
+			t.Errorf("matchesAsset(%q, %v) = %v, want %v", tt.path, tt.patterns, got, tt.want)
+		}
+	}
+}
+
+func TestExcludedAssets(t *testing.T) {
+	assetDir := t.TempDir()
+	files := map[string]string{
+		"index.html":         "<html></html>",
+		"assets/main.js":     "main()",
+		"assets/main.js.map": "{}",
+		"main.css.map":       "{\"version\":3}",
+	}
+	for name, content := range files {
+		filename := filepath.Join(assetDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	excluded, size, err := excludedAssets(assetDir, defaultAssetExcludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{filepath.Join(assetDir, "assets", "main.js.map"), filepath.Join(assetDir, "main.css.map")}
+	if !reflect.DeepEqual(excluded, want) || size != 15 {
+		t.Errorf("expected %v and 15 bytes to be excluded, got %v and %d bytes", want, excluded, size)
+	}
+	// The files are excluded from the build, not removed
+	for name := range files {
+		if _, err := os.Stat(filepath.Join(assetDir, filepath.FromSlash(name))); err != nil {
+			t.Errorf("%s: %s", name, err)
+		}
+	}
+
+	if _, _, err := excludedAssets(assetDir, []string{"["}); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
+
+func TestWriteBuildOverlay(t *testing.T) {
+	files := []string{filepath.Join(t.TempDir(), "main.js.map")}
+	filename, err := writeBuildOverlay(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filename)
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var overlay struct {
+		Replace map[string]string
+	}
+	if err := json.Unmarshal(data, &overlay); err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{files[0]: ""}; !reflect.DeepEqual(overlay.Replace, want) {
+		t.Errorf("expected the overlay to replace %v, got %v", want, overlay.Replace)
+	}
+}
+
+func TestCheckAssetDirectory(t *testing.T) {
+	assetDir := t.TempDir()
+	if err := checkAssetDirectory(filepath.Join(assetDir, "dist")); err == nil {
+		t.Error("expected an error for a missing asset directory")
+	}
+	if err := checkAssetDirectory(assetDir); err == nil {
+		t.Error("expected an error for an empty asset directory")
+	}
+
+	index := filepath.Join(assetDir, "app", "index.html")
+	if err := os.MkdirAll(filepath.Dir(index), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(index, []byte("<html></html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkAssetDirectory(assetDir); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
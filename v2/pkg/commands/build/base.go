@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/wailsapp/wails/v2/internal/system"
 
@@ -20,6 +21,8 @@ import (
 
 	"github.com/leaanthony/slicer"
 	"github.com/wailsapp/wails/v2/internal/assetdb"
+	"github.com/wailsapp/wails/v2/internal/features"
+	"github.com/wailsapp/wails/v2/internal/frontend/assetserver"
 	"github.com/wailsapp/wails/v2/internal/fs"
 	"github.com/wailsapp/wails/v2/internal/html"
 	"github.com/wailsapp/wails/v2/internal/project"
@@ -34,6 +37,7 @@ const (
 // BaseBuilder is the common builder struct
 type BaseBuilder struct {
 	filesToDelete slicer.StringSlicer
+	sharedFiles   []string
 	projectData   *project.Project
 	options       *Options
 }
@@ -136,9 +140,21 @@ func (b *BaseBuilder) convertByteSliceToIntegerString(data []byte) string {
 	return result.String()
 }
 
+// frontendAssetsHash returns the hash of the built frontend assets, which are read from the asset directory
+// of the project. Returns an empty string if they can't be found.
+func frontendAssetsHash(projectData *project.Project) string {
+	hash, err := assetserver.Hash(os.DirFS(assetDirectory(projectData)))
+	if err != nil {
+		return ""
+	}
+	return hash
+}
+
 // CleanUp does post-build housekeeping
 func (b *BaseBuilder) CleanUp() {
 
+	b.releaseSharedFiles()
+
 	// Delete all the files
 	b.filesToDelete.Each(func(filename string) {
 
@@ -190,27 +206,17 @@ func (b *BaseBuilder) OutputFilename(options *Options) string {
 // CompileProject compiles the project
 func (b *BaseBuilder) CompileProject(options *Options) error {
 
-	// Check if the runtime wrapper exists
-	err := generateRuntimeWrapper(options)
-	if err != nil {
-		return err
-	}
-
-	verbose := options.Verbosity == VERBOSE
-	// Run go mod tidy first
-	if !options.SkipModTidy {
-		cmd := exec.Command(options.Compiler, "mod", "tidy")
-		cmd.Stderr = os.Stderr
-		if verbose {
-			println("")
-			cmd.Stdout = os.Stdout
-		}
-		err = cmd.Run()
+	// The modules are prepared while the frontend is built, unless it wasn't built by this build
+	var err error
+	if !options.modulesPrepared {
+		err = prepareModules(options)
 		if err != nil {
 			return err
 		}
 	}
 
+	verbose := options.Verbosity == VERBOSE
+
 	// Default go build command
 	commands := slicer.String([]string{"build"})
 
@@ -224,12 +230,30 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 		commands.Add("-a")
 	}
 
+	if options.assetsOverlay != "" {
+		commands.Add("-overlay")
+		commands.Add(options.assetsOverlay)
+	}
+
 	var tags slicer.StringSlicer
 	tags.Add(options.OutputType)
 	tags.AddSlice(options.UserTags)
 
-	// Add webview2 strategy if we have it
-	if options.WebView2Strategy != "" {
+	// Embed the files listed in the project config
+	err = b.generateResources(options)
+	if err != nil {
+		return err
+	}
+
+	// Portable builds embed the WebView2 runtime instead of using an installed one
+	if options.Portable && options.Platform == "windows" {
+		err = b.generatePortableRuntime(options)
+		if err != nil {
+			return err
+		}
+		tags.Add("wv2runtime.portable")
+	} else if options.WebView2Strategy != "" {
+		// Add webview2 strategy if we have it
 		tags.Add(options.WebView2Strategy)
 	}
 
@@ -241,6 +265,20 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 		tags.Add("debug")
 	}
 
+	// The pprof endpoint is only built into applications when it is requested
+	if options.Profile {
+		tags.Add("pprof")
+	}
+
+	// Compile out the runtime features that aren't listed in the project config
+	if options.ProjectData.RuntimeFeatures != nil {
+		featureTags, err := features.BuildTags(*options.ProjectData.RuntimeFeatures)
+		if err != nil {
+			return err
+		}
+		tags.AddSlice(featureTags)
+	}
+
 	tags.Deduplicate()
 
 	// Add the output type build tag
@@ -253,10 +291,64 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 		ldflags.Add(options.LDFlags)
 	}
 
+	// Debug builds may load the frontend from a running dev server
+	if options.Mode == Debug && options.FrontendDevServerURL != "" {
+		ldflags.Add("-X github.com/wailsapp/wails/v2/internal/appng.frontendDevServerURL=" + options.FrontendDevServerURL)
+	}
+
+	// Build information, printed by the application with `--version` if enabled
+	if options.ProjectData.Info.ProductVersion != "" {
+		flag, err := setStringFlag("github.com/wailsapp/wails/v2/internal/appng.buildAppVersion", options.ProjectData.Info.ProductVersion)
+		if err != nil {
+			return err
+		}
+		ldflags.Add(flag)
+	}
+	// The project name namespaces the settings of the application
+	appNameFlag, err := setStringFlag("github.com/wailsapp/wails/v2/internal/appng.buildAppName", options.ProjectData.Name)
+	if err != nil {
+		return err
+	}
+	ldflags.Add(appNameFlag)
+	if options.ProjectData.Identifier != "" {
+		ldflags.Add("-X github.com/wailsapp/wails/v2/internal/appng.buildIdentifier=" + options.ProjectData.Identifier)
+	}
+	if options.ProjectData.Info.Copyright != "" {
+		flag, err := setStringFlag("github.com/wailsapp/wails/v2/internal/appng.buildCopyright", options.ProjectData.Info.Copyright)
+		if err != nil {
+			return err
+		}
+		ldflags.Add(flag)
+	}
+	buildTime, err := buildTimestamp(os.Environ())
+	if err != nil {
+		return err
+	}
+	ldflags.Add("-X github.com/wailsapp/wails/v2/internal/appng.buildTime=" + buildTime)
+	// The assets change all the time in dev mode, so their hash is computed by the application
+	if options.Mode != Dev {
+		if hash := frontendAssetsHash(options.ProjectData); hash != "" {
+			ldflags.Add("-X github.com/wailsapp/wails/v2/internal/appng.buildAssetsHash=" + hash)
+		}
+	}
+
 	if options.Mode == Production {
-		ldflags.Add("-w", "-s")
+		// The debug information is moved to a sidecar after linking
+		if !options.KeepSymbols && !options.DebugSymbols {
+			ldflags.Add("-w", "-s")
+		}
+		// dsymutil can't read compressed DWARF
+		if options.DebugSymbols && options.Platform == "darwin" {
+			ldflags.Add("-compressdwarf=false")
+		}
+		// Applications flash a console window unless they are linked as Windows GUI applications.
+		// Debug builds keep the console for logging.
 		if options.Platform == "windows" {
-			ldflags.Add("-H windowsgui")
+			if !options.WindowsConsole {
+				ldflags.Add("-H windowsgui")
+			} else if strings.Contains(options.LDFlags, "windowsgui") {
+				options.Logger.Warning("Warning: ldflags contain '-H windowsgui', so the application will not have a console")
+			}
 		}
 	}
 
@@ -289,6 +381,7 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 	cmd := exec.Command(options.Compiler, commands.AsSlice()...)
 	cmd.Stderr = os.Stderr
 	if verbose {
+		println("")
 		println("  Build command:", commands.Join(" "))
 		cmd.Stdout = os.Stdout
 	}
@@ -355,12 +448,22 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 		}
 	}
 
+	// Windows applications don't need cgo, so it is disabled when cross-compiling, unless it has been enabled
+	if isWindowsCrossCompile(options) {
+		cmd.Env = upsertEnv(cmd.Env, "CGO_ENABLED", func(v string) string {
+			if v == "1" {
+				return v
+			}
+			return "0"
+		})
+	}
+
 	cmd.Env = upsertEnv(cmd.Env, "GOOS", func(v string) string {
 		return options.Platform
 	})
 
 	cmd.Env = upsertEnv(cmd.Env, "GOARCH", func(v string) string {
-		return options.Arch
+		return options.ABI()
 	})
 
 	if verbose {
@@ -373,6 +476,7 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 
 	// Format error if we have one
 	if err != nil {
+		b.endPhase()
 		if options.Platform == "darwin" {
 			output, _ := cmd.CombinedOutput()
 			stdErr := string(output)
@@ -389,18 +493,26 @@ Please reinstall by doing the following:
 		return err
 	}
 
+	return nil
+}
+
+// CompressBinary compresses the compiled binary using UPX, if requested
+func (b *BaseBuilder) CompressBinary(options *Options) error {
 	if !options.Compress {
 		return nil
 	}
 
-	fmt.Printf("Compressing application: ")
+	verbose := options.Verbosity == VERBOSE
+	outputLogger := options.Logger
 
 	// Do we have upx installed?
 	if !shell.CommandExists("upx") {
-		println("Warning: Cannot compress binary: upx not found")
+		outputLogger.Warning("  - Warning: Cannot compress binary: upx not found")
 		return nil
 	}
 
+	outputLogger.Phase("Compressing")
+
 	var args = []string{"--best", "--no-color", "--no-progress", options.CompiledBinary}
 
 	if options.CompressFlags != "" {
@@ -409,6 +521,7 @@ Please reinstall by doing the following:
 	}
 
 	if verbose {
+		b.endPhase()
 		println("upx", strings.Join(args, " "))
 	}
 
@@ -416,7 +529,7 @@ Please reinstall by doing the following:
 	if err != nil {
 		return errors.Wrap(err, "Error during compression:")
 	}
-	println("Done.")
+	outputLogger.Println("Done.")
 	if verbose {
 		println(string(output))
 	}
@@ -424,6 +537,44 @@ Please reinstall by doing the following:
 	return nil
 }
 
+// endPhase stops the progress spinner before command output is written to stdout
+func (b *BaseBuilder) endPhase() {
+	if b.options.Logger != nil {
+		b.options.Logger.EndPhase()
+	}
+}
+
+// prepareModules generates the runtime wrapper and tidies the Go modules of the project
+func prepareModules(options *Options) error {
+	// The runtime wrapper and go.mod are shared by targets built in parallel
+	projectLock.Lock()
+	defer projectLock.Unlock()
+
+	err := generateRuntimeWrapper(options)
+	if err != nil {
+		return err
+	}
+	err = tidyModules(options)
+	if err != nil {
+		return err
+	}
+	options.modulesPrepared = true
+	return nil
+}
+
+// tidyModules runs `go mod tidy`, unless it is skipped
+func tidyModules(options *Options) error {
+	if options.SkipModTidy {
+		return nil
+	}
+	cmd := exec.Command(options.Compiler, "mod", "tidy")
+	cmd.Stderr = os.Stderr
+	if options.Verbosity == VERBOSE {
+		cmd.Stdout = os.Stdout
+	}
+	return cmd.Run()
+}
+
 func generateRuntimeWrapper(options *Options) error {
 	if options.WailsJSDir == "" {
 		options.WailsJSDir = filepath.Join("./frontend")
@@ -504,6 +655,7 @@ func (b *BaseBuilder) NpmInstallUsingCommand(sourceDir string, installCommand st
 	cmd := strings.Split(installCommand, " ")
 	stdout, stderr, err := shell.RunCommand(sourceDir, cmd[0], cmd[1:]...)
 	if verbose || err != nil {
+		b.endPhase()
 		for _, l := range strings.Split(stdout, "\n") {
 			fmt.Printf("    %s\n", l)
 		}
@@ -519,6 +671,7 @@ func (b *BaseBuilder) NpmInstallUsingCommand(sourceDir string, installCommand st
 func (b *BaseBuilder) NpmRun(projectDir, buildTarget string, verbose bool) error {
 	stdout, stderr, err := shell.RunCommand(projectDir, "npm", "run", buildTarget)
 	if verbose || err != nil {
+		b.endPhase()
 		for _, l := range strings.Split(stdout, "\n") {
 			fmt.Printf("    %s\n", l)
 		}
@@ -538,6 +691,7 @@ func (b *BaseBuilder) NpmRunWithEnvironment(projectDir, buildTarget string, verb
 	cmd.Stderr = &stde
 	err := cmd.Run()
 	if verbose || err != nil {
+		b.endPhase()
 		for _, l := range strings.Split(stdo.String(), "\n") {
 			fmt.Printf("    %s\n", l)
 		}
@@ -561,7 +715,7 @@ func (b *BaseBuilder) BuildFrontend(outputLogger *clilogger.CLILogger) error {
 		outputLogger.Println("  - No Install command. Skipping.")
 	} else {
 		// Do install if needed
-		outputLogger.Print("  - Installing frontend dependencies: ")
+		outputLogger.Phase("Installing frontend deps")
 		if verbose {
 			outputLogger.Println("")
 			outputLogger.Println("  Install command: '" + b.projectData.InstallCommand + "'")
@@ -589,7 +743,7 @@ func (b *BaseBuilder) BuildFrontend(outputLogger *clilogger.CLILogger) error {
 		return nil
 	}
 
-	outputLogger.Print("  - Compiling frontend: ")
+	outputLogger.Phase("Building frontend")
 	cmd := strings.Split(buildCommand, " ")
 	if verbose {
 		outputLogger.Println("")
@@ -597,6 +751,7 @@ func (b *BaseBuilder) BuildFrontend(outputLogger *clilogger.CLILogger) error {
 	}
 	stdout, stderr, err := shell.RunCommand(frontendDir, cmd[0], cmd[1:]...)
 	if verbose || err != nil {
+		b.endPhase()
 		for _, l := range strings.Split(stdout, "\n") {
 			fmt.Printf("    %s\n", l)
 		}
@@ -620,6 +775,35 @@ func (b *BaseBuilder) ExtractAssets() (*html.AssetBundle, error) {
 	return nil, nil
 }
 
+// buildTimestamp returns the time the application is built. It is taken from SOURCE_DATE_EPOCH if it is set,
+// so reproducible builds produce the same binary.
+func buildTimestamp(env []string) (string, error) {
+	epoch := strings.TrimSpace(envValue(env, "SOURCE_DATE_EPOCH"))
+	if epoch == "" {
+		return time.Now().UTC().Format(time.RFC3339), nil
+	}
+	seconds, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid SOURCE_DATE_EPOCH '%s': it must be a number of seconds since the Unix epoch", epoch)
+	}
+	return time.Unix(seconds, 0).UTC().Format(time.RFC3339), nil
+}
+
+// setStringFlag returns the `-X` linker flag setting the string variable to the value. `go build` splits the ldflags
+// at spaces, and doesn't unescape quoted fields, so a value with spaces is quoted with a quote it doesn't contain.
+func setStringFlag(variable string, value string) (string, error) {
+	field := variable + "=" + value
+	switch {
+	case !strings.ContainsAny(field, " \t\n\r"):
+		return "-X " + field, nil
+	case !strings.Contains(field, "'"):
+		return "-X '" + field + "'", nil
+	case !strings.Contains(field, `"`):
+		return `-X "` + field + `"`, nil
+	}
+	return "", fmt.Errorf("unable to pass '%s' to the linker: it contains spaces, single quotes and double quotes", value)
+}
+
 func upsertEnv(env []string, key string, update func(v string) string) []string {
 	newEnv := make([]string, len(env), len(env)+1)
 	found := false
@@ -1,6 +1,11 @@
 package build
 
-import "testing"
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
 
 func TestUpdateEnv(t *testing.T) {
 
@@ -29,3 +34,107 @@ func TestUpdateEnv(t *testing.T) {
 	}
 
 }
+
+func TestBuildTimestamp(t *testing.T) {
+	got, err := buildTimestamp([]string{"SOURCE_DATE_EPOCH=1644833730"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "2022-02-14T10:15:30Z" {
+		t.Errorf("expected: \"2022-02-14T10:15:30Z\", got: %q", got)
+	}
+	_, err = buildTimestamp([]string{"SOURCE_DATE_EPOCH=yesterday"})
+	if err == nil {
+		t.Error("expected an error for an invalid SOURCE_DATE_EPOCH")
+	}
+	got, err = buildTimestamp(nil)
+	if err != nil || got == "" {
+		t.Errorf("expected the current time, got: %q, %v", got, err)
+	}
+}
+
+func TestSetStringFlag(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"1.0.0", "-X pkg.name=1.0.0", false},
+		{"Joe's", "-X pkg.name=Joe's", false},
+		{"My App", "-X 'pkg.name=My App'", false},
+		{"© 2022 Joe's Company", `-X "pkg.name=© 2022 Joe's Company"`, false},
+		{`The "Best" App`, `-X 'pkg.name=The "Best" App'`, false},
+		{`Joe's "Best" App`, "", true},
+	}
+	for _, tt := range tests {
+		got, err := setStringFlag("pkg.name", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("setStringFlag(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("setStringFlag(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestSetBundleIdentifier(t *testing.T) {
+	plist := "<key>CFBundleName</key>\n<string>myapp</string>\n<key>CFBundleIdentifier</key>\n    <string>com.wails.myapp</string>\n"
+	want := "<key>CFBundleName</key>\n<string>myapp</string>\n<key>CFBundleIdentifier</key>\n    <string>com.mycompany.myapp</string>\n"
+	if got := string(setBundleIdentifier([]byte(plist), "com.mycompany.myapp")); got != want {
+		t.Errorf("expected: %q, got: %q", want, got)
+	}
+}
+
+func TestDistListContains(t *testing.T) {
+	distList := "windows/amd64\r\nwindows/arm64\r\nwindows/arm64ec\r\n"
+	if !distListContains(distList, "windows/arm64ec") {
+		t.Errorf("expected windows/arm64ec to be listed")
+	}
+	if distListContains("windows/amd64\nwindows/arm64\n", "windows/arm64ec") {
+		t.Errorf("expected windows/arm64ec not to be listed")
+	}
+	if distListContains("windows/arm64ec-test\n", "windows/arm64ec") {
+		t.Errorf("expected only whole lines to match")
+	}
+}
+
+func TestDebugSymbolsFilename(t *testing.T) {
+	buildDir := filepath.Join("app", "build", "bin")
+	tests := []struct {
+		name    string
+		options Options
+		want    string
+	}{
+		{"windows", Options{Platform: "windows", CompiledBinary: filepath.Join(buildDir, "myapp.exe")}, "myapp.exe.debug"},
+		{"linux", Options{Platform: "linux", CompiledBinary: filepath.Join(buildDir, "myapp")}, "myapp.debug"},
+		{"darwin", Options{Platform: "darwin", CompiledBinary: filepath.Join(buildDir, "myapp"), Pack: true, ProjectData: &project.Project{Name: "My App"}}, "My App.app.dSYM"},
+		{"darwin bundle name", Options{Platform: "darwin", CompiledBinary: filepath.Join(buildDir, "myapp"), Pack: true, BundleName: "myapp-arm64.app"}, "myapp-arm64.app.dSYM"},
+		{"darwin without packaging", Options{Platform: "darwin", CompiledBinary: filepath.Join(buildDir, "myapp")}, "myapp.dSYM"},
+	}
+	for _, tt := range tests {
+		tt.options.BuildDirectory = buildDir
+		if got := debugSymbolsFilename(&tt.options); got != filepath.Join(buildDir, tt.want) {
+			t.Errorf("%s: expected %s, got %s", tt.name, filepath.Join(buildDir, tt.want), got)
+		}
+	}
+}
+
+func TestCheckWindowsCrossCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     []string
+		wantErr bool
+	}{
+		{"cgo not set", []string{"PATH=/usr/bin"}, false},
+		{"cgo disabled", []string{"CGO_ENABLED=0"}, false},
+		{"cgo without a compiler", []string{"CGO_ENABLED=1"}, true},
+		{"cgo with a missing compiler", []string{"CGO_ENABLED=1", "CC=wails-missing-mingw32-gcc"}, true},
+		{"cgo overridden", []string{"CGO_ENABLED=1", "CGO_ENABLED=0"}, false},
+	}
+	for _, tt := range tests {
+		if err := checkWindowsCrossCompile("amd64", tt.env); (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
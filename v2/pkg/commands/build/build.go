@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/wailsapp/wails/v2/internal/fs"
 
@@ -27,89 +28,126 @@ const (
 	Debug
 )
 
+// MarshalText returns the name of the mode, so it is readable in the output of `wails build -printconfig`
+func (m Mode) MarshalText() ([]byte, error) {
+	switch m {
+	case Dev:
+		return []byte("dev"), nil
+	case Production:
+		return []byte("production"), nil
+	case Debug:
+		return []byte("debug"), nil
+	}
+	return nil, fmt.Errorf("unknown mode %d", m)
+}
+
 // Options contains all the build options as well as the project data
 type Options struct {
-	LDFlags             string               // Optional flags to pass to linker
-	UserTags            []string             // Tags to pass to the Go compiler
-	Logger              *clilogger.CLILogger // All output to the logger
-	OutputType          string               // EG: desktop, server....
-	Mode                Mode                 // release or dev
-	ProjectData         *project.Project     // The project data
-	Pack                bool                 // Create a package for the app after building
-	Platform            string               // The platform to build for
-	Arch                string               // The architecture to build for
-	Compiler            string               // The compiler command to use
-	SkipModTidy         bool                 //  Skip mod tidy before compile
-	IgnoreFrontend      bool                 // Indicates if the frontend does not need building
-	OutputFile          string               // Override the output filename
-	BuildDirectory      string               // Directory to use for building the application
-	CleanBuildDirectory bool                 // Indicates if the build directory should be cleaned before building
-	CompiledBinary      string               // Fully qualified path to the compiled binary
-	KeepAssets          bool                 // Keep the generated assets/files
-	Verbosity           int                  // Verbosity level (0 - silent, 1 - default, 2 - verbose)
-	Compress            bool                 // Compress the final binary
-	CompressFlags       string               // Flags to pass to UPX
-	WebView2Strategy    string               // WebView2 installer strategy
-	RunDelve            bool                 // Indicates if we should run delve after the build
-	WailsJSDir          string               // Directory to generate the wailsjs module
-	ForceBuild          bool                 // Force
-	BundleName          string               // Bundlename for Mac
+	LDFlags              string               // Optional flags to pass to linker
+	UserTags             []string             // Tags to pass to the Go compiler
+	Logger               *clilogger.CLILogger `json:"-"` // All output to the logger
+	OutputType           string               // EG: desktop, server....
+	Mode                 Mode                 // release or dev
+	ProjectData          *project.Project     // The project data
+	ProjectDirectory     string               // The directory of the project, defaults to the working directory
+	Pack                 bool                 // Create a package for the app after building
+	Platform             string               // The platform to build for
+	Arch                 string               // The architecture to build for
+	Compiler             string               // The compiler command to use
+	SkipModTidy          bool                 //  Skip mod tidy before compile
+	IgnoreFrontend       bool                 // Indicates if the frontend does not need building
+	OutputFile           string               // Override the output filename
+	BuildDirectory       string               // Directory to use for building the application
+	CleanBuildDirectory  bool                 // Indicates if the build directory should be cleaned before building
+	CompiledBinary       string               // Fully qualified path to the compiled binary
+	KeepAssets           bool                 // Keep the generated assets/files
+	Verbosity            int                  // Verbosity level (0 - silent, 1 - default, 2 - verbose)
+	Compress             bool                 // Compress the final binary
+	CompressFlags        string               // Flags to pass to UPX
+	WebView2Strategy     string               // WebView2 installer strategy
+	RunDelve             bool                 // Indicates if we should run delve after the build
+	WailsJSDir           string               // Directory to generate the wailsjs module
+	ForceBuild           bool                 // Force
+	BundleName           string               // Bundlename for Mac
+	FrontendDevServerURL string               // Debug builds only: URL of a frontend dev server to load instead of the embedded assets
+	Portable             bool                 // Windows only: embed the WebView2 runtime in build/windows/webview2 in the binary
+	KeepSymbols          bool                 // Production builds only: keep the symbol table and debug information
+	WindowsConsole       bool                 // Windows only: build a console application instead of linking with `-H windowsgui`
+	ExcludeAssets        bool                 // Production builds only: exclude source maps or the files matching `build:excludeassets` from the embedded frontend assets
+	Profile              bool                 // Serve the pprof endpoint on localhost in the application
+	ARM64EC              bool                 // Experimental, windows/arm64 only: build for the ARM64EC ABI
+	ExtraFilesCopied     []string             // The files copied by `build:extrafiles`, relative to the output directory
+	DebugSymbols         bool                 // Production builds only: move the debug information to a sidecar in the build directory
+	DebugSymbolsFile     string               // The path of the debug symbols sidecar, if one was created
+	SignIdentity         string               // macOS only: the identity that signs the application bundle, or "-" to sign it ad hoc
+	NSIS                 bool                 // Windows only: create an installer of the application with NSIS
+	NSISFlags            string               // Flags to pass to makensis
+	InstallerFile        string               // The path of the installer, if one was created
+	AppVersion           *AppVersion          // The version of the application in the project config, set by ResolveOptions
+
+	frontendBuilt   bool   // The frontend has been built by BuildFrontend
+	modulesPrepared bool   // The runtime wrapper has been generated and the modules tidied, while the frontend was built
+	assetsOverlay   string // The build overlay excluding frontend assets from the application
 }
 
-// Build the project!
-func Build(options *Options) (string, error) {
-
-	// Extract logger
-	outputLogger := options.Logger
+// Clone returns a copy of the options, so targets can be built in parallel with their own options
+func (o *Options) Clone() *Options {
+	result := *o
+	result.UserTags = append([]string{}, o.UserTags...)
+	result.ExtraFilesCopied = append([]string{}, o.ExtraFilesCopied...)
+	return &result
+}
 
-	// Get working directory
-	cwd, err := os.Getwd()
+// BuildFrontend builds the frontend, and cleans the build directory if requested, before targets are built in
+// parallel with clones of the options. Builds with the options, or their clones, don't build the frontend again.
+func BuildFrontend(options *Options) error {
+	builder, err := newBuilder(options)
 	if err != nil {
-		return "", err
+		return err
 	}
+	defer builder.CleanUp()
 
-	// Load project
-	projectData, err := project.Load(cwd)
-	if err != nil {
-		return "", err
+	if options.CleanBuildDirectory {
+		err = cleanBuildDirectory(options)
+		if err != nil {
+			return err
+		}
+		options.CleanBuildDirectory = false
 	}
-	options.ProjectData = projectData
 
-	// Add default path if it doesn't exist
-	if projectData.Path == "" {
-		projectData.Path = cwd
+	if !options.IgnoreFrontend || options.ForceBuild {
+		err = buildFrontendAndModules(builder, options)
+		if err != nil {
+			return err
+		}
 	}
+	options.frontendBuilt = true
+	return nil
+}
 
-	// Set build directory
-	options.BuildDirectory = filepath.Join(options.ProjectData.Path, "build", "bin")
-
-	// Save the project type
-	projectData.OutputType = options.OutputType
+// Build the project!
+func Build(options *Options) (string, error) {
 
-	// Create builder
-	var builder Builder
+	// Extract logger
+	outputLogger := options.Logger
 
-	switch projectData.OutputType {
-	case "desktop":
-		builder = newDesktopBuilder(options)
-	case "hybrid":
-		builder = newHybridBuilder(options)
-	case "server":
-		builder = newServerBuilder(options)
-	case "dev":
-		builder = newDesktopBuilder(options)
-	default:
-		return "", fmt.Errorf("cannot build assets for output type %s", projectData.OutputType)
+	builder, err := newBuilder(options)
+	if err != nil {
+		return "", err
 	}
 
 	// Set up our clean up method
 	defer builder.CleanUp()
 
-	// Initialise Builder
-	builder.SetProjectData(projectData)
+	projectData := options.ProjectData
 
-	if !options.IgnoreFrontend || options.ForceBuild {
-		err = builder.BuildFrontend(outputLogger)
+	// The modules are prepared again by the next build, as the code may have changed since
+	defer func() {
+		options.modulesPrepared = false
+	}()
+
+	if (!options.IgnoreFrontend || options.ForceBuild) && !options.frontendBuilt {
+		err = buildFrontendAndModules(builder, options)
 		if err != nil {
 			return "", err
 		}
@@ -118,7 +156,7 @@ func Build(options *Options) (string, error) {
 	// If we are building for windows, we will need to generate the asset bundle before
 	// compilation. This will be a .syso file in the project root
 	if options.Pack && options.Platform == "windows" {
-		outputLogger.Print("  - Generating bundle assets: ")
+		outputLogger.Phase("Generating bundle assets")
 		err := packageApplicationForWindows(options)
 		if err != nil {
 			return "", err
@@ -127,15 +165,29 @@ func Build(options *Options) (string, error) {
 
 		// When we finish, we will want to remove the syso file
 		defer func() {
-			err := os.Remove(filepath.Join(options.ProjectData.Path, options.ProjectData.Name+"-res.syso"))
+			err := os.Remove(windowsResourcesFilename(options))
 			if err != nil {
 				log.Fatal(err)
 			}
 		}()
 	}
 
+	// Source maps and other development files are kept in debug builds
+	if options.ExcludeAssets && options.Mode == Production {
+		options.assetsOverlay, err = excludeFrontendAssets(options)
+		if err != nil {
+			return "", err
+		}
+		if options.assetsOverlay != "" {
+			defer func() {
+				_ = os.Remove(options.assetsOverlay)
+				options.assetsOverlay = ""
+			}()
+		}
+	}
+
 	// Compile the application
-	outputLogger.Print("  - Compiling application: ")
+	outputLogger.Phase("Compiling Go")
 
 	if options.Platform == "darwin" && options.Arch == "universal" {
 		outputFile := builder.OutputFilename(options)
@@ -185,6 +237,10 @@ func Build(options *Options) (string, error) {
 		}
 		projectData.OutputFilename = outputFile
 		options.CompiledBinary = filepath.Join(options.BuildDirectory, outputFile)
+		// The arch was set to each target in turn. It is restored, so the extra files and the contents of the bundle
+		// are selected for `darwin/universal`.
+		options.Arch = "universal"
+
 	} else {
 		err = builder.CompileProject(options)
 		if err != nil {
@@ -194,10 +250,30 @@ func Build(options *Options) (string, error) {
 
 	outputLogger.Println("Done.")
 
+	// The symbols are extracted before the binary is compressed or moved into the application bundle
+	options.DebugSymbolsFile = ""
+	if options.DebugSymbols && options.Mode == Production {
+		err = extractDebugSymbols(options)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = builder.CompressBinary(options)
+	if err != nil {
+		return "", err
+	}
+
+	// The extra files are copied before packaging, so they are signed with the macOS application bundle
+	err = copyExtraFiles(options)
+	if err != nil {
+		return "", err
+	}
+
 	// Do we need to pack the app for non-windows?
 	if options.Pack && options.Platform != "windows" {
 
-		outputLogger.Print("  - Packaging application: ")
+		outputLogger.Phase("Packaging")
 
 		// TODO: Allow cross platform build
 		err = packageProject(options, runtime.GOOS)
@@ -213,8 +289,186 @@ func Build(options *Options) (string, error) {
 		return "", err
 	}
 
+	options.InstallerFile = ""
+	if options.NSIS && options.Platform == "windows" {
+		err = createNSISInstaller(options)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	result := options.CompiledBinary
 
 	return result, nil
 
 }
+
+// ResolveOptions loads the project and applies the defaults of the options, as they are when building
+func ResolveOptions(options *Options) error {
+
+	// The working directory is only read when the project directory isn't given, as it is shared by the targets
+	// built in parallel
+	projectDir := options.ProjectDirectory
+	if projectDir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		projectDir = cwd
+	}
+
+	// Load project
+	projectData, err := project.Load(projectDir)
+	if err != nil {
+		return err
+	}
+	options.ProjectData = projectData
+
+	if projectData.Identifier != "" {
+		err = project.ValidateIdentifier(projectData.Identifier)
+		if err != nil {
+			return err
+		}
+	}
+
+	var warnings []string
+	options.AppVersion, warnings, err = resolveAppVersion(projectData.Info, options.Platform, os.Environ())
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		options.Logger.Warning("Warning: " + warning)
+	}
+
+	// Add default path if it doesn't exist
+	if projectData.Path == "" {
+		projectData.Path = projectDir
+	}
+
+	// Generate the runtime wrapper in the `wailsjsdir` of the project
+	if options.WailsJSDir == "" && projectData.WailsJSDir != "" {
+		options.WailsJSDir = projectData.WailsJSDir
+		if !filepath.IsAbs(options.WailsJSDir) {
+			options.WailsJSDir = filepath.Join(projectData.Path, options.WailsJSDir)
+		}
+	}
+
+	// Set build directory
+	options.BuildDirectory = filepath.Join(options.ProjectData.Path, "build", "bin")
+
+	// Save the project type
+	projectData.OutputType = options.OutputType
+
+	return nil
+}
+
+// newBuilder resolves the options and creates the builder for the output type of the project
+func newBuilder(options *Options) (Builder, error) {
+
+	err := ResolveOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	projectData := options.ProjectData
+
+	// Check the toolchain before spending time on the frontend
+	if isWindowsCrossCompile(options) {
+		err = checkWindowsCrossCompile(options.Arch, os.Environ())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if options.useARM64EC() {
+		err = checkARM64ECSupport(options.Compiler)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if options.NSIS && options.Platform == "windows" {
+		err = checkNSIS()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create builder
+	var builder Builder
+
+	switch projectData.OutputType {
+	case "desktop":
+		builder = newDesktopBuilder(options)
+	case "hybrid":
+		builder = newHybridBuilder(options)
+	case "server":
+		builder = newServerBuilder(options)
+	case "dev":
+		builder = newDesktopBuilder(options)
+	default:
+		return nil, fmt.Errorf("cannot build assets for output type %s", projectData.OutputType)
+	}
+
+	// Initialise Builder
+	builder.SetProjectData(projectData)
+
+	return builder, nil
+}
+
+// buildFrontendAndModules builds the frontend while the Go modules are tidied, as neither needs the other. The runtime
+// wrapper is generated beforehand, as the frontend imports it. Errors of both are reported.
+func buildFrontendAndModules(builder Builder, options *Options) error {
+	projectLock.Lock()
+	err := generateRuntimeWrapper(options)
+	projectLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	var modulesErr error
+	var modulesTime time.Duration
+	modulesDone := make(chan struct{})
+	go func() {
+		defer close(modulesDone)
+		projectLock.Lock()
+		defer projectLock.Unlock()
+		modulesErr = tidyModules(options)
+		modulesTime = time.Since(start)
+	}()
+
+	frontendErr := buildFrontend(builder, options)
+	frontendTime := time.Since(start)
+	<-modulesDone
+
+	switch {
+	case frontendErr != nil && modulesErr != nil:
+		return fmt.Errorf("unable to build the frontend: %s. Unable to tidy the Go modules: %s", frontendErr.Error(), modulesErr.Error())
+	case frontendErr != nil:
+		return fmt.Errorf("unable to build the frontend: %w", frontendErr)
+	case modulesErr != nil:
+		return fmt.Errorf("unable to tidy the Go modules: %w", modulesErr)
+	}
+	options.modulesPrepared = true
+
+	if options.Verbosity == VERBOSE && !options.SkipModTidy {
+		saved := frontendTime + modulesTime - time.Since(start)
+		options.Logger.Println("Built the frontend in %s while the Go modules were tidied in %s, saving %s.",
+			frontendTime.Round(time.Millisecond), modulesTime.Round(time.Millisecond), saved.Round(time.Millisecond))
+	}
+	return nil
+}
+
+// buildFrontend builds the frontend of the project
+func buildFrontend(builder Builder, options *Options) error {
+	err := builder.BuildFrontend(options.Logger)
+	if err != nil {
+		return err
+	}
+
+	// A misconfigured build command would otherwise embed no assets and the application would show a
+	// blank window. Dev builds may load the assets from a dev server.
+	projectData := options.ProjectData
+	if projectData.BuildCommand != "" && options.Mode != Dev {
+		return checkAssetDirectory(assetDirectory(projectData))
+	}
+	return nil
+}
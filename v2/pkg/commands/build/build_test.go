@@ -0,0 +1,71 @@
+package build
+
+import (
+	"errors"
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/pkg/clilogger"
+)
+
+// frontendBuilder is a builder whose frontend build returns the given error
+type frontendBuilder struct {
+	*DesktopBuilder
+	err error
+}
+
+func (b *frontendBuilder) BuildFrontend(*clilogger.CLILogger) error {
+	return b.err
+}
+
+func TestBuildFrontendAndModules(t *testing.T) {
+	for _, command := range []string{"true", "false"} {
+		if _, err := exec.LookPath(command); err != nil {
+			t.Skipf("'%s' is required to stand in for the compiler", command)
+		}
+	}
+	tests := []struct {
+		name        string
+		frontendErr error
+		compiler    string
+		wantErr     []string
+	}{
+		{"success", nil, "true", nil},
+		{"frontend", errors.New("npm failed"), "true", []string{"unable to build the frontend: npm failed"}},
+		{"modules", nil, "false", []string{"unable to tidy the Go modules"}},
+		{"both", errors.New("npm failed"), "false", []string{"unable to build the frontend: npm failed", "Unable to tidy the Go modules"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := &Options{
+				Compiler:    tt.compiler,
+				WailsJSDir:  t.TempDir(),
+				ProjectData: &project.Project{},
+				Logger:      clilogger.New(io.Discard),
+			}
+			builder := &frontendBuilder{DesktopBuilder: newDesktopBuilder(options), err: tt.frontendErr}
+
+			err := buildFrontendAndModules(builder, options)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if !options.modulesPrepared {
+					t.Error("expected the modules to be prepared")
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected the error to contain %q, got: %s", want, err)
+				}
+			}
+		})
+	}
+}
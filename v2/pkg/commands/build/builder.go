@@ -12,6 +12,7 @@ type Builder interface {
 	BuildFrontend(*clilogger.CLILogger) error
 	BuildRuntime(*Options) error
 	CompileProject(*Options) error
+	CompressBinary(*Options) error
 	OutputFilename(*Options) string
 	PostCompilation(*Options) error
 	CleanUp()
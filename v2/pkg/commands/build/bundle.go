@@ -0,0 +1,180 @@
+package build
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/shell"
+)
+
+// bundleContentDirectories are the directories of the bundle's Contents that `build:darwin:contents` may copy into
+var bundleContentDirectories = []string{"MacOS", "Resources", "Frameworks", "Helpers"}
+
+// Extensions of the nested bundles that are signed as a whole
+var nestedBundleExtensions = []string{".app", ".framework", ".bundle", ".xpc", ".appex"}
+
+// Magic numbers of 32 and 64 bit Mach-O binaries in both byte orders
+var machOMagics = [][]byte{
+	{0xfe, 0xed, 0xfa, 0xce},
+	{0xce, 0xfa, 0xed, 0xfe},
+	{0xfe, 0xed, 0xfa, 0xcf},
+	{0xcf, 0xfa, 0xed, 0xfe},
+}
+
+// Magic number of universal Mach-O binaries, which is also the magic number of Java class files
+var fatMagic = []byte{0xca, 0xfe, 0xba, 0xbe}
+
+// The header of a universal binary has the number of architectures after the magic number, where a Java class file
+// has its version, which is at least 45
+const maxFatArchitectures = 44
+
+// copyBundleContents copies the `build:darwin:contents` of the project for the target into the Contents directory
+// of the application bundle
+func copyBundleContents(options *Options, contentsDirectory string) error {
+	for _, contents := range options.ProjectData.DarwinContents {
+		if !contents.IncludedFor(options.Platform, options.Arch) {
+			continue
+		}
+		err := validateBundleDestination(contents.Destination)
+		if err != nil {
+			return err
+		}
+		files, err := extraFileCopies(options.ProjectData.Path, "build:darwin:contents", contents)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			err = copyExtraFile(file.source, filepath.Join(contentsDirectory, file.target))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateBundleDestination returns an error if the destination isn't in one of the bundleContentDirectories
+func validateBundleDestination(destination string) error {
+	topLevel := strings.Split(filepath.ToSlash(filepath.Clean(destination)), "/")[0]
+	for _, directory := range bundleContentDirectories {
+		if topLevel == directory {
+			return nil
+		}
+	}
+	return fmt.Errorf("the destination '%s' in build:darwin:contents must be in one of the directories of the bundle: %s", destination, strings.Join(bundleContentDirectories, ", "))
+}
+
+// signBundle signs the application bundle with the identity given by options.SignIdentity. The code nested in the
+// bundle is signed first, as the signature of the bundle covers the signatures of its contents.
+func signBundle(options *Options, bundleDirectory string) error {
+	if !shell.CommandExists("codesign") {
+		return fmt.Errorf("'codesign' is required to sign the application but it wasn't found. Please install the Xcode command line tools: xcode-select --install")
+	}
+	contentsDirectory := filepath.Join(bundleDirectory, "Contents")
+	nested, err := nestedCode(contentsDirectory, options.CompiledBinary)
+	if err != nil {
+		return err
+	}
+	for _, code := range append(nested, bundleDirectory) {
+		err = codesign(options.SignIdentity, code)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func codesign(identity string, path string) error {
+	args := []string{"--force", "--sign", identity}
+	// Notarization requires the hardened runtime and a secure timestamp, which ad hoc signatures can't have
+	if identity != "-" {
+		args = append(args, "--options", "runtime", "--timestamp")
+	}
+	_, stderr, err := shell.RunCommand(".", "codesign", append(args, path)...)
+	if err != nil {
+		return fmt.Errorf("unable to sign %s: %s - %s", path, err.Error(), stderr)
+	}
+	return nil
+}
+
+// nestedCode returns the nested bundles and Mach-O binaries in the Contents directory of a bundle, other than the
+// main binary of the application
+func nestedCode(contentsDirectory string, mainBinary string) ([]string, error) {
+	var result []string
+	for _, directory := range bundleContentDirectories {
+		root := filepath.Join(contentsDirectory, directory)
+		if _, err := os.Stat(root); os.IsNotExist(err) {
+			continue
+		}
+		err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				if path != root && isNestedBundle(entry.Name()) {
+					result = append(result, path)
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !entry.Type().IsRegular() || path == mainBinary {
+				return nil
+			}
+			machO, err := isMachO(path)
+			if err != nil {
+				return err
+			}
+			if machO {
+				result = append(result, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func isNestedBundle(name string) bool {
+	for _, extension := range nestedBundleExtensions {
+		if strings.EqualFold(filepath.Ext(name), extension) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMachO returns true if the file is a Mach-O binary
+func isMachO(filename string) (bool, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	header := make([]byte, 8)
+	_, err = io.ReadFull(file, header)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	magic := header[:4]
+	if bytes.Equal(magic, fatMagic) {
+		architectures := binary.BigEndian.Uint32(header[4:])
+		return architectures > 0 && architectures <= maxFatArchitectures, nil
+	}
+	for _, machOMagic := range machOMagics {
+		if bytes.Equal(magic, machOMagic) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
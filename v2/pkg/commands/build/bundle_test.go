@@ -0,0 +1,67 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestValidateBundleDestination(t *testing.T) {
+	tests := []struct {
+		destination string
+		wantErr     bool
+	}{
+		{"Helpers/myhelper", false},
+		{"Frameworks", false},
+		{"MacOS/tool", false},
+		{"Resources/data/config.yaml", false},
+		{"", true},
+		{"Info.plist", true},
+		{"Library/LaunchServices/helper", true},
+	}
+	for _, tt := range tests {
+		if err := validateBundleDestination(tt.destination); (err != nil) != tt.wantErr {
+			t.Errorf("%q: error = %v, wantErr %v", tt.destination, err, tt.wantErr)
+		}
+	}
+}
+
+func TestNestedCode(t *testing.T) {
+	contents := t.TempDir()
+	machO := []byte{0xcf, 0xfa, 0xed, 0xfe, 0x07, 0x00, 0x00, 0x01}
+	files := map[string][]byte{
+		"MacOS/myapp": machO,
+		"MacOS/tool":  machO,
+		"Helpers/Helper.app/Contents/MacOS/Helper": machO,
+		"Frameworks/libfoo.dylib":                  machO,
+		"Frameworks/Foo.framework/Versions/A/Foo":  machO,
+		"Resources/config.yaml":                    []byte("key: value"),
+		"Resources/tiny":                           {0xcf},
+		"Resources/universal":                      {0xca, 0xfe, 0xba, 0xbe, 0x00, 0x00, 0x00, 0x02},
+		"Resources/Main.class":                     {0xca, 0xfe, 0xba, 0xbe, 0x00, 0x00, 0x00, 0x34},
+	}
+	for filename, data := range files {
+		filename = filepath.Join(contents, filepath.FromSlash(filename))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, data, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := nestedCode(contents, filepath.Join(contents, "MacOS", "myapp"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var relative []string
+	for _, path := range got {
+		rel, _ := filepath.Rel(contents, path)
+		relative = append(relative, filepath.ToSlash(rel))
+	}
+	want := []string{"MacOS/tool", "Resources/universal", "Frameworks/Foo.framework", "Frameworks/libfoo.dylib", "Helpers/Helper.app"}
+	if !reflect.DeepEqual(relative, want) {
+		t.Errorf("expected %v, got %v", want, relative)
+	}
+}
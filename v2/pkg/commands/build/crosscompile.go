@@ -0,0 +1,54 @@
+package build
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// mingwCompilers are the C compilers of mingw-w64 for each Windows architecture, suggested when cgo is enabled
+var mingwCompilers = map[string]string{
+	"amd64": "x86_64-w64-mingw32-gcc",
+	"arm64": "aarch64-w64-mingw32-clang",
+}
+
+// isWindowsCrossCompile returns true if a Windows target is built on another platform
+func isWindowsCrossCompile(options *Options) bool {
+	return options.Platform == "windows" && runtime.GOOS != "windows"
+}
+
+// checkWindowsCrossCompile returns an error if the Windows target can't be built on this platform with the given
+// environment. Windows applications don't need cgo, so they are built without it, unless CGO_ENABLED=1 is set.
+// This needs a C compiler for the target, given by CC.
+func checkWindowsCrossCompile(arch string, env []string) error {
+	if envValue(env, "CGO_ENABLED") != "1" {
+		return nil
+	}
+	compiler := strings.Fields(envValue(env, "CC"))
+	if len(compiler) == 0 {
+		return fmt.Errorf("CGO_ENABLED=1 needs a C compiler for windows/%s to build on %s: set CC to a mingw-w64 compiler, EG: CC=%s, or unset CGO_ENABLED", arch, runtime.GOOS, mingwCompiler(arch))
+	}
+	if _, err := exec.LookPath(compiler[0]); err != nil {
+		return fmt.Errorf("the C compiler '%s' given by CC was not found. It is needed to build windows/%s with CGO_ENABLED=1", compiler[0], arch)
+	}
+	return nil
+}
+
+func mingwCompiler(arch string) string {
+	if compiler, ok := mingwCompilers[arch]; ok {
+		return compiler
+	}
+	return "x86_64-w64-mingw32-gcc"
+}
+
+// envValue returns the value of the variable in the environment, or an empty string if it isn't set
+func envValue(env []string, key string) string {
+	value := ""
+	for _, variable := range env {
+		if strings.HasPrefix(variable, key+"=") {
+			value = strings.TrimPrefix(variable, key+"=")
+		}
+	}
+	return value
+}
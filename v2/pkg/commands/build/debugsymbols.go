@@ -0,0 +1,100 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/wailsapp/wails/v2/internal/shell"
+)
+
+// extractDebugSymbols moves the debug information of the compiled binary to a sidecar in the build directory, named
+// after the application: a dSYM bundle on macOS, or a file of the DWARF sections on Windows and Linux. The binary is
+// then stripped. On Windows and Linux, it is linked to the sidecar with a `.gnu_debuglink` section, so debuggers find
+// it when it is next to the binary.
+func extractDebugSymbols(options *Options) error {
+	sidecar := debugSymbolsFilename(options)
+	options.Logger.Phase("Extracting debug symbols")
+
+	// dSYM bundles are directories, which aren't replaced by dsymutil
+	err := os.RemoveAll(sidecar)
+	if err != nil {
+		return err
+	}
+	if options.Platform == "darwin" {
+		err = extractDSYM(options, sidecar)
+	} else {
+		err = extractDWARF(options, sidecar)
+	}
+	if err != nil {
+		return err
+	}
+
+	options.DebugSymbolsFile = sidecar
+	options.Logger.Println("Done.")
+	return nil
+}
+
+// debugSymbolsFilename returns the path of the debug symbols sidecar of the application
+func debugSymbolsFilename(options *Options) string {
+	if options.Platform == "darwin" {
+		name := filepath.Base(options.CompiledBinary)
+		if options.Pack {
+			name = appBundleName(options)
+		}
+		return filepath.Join(options.BuildDirectory, name+".dSYM")
+	}
+	return filepath.Join(options.BuildDirectory, filepath.Base(options.CompiledBinary)+".debug")
+}
+
+func extractDSYM(options *Options, sidecar string) error {
+	for _, tool := range []string{"dsymutil", "strip"} {
+		if !shell.CommandExists(tool) {
+			return fmt.Errorf("'%s' is required to create the debug symbols but it wasn't found. Please install the Xcode command line tools: xcode-select --install", tool)
+		}
+	}
+	binary := options.CompiledBinary
+	_, stderr, err := shell.RunCommand(options.BuildDirectory, "dsymutil", binary, "-o", sidecar)
+	if err != nil {
+		return fmt.Errorf("unable to create %s: %s - %s", filepath.Base(sidecar), err.Error(), stderr)
+	}
+	_, stderr, err = shell.RunCommand(options.BuildDirectory, "strip", "-S", binary)
+	if err != nil {
+		return fmt.Errorf("unable to strip %s: %s - %s", filepath.Base(binary), err.Error(), stderr)
+	}
+	// Stripping invalidates the ad-hoc signature that arm64 binaries must have to run
+	if options.Arch != "amd64" {
+		_, stderr, err = shell.RunCommand(options.BuildDirectory, "codesign", "--force", "--sign", "-", binary)
+		if err != nil {
+			return fmt.Errorf("unable to sign %s: %s - %s", filepath.Base(binary), err.Error(), stderr)
+		}
+	}
+	return nil
+}
+
+func extractDWARF(options *Options, sidecar string) error {
+	objcopy := objcopyCommand()
+	if objcopy == "" {
+		return fmt.Errorf("'objcopy' is required to create the debug symbols but it wasn't found. Please install binutils or llvm")
+	}
+	binary := options.CompiledBinary
+	_, stderr, err := shell.RunCommand(options.BuildDirectory, objcopy, "--only-keep-debug", binary, sidecar)
+	if err != nil {
+		return fmt.Errorf("unable to create %s: %s - %s", filepath.Base(sidecar), err.Error(), stderr)
+	}
+	_, stderr, err = shell.RunCommand(options.BuildDirectory, objcopy, "--strip-debug", "--add-gnu-debuglink="+sidecar, binary)
+	if err != nil {
+		return fmt.Errorf("unable to strip %s: %s - %s", filepath.Base(binary), err.Error(), stderr)
+	}
+	return nil
+}
+
+// objcopyCommand returns the objcopy of binutils or llvm, or an empty string if neither is installed
+func objcopyCommand() string {
+	for _, command := range []string{"objcopy", "llvm-objcopy"} {
+		if shell.CommandExists(command) {
+			return command
+		}
+	}
+	return ""
+}
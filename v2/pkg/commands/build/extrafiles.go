@@ -0,0 +1,140 @@
+package build
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+// extraFileCopy is a file copied by `build:extrafiles`
+type extraFileCopy struct {
+	source string // Path of the file in the project
+	target string // Path of the copy, relative to the output directory
+}
+
+// copyExtraFiles copies the `build:extrafiles` of the project for the target next to the built application, or into
+// the Resources of the application bundle on macOS. The copied files are listed in options.ExtraFilesCopied.
+func copyExtraFiles(options *Options) error {
+	options.ExtraFilesCopied = nil
+
+	var copies []extraFileCopy
+	for _, extraFile := range options.ProjectData.ExtraFiles {
+		if !extraFile.IncludedFor(options.Platform, options.Arch) {
+			continue
+		}
+		files, err := extraFileCopies(options.ProjectData.Path, "build:extrafiles", extraFile)
+		if err != nil {
+			return err
+		}
+		copies = append(copies, files...)
+	}
+	if len(copies) == 0 {
+		return nil
+	}
+
+	outputDir := options.BuildDirectory
+	if options.Platform == "darwin" && options.Pack {
+		outputDir = filepath.Join(options.BuildDirectory, appBundleName(options), "Contents", "Resources")
+	}
+
+	options.Logger.Phase("Copying extra files")
+	for _, file := range copies {
+		err := copyExtraFile(file.source, filepath.Join(outputDir, file.target))
+		if err != nil {
+			return err
+		}
+		options.ExtraFilesCopied = append(options.ExtraFilesCopied, filepath.ToSlash(file.target))
+	}
+	options.Logger.Println("Copied %d files.", len(copies))
+	return nil
+}
+
+// extraFileCopies returns the files copied for an extra file, with the paths they are copied to. Directories are
+// copied with all of their files, and symlinks are copied as symlinks. The key of the extra file in the project
+// config is used in errors.
+func extraFileCopies(projectDir string, key string, extraFile project.ExtraFile) ([]extraFileCopy, error) {
+	source := filepath.FromSlash(strings.TrimSpace(extraFile.Source))
+	if source == "" {
+		return nil, fmt.Errorf("an entry in %s has no source", key)
+	}
+	if filepath.IsAbs(source) {
+		return nil, fmt.Errorf("the source '%s' in %s must be relative to the project directory", extraFile.Source, key)
+	}
+	destination := filepath.Clean(filepath.FromSlash(extraFile.Destination))
+	if filepath.IsAbs(destination) || destination == ".." || strings.HasPrefix(destination, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("the destination '%s' in %s must be within the output directory", extraFile.Destination, key)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(projectDir, source))
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s' in %s: %w", extraFile.Source, key, err)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("'%s' in %s doesn't match any files", extraFile.Source, key)
+	}
+	sort.Strings(matches)
+
+	// A glob pattern is copied into the destination. A file or directory is renamed to it.
+	isPattern := strings.ContainsAny(source, "*?[")
+	var result []extraFileCopy
+	for _, match := range matches {
+		target := destination
+		if isPattern {
+			target = filepath.Join(destination, filepath.Base(match))
+		} else if extraFile.Destination == "" {
+			target = filepath.Base(match)
+		}
+		err = filepath.WalkDir(match, func(filename string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				return nil
+			}
+			relative, err := filepath.Rel(match, filename)
+			if err != nil {
+				return err
+			}
+			result = append(result, extraFileCopy{source: filename, target: filepath.Join(target, relative)})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// copyExtraFile copies a file, keeping its permissions, and creates the directories of the target. Symlinks are
+// copied as symlinks, so the structure of frameworks is kept.
+func copyExtraFile(source string, target string) error {
+	info, err := os.Lstat(source)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		link, err := os.Readlink(source)
+		if err != nil {
+			return err
+		}
+		err = os.Remove(target)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return os.Symlink(link, target)
+	}
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(target, data, info.Mode().Perm())
+}
@@ -0,0 +1,54 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func TestExtraFileCopies(t *testing.T) {
+	projectDir := t.TempDir()
+	for _, filename := range []string{"config.yaml", "licenses/MIT.txt", "licenses/sub/BSD.txt", "data/a.db", "data/b.db"} {
+		filename = filepath.Join(projectDir, filepath.FromSlash(filename))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		extraFile project.ExtraFile
+		want      []string
+		wantErr   bool
+	}{
+		{"file", project.ExtraFile{Source: "config.yaml"}, []string{"config.yaml"}, false},
+		{"renamed file", project.ExtraFile{Source: "config.yaml", Destination: "conf/app.yaml"}, []string{"conf/app.yaml"}, false},
+		{"directory", project.ExtraFile{Source: "licenses"}, []string{"licenses/MIT.txt", "licenses/sub/BSD.txt"}, false},
+		{"renamed directory", project.ExtraFile{Source: "licenses", Destination: "legal"}, []string{"legal/MIT.txt", "legal/sub/BSD.txt"}, false},
+		{"glob", project.ExtraFile{Source: "data/*.db"}, []string{"a.db", "b.db"}, false},
+		{"glob into directory", project.ExtraFile{Source: "data/*.db", Destination: "db"}, []string{"db/a.db", "db/b.db"}, false},
+		{"no match", project.ExtraFile{Source: "missing.txt"}, nil, true},
+		{"no source", project.ExtraFile{}, nil, true},
+		{"outside the output directory", project.ExtraFile{Source: "config.yaml", Destination: "../config.yaml"}, nil, true},
+	}
+	for _, tt := range tests {
+		copies, err := extraFileCopies(projectDir, "build:extrafiles", tt.extraFile)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		var got []string
+		for _, file := range copies {
+			got = append(got, filepath.ToSlash(file.target))
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package build
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/leaanthony/slicer"
+	"github.com/wailsapp/wails/v2/internal/shell"
+)
+
+// nsisTemplate is the NSIS script of the installer. It installs the application and its extra files in Program
+// Files, adds a shortcut to the start menu and registers the uninstaller with Windows. The user may install into a
+// directory that holds other files, so the uninstaller only removes the installed files, and the directories once
+// they are empty.
+const nsisTemplate = `; Generated by wails build. DO NOT EDIT.
+Unicode true
+
+!define PRODUCT_NAME {{quote .Name}}
+!define UNINSTALL_KEY "Software\Microsoft\Windows\CurrentVersion\Uninstall\${PRODUCT_NAME}"
+
+Name "${PRODUCT_NAME}"
+OutFile {{quote .Installer}}
+InstallDir "$PROGRAMFILES64\${PRODUCT_NAME}"
+RequestExecutionLevel admin
+ShowInstDetails show
+ShowUninstDetails show
+
+Page directory
+Page instfiles
+UninstPage uninstConfirm
+UninstPage instfiles
+
+Function .onInit
+	SetRegView 64
+FunctionEnd
+
+Function un.onInit
+	SetRegView 64
+FunctionEnd
+
+Section
+	SetOutPath "$INSTDIR"
+	File {{quote .Binary}}
+{{- range .ExtraFiles}}
+	SetOutPath "$INSTDIR{{nsis .Directory}}"
+	File {{quote .Source}}
+{{- end}}
+	SetOutPath "$INSTDIR"
+
+	CreateShortCut "$SMPROGRAMS\${PRODUCT_NAME}.lnk" "$INSTDIR\{{nsis .BinaryName}}"
+	WriteUninstaller "$INSTDIR\uninstall.exe"
+	WriteRegStr HKLM "${UNINSTALL_KEY}" "DisplayName" "${PRODUCT_NAME}"
+	WriteRegStr HKLM "${UNINSTALL_KEY}" "DisplayIcon" "$INSTDIR\{{nsis .BinaryName}}"
+{{- if .Version}}
+	WriteRegStr HKLM "${UNINSTALL_KEY}" "DisplayVersion" {{quote .Version}}
+{{- end}}
+	WriteRegStr HKLM "${UNINSTALL_KEY}" "UninstallString" "$\"$INSTDIR\uninstall.exe$\""
+SectionEnd
+
+Section "uninstall"
+	Delete "$SMPROGRAMS\${PRODUCT_NAME}.lnk"
+	Delete "$INSTDIR\{{nsis .BinaryName}}"
+{{- range .ExtraFiles}}
+	Delete "$INSTDIR{{nsis .Directory}}\{{nsis .Name}}"
+{{- end}}
+	Delete "$INSTDIR\uninstall.exe"
+{{- range .Directories}}
+	RMDir "$INSTDIR{{nsis .}}"
+{{- end}}
+	RMDir "$INSTDIR"
+	DeleteRegKey HKLM "${UNINSTALL_KEY}"
+SectionEnd
+`
+
+// nsisScript is the data of the nsisTemplate
+type nsisScript struct {
+	Name       string
+	Version    string
+	Binary     string // Path of the compiled binary
+	BinaryName string
+	Installer  string // Path of the installer
+	ExtraFiles []nsisFile
+	// The directories of the extra files, relative to the installation directory, the deepest first
+	Directories []string
+}
+
+// nsisFile is a file installed with the application
+type nsisFile struct {
+	Source    string // Path of the file
+	Directory string // Directory it is installed in, relative to the installation directory, EG: \licenses
+	Name      string // Filename it is installed as
+}
+
+// checkNSIS returns an error if makensis isn't installed
+func checkNSIS() error {
+	if !shell.CommandExists("makensis") {
+		return fmt.Errorf("cannot create the installer: makensis not found. Please install NSIS (https://nsis.sourceforge.io) and add makensis to the PATH")
+	}
+	return nil
+}
+
+// installerFilename returns the path of the NSIS installer of the application, next to the compiled binary and named
+// after it, as the project name may not be a valid filename
+func installerFilename(options *Options) string {
+	return strings.TrimSuffix(options.CompiledBinary, ".exe") + "-installer.exe"
+}
+
+// createNSISInstaller creates an installer of the application and its extra files with makensis. The script is
+// generated next to the installer, and removed afterwards unless the assets are kept.
+func createNSISInstaller(options *Options) error {
+	options.Logger.Phase("Creating installer")
+
+	installer := installerFilename(options)
+	script, err := generateNSISScript(options, installer)
+	if err != nil {
+		return err
+	}
+	scriptFile := strings.TrimSuffix(installer, ".exe") + ".nsi"
+	err = os.WriteFile(scriptFile, script, 0644)
+	if err != nil {
+		return err
+	}
+	if !options.KeepAssets {
+		defer os.Remove(scriptFile)
+	}
+
+	var args []string
+	if options.NSISFlags != "" {
+		args = strings.Split(options.NSISFlags, " ")
+	}
+	args = append(args, scriptFile)
+
+	if options.Verbosity == VERBOSE {
+		options.Logger.EndPhase()
+		println("makensis", strings.Join(args, " "))
+	}
+
+	output, err := exec.Command("makensis", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error creating the installer: %s\n%s", err.Error(), string(output))
+	}
+	if options.Verbosity == VERBOSE {
+		println(string(output))
+	}
+
+	options.InstallerFile = installer
+	options.Logger.Println("Done.")
+	return nil
+}
+
+// generateNSISScript returns the NSIS script of the installer
+func generateNSISScript(options *Options, installer string) ([]byte, error) {
+	data := nsisScript{
+		Name:       options.ProjectData.Name,
+		Version:    options.ProjectData.Info.ProductVersion,
+		Binary:     options.CompiledBinary,
+		BinaryName: filepath.Base(options.CompiledBinary),
+		Installer:  installer,
+	}
+	if options.AppVersion != nil && options.AppVersion.ShortVersion != "" {
+		data.Version = options.AppVersion.ShortVersion
+	}
+	directories := slicer.String()
+	for _, file := range options.ExtraFilesCopied {
+		directory := ""
+		if dir := path.Dir(file); dir != "." {
+			directory = `\` + strings.ReplaceAll(dir, "/", `\`)
+			for ; dir != "."; dir = path.Dir(dir) {
+				directories.AddUnique(`\` + strings.ReplaceAll(dir, "/", `\`))
+			}
+		}
+		data.ExtraFiles = append(data.ExtraFiles, nsisFile{
+			Source:    filepath.Join(options.BuildDirectory, filepath.FromSlash(file)),
+			Directory: directory,
+			Name:      path.Base(file),
+		})
+	}
+	// A directory is removed after the directories in it
+	data.Directories = directories.AsSlice()
+	sort.Slice(data.Directories, func(i, j int) bool {
+		return data.Directories[i] > data.Directories[j]
+	})
+
+	functions := template.FuncMap{
+		"nsis": escapeNSIS,
+		"quote": func(value string) string {
+			return `"` + escapeNSIS(value) + `"`
+		},
+	}
+	tmpl, err := template.New("installer").Funcs(functions).Parse(nsisTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var result bytes.Buffer
+	err = tmpl.Execute(&result, data)
+	if err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+// escapeNSIS escapes a value for a string in an NSIS script
+func escapeNSIS(value string) string {
+	return strings.NewReplacer(`$`, `$$`, `"`, `$\"`).Replace(value)
+}
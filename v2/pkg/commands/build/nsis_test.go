@@ -0,0 +1,67 @@
+package build
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func TestGenerateNSISScript(t *testing.T) {
+	buildDir := filepath.Join("project", "build", "bin")
+	options := &Options{
+		ProjectData: &project.Project{
+			Name: `My "App"`,
+			Info: project.Info{ProductVersion: "1.0.0"},
+		},
+		BuildDirectory:   buildDir,
+		CompiledBinary:   filepath.Join(buildDir, "myapp.exe"),
+		ExtraFilesCopied: []string{"config.yaml", "licenses/MIT.txt", "licenses/fonts/OFL.txt"},
+		AppVersion:       &AppVersion{ShortVersion: "1.2.3"},
+	}
+
+	script, err := generateNSISScript(options, filepath.Join(buildDir, "installer.exe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`!define PRODUCT_NAME "My $\"App$\""`,
+		`File "` + filepath.Join(buildDir, "myapp.exe") + `"`,
+		"SetOutPath \"$INSTDIR\"\n\tFile \"" + filepath.Join(buildDir, "config.yaml") + `"`,
+		"SetOutPath \"$INSTDIR\\licenses\"\n\tFile \"" + filepath.Join(buildDir, "licenses", "MIT.txt") + `"`,
+		`"DisplayVersion" "1.2.3"`,
+		`OutFile "` + filepath.Join(buildDir, "installer.exe") + `"`,
+		"Delete \"$INSTDIR\\myapp.exe\"\n\tDelete \"$INSTDIR\\config.yaml\"\n\tDelete \"$INSTDIR\\licenses\\MIT.txt\"\n" +
+			"\tDelete \"$INSTDIR\\licenses\\fonts\\OFL.txt\"\n\tDelete \"$INSTDIR\\uninstall.exe\"\n" +
+			"\tRMDir \"$INSTDIR\\licenses\\fonts\"\n\tRMDir \"$INSTDIR\\licenses\"\n\tRMDir \"$INSTDIR\"\n",
+	} {
+		if !strings.Contains(string(script), want) {
+			t.Errorf("expected the script to contain %q, got:\n%s", want, script)
+		}
+	}
+	// The user may install into a directory that holds other files
+	if strings.Contains(string(script), "RMDir /r") {
+		t.Errorf("expected the uninstaller to only remove the installed files, got:\n%s", script)
+	}
+}
+
+func TestEscapeNSIS(t *testing.T) {
+	got := escapeNSIS(`C:\$PROGRAMFILES\"app"`)
+	want := `C:\$$PROGRAMFILES\$\"app$\"`
+	if got != want {
+		t.Errorf("expected: %s, got: %s", want, got)
+	}
+}
+
+func TestInstallerFilename(t *testing.T) {
+	binary := filepath.Join("project", "build", "bin", "myapp-amd64.exe")
+	options := &Options{
+		ProjectData:    &project.Project{Name: `My "App"`},
+		CompiledBinary: binary,
+	}
+	want := filepath.Join("project", "build", "bin", "myapp-amd64-installer.exe")
+	if got := installerFilename(options); got != want {
+		t.Errorf("installerFilename() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,139 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+// Package packages an application that has already been compiled, without rebuilding it.
+// The binary to package is given by options.CompiledBinary. Returns the path of the
+// packaged application.
+func Package(options *Options) (string, error) {
+
+	// Extract logger
+	outputLogger := options.Logger
+
+	if !fs.FileExists(options.CompiledBinary) {
+		return "", fmt.Errorf("binary not found: %s", options.CompiledBinary)
+	}
+	binary, err := filepath.Abs(options.CompiledBinary)
+	if err != nil {
+		return "", err
+	}
+
+	// Get working directory
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	// Load project
+	projectData, err := project.Load(cwd)
+	if err != nil {
+		return "", err
+	}
+	options.ProjectData = projectData
+
+	// Add default path if it doesn't exist
+	if projectData.Path == "" {
+		projectData.Path = cwd
+	}
+
+	// Set build directory
+	options.BuildDirectory = filepath.Join(options.ProjectData.Path, "build", "bin")
+	err = os.MkdirAll(options.BuildDirectory, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	if options.Platform == "" {
+		options.Platform = runtime.GOOS
+	}
+
+	outputLogger.Phase("Packaging")
+
+	switch options.Platform {
+	case "darwin":
+		// Packaging moves the binary into the bundle, so work on a copy of it
+		bundledBinary := filepath.Join(options.BuildDirectory, filepath.Base(binary)+".tmp")
+		err = fs.CopyFile(binary, bundledBinary)
+		if err != nil {
+			return "", err
+		}
+		options.CompiledBinary = bundledBinary
+		err = packageApplicationForDarwin(options)
+		if err != nil {
+			_ = os.Remove(bundledBinary)
+		}
+	case "windows":
+		options.CompiledBinary = binary
+		err = packageBinaryForWindows(options)
+	case "linux":
+		options.CompiledBinary = binary
+		err = packageApplicationForLinux(options)
+	default:
+		err = fmt.Errorf("packing not supported for %s yet", options.Platform)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	outputLogger.Println("Done.")
+
+	if options.Platform == "darwin" {
+		return filepath.Join(options.BuildDirectory, appBundleName(options)), nil
+	}
+	return options.CompiledBinary, nil
+}
+
+// packageBinaryForWindows replaces the resources of a compiled Windows binary with the
+// icon, manifest and version information in build/windows
+func packageBinaryForWindows(options *Options) error {
+	err := generateIcoFile(options)
+	if err != nil {
+		return err
+	}
+
+	err = generateManifest(options)
+	if err != nil {
+		return err
+	}
+
+	rs, err := windowsResources(options)
+	if err != nil {
+		return err
+	}
+
+	source, err := os.Open(options.CompiledBinary)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	// The binary can't be patched in place
+	patchedBinary := options.CompiledBinary + ".tmp"
+	target, err := os.Create(patchedBinary)
+	if err != nil {
+		return err
+	}
+	err = rs.WriteToEXE(target, source)
+	if closeErr := target.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(patchedBinary)
+		return fmt.Errorf("unable to update the resources of %s: %s", options.CompiledBinary, err.Error())
+	}
+
+	// Windows doesn't allow an open file to be replaced
+	err = source.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(patchedBinary, options.CompiledBinary)
+}
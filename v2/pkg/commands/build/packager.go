@@ -1,12 +1,15 @@
 package build
 
 import (
+	"bytes"
 	"fmt"
 	"image"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"strconv"
 
 	"github.com/leaanthony/winicon"
 	"github.com/tc-hib/winres"
@@ -85,12 +88,7 @@ func packageApplicationForDarwin(options *Options) error {
 	var err error
 
 	// Create directory structure
-	bundlename := options.BundleName
-	if bundlename == "" {
-		bundlename = options.ProjectData.Name + ".app"
-	}
-
-	contentsDirectory := filepath.Join(options.BuildDirectory, bundlename, "/Contents")
+	contentsDirectory := filepath.Join(options.BuildDirectory, appBundleName(options), "/Contents")
 	exeDir := filepath.Join(contentsDirectory, "/MacOS")
 	err = fs.MkDirs(exeDir, 0755)
 	if err != nil {
@@ -124,11 +122,33 @@ func packageApplicationForDarwin(options *Options) error {
 		return err
 	}
 
+	// Add the helpers, frameworks and other files of the project to the bundle
+	err = copyBundleContents(options, contentsDirectory)
+	if err != nil {
+		return err
+	}
+
 	options.CompiledBinary = packedBinaryPath
 
+	// The bundle is signed last, as changing its contents invalidates the signature
+	if options.SignIdentity != "" {
+		err = signBundle(options, filepath.Dir(contentsDirectory))
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// appBundleName returns the name of the macOS application bundle
+func appBundleName(options *Options) string {
+	if options.BundleName != "" {
+		return options.BundleName
+	}
+	return options.ProjectData.Name + ".app"
+}
+
 func processPList(options *Options, contentsDirectory string) error {
 
 	// Check if plist already exists in project dir
@@ -144,7 +164,54 @@ func processPList(options *Options, contentsDirectory string) error {
 
 	// Copy it to the contents directory
 	targetFile := filepath.Join(contentsDirectory, "Info.plist")
-	return fs.CopyFile(plistFile, targetFile)
+	if options.ProjectData.Identifier == "" && options.AppVersion == nil {
+		return fs.CopyFile(plistFile, targetFile)
+	}
+
+	plist, err := os.ReadFile(plistFile)
+	if err != nil {
+		return err
+	}
+	// Use the identifier of the project as the bundle ID
+	if options.ProjectData.Identifier != "" {
+		plist = setBundleIdentifier(plist, options.ProjectData.Identifier)
+	}
+	if options.AppVersion != nil {
+		plist = setBundleVersion(plist, options.AppVersion)
+	}
+	return os.WriteFile(targetFile, plist, 0644)
+}
+
+// setBundleIdentifier sets the CFBundleIdentifier of the plist
+func setBundleIdentifier(plist []byte, identifier string) []byte {
+	return setPlistString(plist, "CFBundleIdentifier", identifier)
+}
+
+// setBundleVersion sets the CFBundleShortVersionString and CFBundleVersion of the plist to the short version and
+// build number
+func setBundleVersion(plist []byte, appVersion *AppVersion) []byte {
+	if appVersion.ShortVersion != "" {
+		plist = setPlistString(plist, "CFBundleShortVersionString", appVersion.ShortVersion)
+	}
+	if appVersion.BuildNumber != 0 {
+		plist = setPlistString(plist, "CFBundleVersion", strconv.Itoa(appVersion.BuildNumber))
+	}
+	return plist
+}
+
+// setPlistString sets the string value of the key in the plist. The key is added to the end of the dictionary if
+// the plist doesn't have it.
+func setPlistString(plist []byte, key string, value string) []byte {
+	keyRegex := regexp.MustCompile(`(<key>` + regexp.QuoteMeta(key) + `</key>\s*<string>)[^<]*(</string>)`)
+	if keyRegex.Match(plist) {
+		return keyRegex.ReplaceAll(plist, []byte("${1}"+value+"${2}"))
+	}
+	end := bytes.LastIndex(plist, []byte("</dict>"))
+	if end == -1 {
+		return plist
+	}
+	entry := fmt.Sprintf("\t<key>%s</key><string>%s</string>\n", key, value)
+	return append(append(append([]byte{}, plist[:end]...), entry...), plist[end:]...)
 }
 
 func processApplicationIcon(resourceDir string, iconsDir string) (err error) {
@@ -192,6 +259,10 @@ func processApplicationIcon(resourceDir string, iconsDir string) (err error) {
 }
 
 func packageApplicationForWindows(options *Options) error {
+	// The icon and manifest are generated in the project, which is shared by targets built in parallel
+	projectLock.Lock()
+	defer projectLock.Unlock()
+
 	// Generate icon
 	var err error
 	err = generateIcoFile(options)
@@ -272,71 +343,89 @@ func generateIcoFile(options *Options) error {
 	return nil
 }
 
+// windowsResourcesFilename returns the path of the syso file of the Windows resources. It is named after the target, so
+// it is only linked into the binary of that target when targets are built in parallel.
+func windowsResourcesFilename(options *Options) string {
+	return filepath.Join(options.ProjectData.Path, fmt.Sprintf("%s-res_windows_%s.syso", options.ProjectData.Name, options.ABI()))
+}
+
 func compileResources(options *Options) error {
 
-	currentDir, err := os.Getwd()
+	rs, err := windowsResources(options)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		os.Chdir(currentDir)
-	}()
-	windowsDir := filepath.Join(options.ProjectData.Path, "build", "windows")
-	err = os.Chdir(windowsDir)
+
+	fout, err := os.Create(windowsResourcesFilename(options))
 	if err != nil {
 		return err
 	}
-	rs := winres.ResourceSet{}
+	defer fout.Close()
+
+	archs := map[string]winres.Arch{
+		"amd64": winres.ArchAMD64,
+		"arm64": winres.ArchARM64,
+	}
+	targetArch, supported := archs[options.Arch]
+	if !supported {
+		return fmt.Errorf("arch '%s' not supported", options.Arch)
+	}
+
+	err = rs.WriteObject(fout, targetArch)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// windowsResources creates the resources of the application from the files in build/windows
+func windowsResources(options *Options) (*winres.ResourceSet, error) {
+
+	// The paths are absolute, as the working directory is shared by the targets built in parallel
+	windowsDir := filepath.Join(options.ProjectData.Path, "build", "windows")
+	rs := &winres.ResourceSet{}
 	icon := filepath.Join(windowsDir, "icon.ico")
 	iconFile, err := os.Open(icon)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer iconFile.Close()
 	ico, err := winres.LoadICO(iconFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = rs.SetIcon(winres.RT_ICON, ico)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	ManifestFilename := options.ProjectData.Name + ".exe.manifest"
-	manifestData, err := os.ReadFile(ManifestFilename)
+	manifestData, err := os.ReadFile(filepath.Join(windowsDir, ManifestFilename))
+	if err != nil {
+		return nil, err
+	}
 	xmlData, err := winres.AppManifestFromXML(manifestData)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	rs.SetManifest(xmlData)
 
-	if versionInfo, _ := os.ReadFile("info.json"); len(versionInfo) != 0 {
-		var v version.Info
-		if err := v.UnmarshalJSON(versionInfo); err != nil {
-			return err
+	var versionInfo *version.Info
+	if data, _ := os.ReadFile(filepath.Join(windowsDir, "info.json")); len(data) != 0 {
+		versionInfo = &version.Info{}
+		if err := versionInfo.UnmarshalJSON(data); err != nil {
+			return nil, err
 		}
-		rs.SetVersionInfo(v)
-	}
-
-	targetFile := filepath.Join(options.ProjectData.Path, options.ProjectData.Name+"-res.syso")
-	fout, err := os.Create(targetFile)
-	if err != nil {
-		return err
 	}
-	defer fout.Close()
-
-	archs := map[string]winres.Arch{
-		"amd64": winres.ArchAMD64,
-		"arm64": winres.ArchARM64,
+	if options.AppVersion != nil {
+		if versionInfo == nil {
+			versionInfo = &version.Info{}
+		}
+		setWindowsVersion(versionInfo, options.AppVersion)
 	}
-	targetArch, supported := archs[options.Arch]
-	if !supported {
-		return fmt.Errorf("arch '%s' not supported", options.Arch)
+	if versionInfo != nil {
+		rs.SetVersionInfo(*versionInfo)
 	}
 
-	err = rs.WriteObject(fout, targetArch)
-	if err != nil {
-		return err
-	}
-	return nil
+	return rs, nil
 }
@@ -0,0 +1,162 @@
+package build
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// The generated files are named after the architecture, so targets built in parallel don't overwrite each other's
+// runtime, and the Go file is only built for its architecture
+const (
+	portableRuntimeArchive = "wails_webview2_runtime_%s.zip"
+	portableRuntimeSource  = "wails_webview2_runtime_windows_%s.go"
+)
+
+// portableRuntimeTemplate embeds the WebView2 runtime in the main package of portable builds
+const portableRuntimeTemplate = `//go:build windows && wv2runtime.portable
+// +build windows,wv2runtime.portable
+
+// Code generated by wails build -portable. DO NOT EDIT.
+
+package main
+
+import (
+	_ "embed"
+
+	"github.com/wailsapp/wails/v2/pkg/portable"
+)
+
+//go:embed %s
+var wailsWebView2Runtime []byte
+
+func init() {
+	portable.SetWebView2Runtime("%s", wailsWebView2Runtime)
+}
+`
+
+// webView2Architectures maps the architecture suffix of the fixed version runtime directories to Go architectures
+var webView2Architectures = map[string]string{
+	".x64":   "amd64",
+	".arm64": "arm64",
+	".x86":   "386",
+}
+
+// findPortableRuntime returns the directory of the WebView2 fixed version runtime in build/windows/webview2 for the
+// architecture of the target. The runtimes of several architectures may be in the directory.
+func findPortableRuntime(options *Options) (string, error) {
+	searchDir := filepath.Join(options.ProjectData.Path, "build", "windows", "webview2")
+	var runtimeDirs []string
+	err := filepath.Walk(searchDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.EqualFold(info.Name(), "msedgewebview2.exe") {
+			runtimeDirs = append(runtimeDirs, filepath.Dir(path))
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to find the WebView2 fixed version runtime in %s: %s", searchDir, err.Error())
+	}
+	if len(runtimeDirs) == 0 {
+		return "", fmt.Errorf("unable to find the WebView2 fixed version runtime in %s", searchDir)
+	}
+
+	// The runtime directories are named after the version and architecture, EG: Microsoft.WebView2.FixedVersionRuntime.98.0.1108.43.x64
+	// A directory that isn't named after an architecture is used if there is none for the target.
+	var unnamedDir string
+	var found []string
+	for _, runtimeDir := range runtimeDirs {
+		arch := runtimeArchitecture(runtimeDir)
+		if arch == options.Arch {
+			return runtimeDir, nil
+		}
+		if arch == "" && unnamedDir == "" {
+			unnamedDir = runtimeDir
+		}
+		if arch != "" {
+			found = append(found, arch)
+		}
+	}
+	if unnamedDir != "" {
+		return unnamedDir, nil
+	}
+	return "", fmt.Errorf("the WebView2 runtimes in %s are for %s, not %s", searchDir, strings.Join(found, ", "), options.Arch)
+}
+
+// runtimeArchitecture returns the architecture of the WebView2 runtime directory, or "" if it isn't named after one
+func runtimeArchitecture(runtimeDir string) string {
+	name := strings.ToLower(filepath.Base(runtimeDir))
+	for suffix, arch := range webView2Architectures {
+		if strings.HasSuffix(name, suffix) {
+			return arch
+		}
+	}
+	return ""
+}
+
+// generatePortableRuntime zips the WebView2 fixed version runtime into the project and generates the code to embed it
+func (b *BaseBuilder) generatePortableRuntime(options *Options) error {
+	runtimeDir, err := findPortableRuntime(options)
+	if err != nil {
+		return err
+	}
+
+	archiveName := fmt.Sprintf(portableRuntimeArchive, options.ABI())
+	archiveFile := filepath.Join(options.ProjectData.Path, archiveName)
+	sourceFile := filepath.Join(options.ProjectData.Path, fmt.Sprintf(portableRuntimeSource, options.ABI()))
+	return b.generateSharedFiles([]string{archiveFile, sourceFile}, func() error {
+		id, err := zipDirectory(runtimeDir, archiveFile)
+		if err != nil {
+			return err
+		}
+		source := fmt.Sprintf(portableRuntimeTemplate, archiveName, id)
+		return os.WriteFile(sourceFile, []byte(source), 0644)
+	})
+}
+
+// zipDirectory zips the contents of the given directory into the target file. Returns the hash of the archive.
+func zipDirectory(dir string, target string) (string, error) {
+	output, err := os.Create(target)
+	if err != nil {
+		return "", err
+	}
+	defer output.Close()
+
+	hash := sha256.New()
+	archive := zip.NewWriter(io.MultiWriter(output, hash))
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		writer, err := archive.Create(filepath.ToSlash(name))
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(writer, file)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	err = archive.Close()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16], output.Close()
+}
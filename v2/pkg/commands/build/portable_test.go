@@ -0,0 +1,46 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func TestFindPortableRuntime(t *testing.T) {
+	projectDir := t.TempDir()
+	webview2Dir := filepath.Join(projectDir, "build", "windows", "webview2")
+	for _, name := range []string{"Microsoft.WebView2.FixedVersionRuntime.98.0.1108.43.arm64", "Microsoft.WebView2.FixedVersionRuntime.98.0.1108.43.x64"} {
+		err := os.MkdirAll(filepath.Join(webview2Dir, name), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(webview2Dir, name, "msedgewebview2.exe"), nil, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		arch    string
+		want    string
+		wantErr bool
+	}{
+		{"amd64", "Microsoft.WebView2.FixedVersionRuntime.98.0.1108.43.x64", false},
+		{"arm64", "Microsoft.WebView2.FixedVersionRuntime.98.0.1108.43.arm64", false},
+		{"386", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arch, func(t *testing.T) {
+			options := &Options{ProjectData: &project.Project{Path: projectDir}, Arch: tt.arch}
+			got, err := findPortableRuntime(options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("findPortableRuntime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != filepath.Join(webview2Dir, tt.want) {
+				t.Errorf("findPortableRuntime() = %q, want %q", got, filepath.Join(webview2Dir, tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package build
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	resourcesArchive = "wails_resources.zip"
+	resourcesSource  = "wails_resources.go"
+)
+
+// resourcesTemplate embeds the resources listed in the project config in the main package
+const resourcesTemplate = `// Code generated by wails build. DO NOT EDIT.
+
+package main
+
+import (
+	_ "embed"
+
+	"github.com/wailsapp/wails/v2/pkg/resources"
+)
+
+//go:embed %s
+var wailsResources []byte
+
+func init() {
+	resources.SetArchive(wailsResources)
+}
+`
+
+// generateResources zips the files and directories in the `embed` list of the project config
+// into the project and generates the code to embed them
+func (b *BaseBuilder) generateResources(options *Options) error {
+	if len(options.ProjectData.EmbedFiles) == 0 {
+		return nil
+	}
+
+	archiveFile := filepath.Join(options.ProjectData.Path, resourcesArchive)
+	sourceFile := filepath.Join(options.ProjectData.Path, resourcesSource)
+	return b.generateSharedFiles([]string{archiveFile, sourceFile}, func() error {
+		err := zipResources(options.ProjectData.Path, options.ProjectData.EmbedFiles, archiveFile)
+		if err != nil {
+			return err
+		}
+		source := fmt.Sprintf(resourcesTemplate, resourcesArchive)
+		return os.WriteFile(sourceFile, []byte(source), 0644)
+	})
+}
+
+// zipResources zips the given files and directories, relative to the project directory, into the target file.
+// They are stored by their path relative to the project directory.
+func zipResources(projectDir string, paths []string, target string) error {
+	output, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+
+	archive := zip.NewWriter(output)
+	added := map[string]bool{}
+	for _, resource := range paths {
+		root := filepath.Join(projectDir, resource)
+		err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			// The archive may be in a directory being embedded
+			if err != nil || info.IsDir() || path == target {
+				return err
+			}
+			name, err := filepath.Rel(projectDir, path)
+			if err != nil {
+				return err
+			}
+			name = filepath.ToSlash(name)
+			if name == ".." || strings.HasPrefix(name, "../") {
+				return fmt.Errorf("embedded file '%s' must be in the project directory", resource)
+			}
+			if added[name] {
+				return nil
+			}
+			added[name] = true
+			writer, err := archive.Create(name)
+			if err != nil {
+				return err
+			}
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			_, err = io.Copy(writer, file)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("unable to embed '%s': %s", resource, err.Error())
+		}
+	}
+	err = archive.Close()
+	if err != nil {
+		return err
+	}
+	return output.Close()
+}
@@ -0,0 +1,51 @@
+package build
+
+import (
+	"os"
+	"sync"
+)
+
+// projectLock serialises the steps that modify the project directory, such as `go mod tidy`, when targets are
+// built in parallel
+var projectLock sync.Mutex
+
+// sharedFiles counts the builds using each file generated in the project directory. Targets built in parallel share
+// the generated files, so they are created by the first build that needs them and removed by the last.
+var sharedFiles = struct {
+	sync.Mutex
+	users map[string]int
+}{users: map[string]int{}}
+
+// generateSharedFiles calls generate to create the files, unless a build running in parallel has already created
+// them. The files are removed by CleanUp once no other build is using them.
+func (b *BaseBuilder) generateSharedFiles(files []string, generate func() error) error {
+	sharedFiles.Lock()
+	defer sharedFiles.Unlock()
+	generated := sharedFiles.users[files[0]] > 0
+	for _, filename := range files {
+		sharedFiles.users[filename]++
+		b.sharedFiles = append(b.sharedFiles, filename)
+	}
+	if generated {
+		return nil
+	}
+	return generate()
+}
+
+// releaseSharedFiles removes the shared files that are no longer used by another build
+func (b *BaseBuilder) releaseSharedFiles() {
+	sharedFiles.Lock()
+	defer sharedFiles.Unlock()
+	for _, filename := range b.sharedFiles {
+		sharedFiles.users[filename]--
+		if sharedFiles.users[filename] > 0 {
+			continue
+		}
+		delete(sharedFiles.users, filename)
+		if !b.options.KeepAssets {
+			// Errors are ignored because these files will be overwritten by the next build anyway
+			_ = os.Remove(filename)
+		}
+	}
+	b.sharedFiles = nil
+}
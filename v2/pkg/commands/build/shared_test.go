@@ -0,0 +1,48 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSharedFiles(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "shared.go")
+	generations := 0
+	generate := func() error {
+		generations++
+		return os.WriteFile(filename, []byte("package main"), 0644)
+	}
+
+	first := NewBaseBuilder(&Options{})
+	second := NewBaseBuilder(&Options{})
+	for _, builder := range []*BaseBuilder{first, second} {
+		err := builder.generateSharedFiles([]string{filename}, generate)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if generations != 1 {
+		t.Errorf("expected the file to be generated once, got: %d", generations)
+	}
+
+	first.releaseSharedFiles()
+	if _, err := os.Stat(filename); err != nil {
+		t.Errorf("expected the file to be kept while it is in use, got: %s", err)
+	}
+	second.releaseSharedFiles()
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		t.Errorf("expected the file to be removed once it is no longer used, got: %v", err)
+	}
+}
+
+func TestOptionsClone(t *testing.T) {
+	options := &Options{Platform: "windows", UserTags: []string{"one"}}
+	clone := options.Clone()
+	clone.Platform = "linux"
+	clone.UserTags[0] = "two"
+
+	if options.Platform != "windows" || options.UserTags[0] != "one" {
+		t.Errorf("expected the options to be unchanged by the clone, got: %s %v", options.Platform, options.UserTags)
+	}
+}
@@ -0,0 +1,61 @@
+package build
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/system"
+)
+
+// platformArchs are the platforms and architectures that may be built
+var platformArchs = []string{
+	"darwin/amd64",
+	"darwin/arm64",
+	"darwin/universal",
+	"linux/amd64",
+	"linux/arm64",
+	"windows/amd64",
+	"windows/arm64",
+}
+
+// UnsupportedTargetError is returned by ParseTarget for a target that can't be built
+type UnsupportedTargetError struct {
+	Target string
+}
+
+func (e *UnsupportedTargetError) Error() string {
+	return fmt.Sprintf("platform '%s' is not supported", e.Target)
+}
+
+// SupportedTargets returns the targets that may be built: the platforms, which are built for the architecture of the
+// host, followed by the platforms and architectures, EG: "windows/arm64" or "darwin/universal"
+func SupportedTargets() []string {
+	return append([]string{"darwin", "linux", "windows"}, platformArchs...)
+}
+
+// ParseTarget returns the platform and architecture of a target, EG: "windows/arm64". The architecture of a platform
+// without one is the architecture of the host, which is arm64 on Apple Silicon even when running under Rosetta.
+// Returns an *UnsupportedTargetError if the target isn't one of the SupportedTargets.
+func ParseTarget(target string) (platform string, arch string, err error) {
+	supported := false
+	for _, supportedTarget := range SupportedTargets() {
+		if target == supportedTarget {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return "", "", &UnsupportedTargetError{Target: target}
+	}
+
+	parts := strings.Split(target, "/")
+	if len(parts) == 2 {
+		return parts[0], parts[1], nil
+	}
+	arch = runtime.GOARCH
+	if system.IsAppleSilicon {
+		arch = "arm64"
+	}
+	return parts[0], arch, nil
+}
@@ -0,0 +1,47 @@
+package build
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		target       string
+		wantPlatform string
+		wantArch     string
+		wantErr      bool
+	}{
+		{"windows/arm64", "windows", "arm64", false},
+		{"darwin/universal", "darwin", "universal", false},
+		{"linux/amd64", "linux", "amd64", false},
+		{"windows/386", "", "", true},
+		{"freebsd", "", "", true},
+		{"", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			platform, arch, err := ParseTarget(tt.target)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTarget() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if platform != tt.wantPlatform || arch != tt.wantArch {
+				t.Errorf("ParseTarget() = %s, %s, want %s, %s", platform, arch, tt.wantPlatform, tt.wantArch)
+			}
+			var unsupported *UnsupportedTargetError
+			if tt.wantErr && (!errors.As(err, &unsupported) || unsupported.Target != tt.target) {
+				t.Errorf("expected an UnsupportedTargetError for %q, got: %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestParseTargetDefaultArch(t *testing.T) {
+	platform, arch, err := ParseTarget("windows")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if platform != "windows" || arch == "" {
+		t.Errorf("expected windows and the host architecture, got: %s, %s", platform, arch)
+	}
+}
@@ -0,0 +1,111 @@
+package build
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/shell"
+	"github.com/wailsapp/wails/v2/internal/system"
+)
+
+// Toolchain is the Go toolchain that compiles the application
+type Toolchain struct {
+	Compiler string // The compiler command
+	Version  string // EG: go1.18.1
+	Platform string // The platform the toolchain runs on
+	Arch     string // The architecture the toolchain runs on, which may differ from the host under emulation
+	GOROOT   string // The directory of the toolchain
+	GOARCH   string // The architecture the toolchain builds for by default
+
+	hostPlatform string // The platform of the host
+	hostArch     string // The native architecture of the host
+	distList     string // The targets the toolchain can build, listed by `go tool dist list`
+}
+
+// DetectToolchain runs the compiler to find out the version, platform and architecture of the toolchain, and the
+// targets it can build
+func DetectToolchain(compiler string) (*Toolchain, error) {
+	stdout, stderr, err := shell.RunCommand(".", compiler, "version")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the version of the compiler '%s': %s - %s", compiler, err.Error(), stderr)
+	}
+	result := &Toolchain{
+		Compiler:     compiler,
+		hostPlatform: runtime.GOOS,
+		hostArch:     runtime.GOARCH,
+	}
+	result.Version, result.Platform, result.Arch, err = parseGoVersion(stdout)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the version of the compiler '%s': %s", compiler, err.Error())
+	}
+	// The CLI itself may run under Rosetta
+	if system.IsAppleSilicon {
+		result.hostArch = "arm64"
+	}
+
+	stdout, stderr, err = shell.RunCommand(".", compiler, "env", "GOROOT", "GOARCH")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the environment of the compiler '%s': %s - %s", compiler, err.Error(), stderr)
+	}
+	env := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(env) != 2 {
+		return nil, fmt.Errorf("unable to get the environment of the compiler '%s': unexpected output: %s", compiler, stdout)
+	}
+	result.GOROOT, result.GOARCH = strings.TrimSpace(env[0]), strings.TrimSpace(env[1])
+
+	// Older toolchains can't list their targets, in which case they aren't checked
+	result.distList, _, _ = shell.RunCommand(".", compiler, "tool", "dist", "list")
+	return result, nil
+}
+
+// parseGoVersion returns the version, platform and architecture in the output of `go version`,
+// EG: "go version go1.18.1 darwin/amd64"
+func parseGoVersion(output string) (version string, platform string, arch string, err error) {
+	fields := strings.Fields(output)
+	if len(fields) < 4 || fields[0] != "go" || fields[1] != "version" {
+		return "", "", "", fmt.Errorf("unexpected output: %s", strings.TrimSpace(output))
+	}
+	target := strings.Split(fields[len(fields)-1], "/")
+	if len(target) != 2 {
+		return "", "", "", fmt.Errorf("unexpected output: %s", strings.TrimSpace(output))
+	}
+	return fields[2], target[0], target[1], nil
+}
+
+func (t *Toolchain) String() string {
+	return fmt.Sprintf("%s %s/%s", t.Version, t.Platform, t.Arch)
+}
+
+// Check returns an error if the toolchain can't build one of the targets, and warnings if it doesn't run natively
+// on the host. A toolchain for another architecture runs under emulation, such as an amd64 toolchain installed with
+// Homebrew on Apple Silicon, and cgo may link the libraries of the wrong architecture.
+func (t *Toolchain) Check(targets []string) (warnings []string, err error) {
+	if t.Platform != t.hostPlatform || t.Arch != t.hostArch {
+		warnings = append(warnings, fmt.Sprintf("the Go toolchain in '%s' is built for %s/%s and builds for %s by default, but this machine is %s/%s. "+
+			"It runs under emulation, which is slower, and cgo may link libraries of the wrong architecture. "+
+			"Install the Go toolchain for %s/%s from https://go.dev/dl/, or use -compiler to select it",
+			t.GOROOT, t.Platform, t.Arch, t.GOARCH, t.hostPlatform, t.hostArch, t.hostPlatform, t.hostArch))
+	}
+
+	if t.distList == "" {
+		return warnings, nil
+	}
+	for _, target := range targets {
+		platform, arch, err := ParseTarget(target)
+		if err != nil {
+			// Unsupported targets are skipped when building
+			continue
+		}
+		archs := []string{arch}
+		if platform == "darwin" && arch == "universal" {
+			archs = []string{"amd64", "arm64"}
+		}
+		for _, arch := range archs {
+			if !distListContains(t.distList, platform+"/"+arch) {
+				return warnings, fmt.Errorf("the Go toolchain in '%s' (%s) can't build %s/%s: it isn't listed by `%s tool dist list`. Use -compiler to select a toolchain that supports it", t.GOROOT, t.Version, platform, arch, t.Compiler)
+			}
+		}
+	}
+	return warnings, nil
+}
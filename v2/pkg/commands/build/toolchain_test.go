@@ -0,0 +1,69 @@
+package build
+
+import (
+	"testing"
+)
+
+func TestParseGoVersion(t *testing.T) {
+	tests := []struct {
+		output       string
+		wantVersion  string
+		wantPlatform string
+		wantArch     string
+		wantErr      bool
+	}{
+		{"go version go1.18.1 darwin/amd64\n", "go1.18.1", "darwin", "amd64", false},
+		{"go version devel go1.19-abcdef Tue Apr 5 10:00:00 2022 +0000 linux/arm64", "devel", "linux", "arm64", false},
+		{"gccgo (GCC) 12.1.0", "", "", "", true},
+		{"go version go1.18.1", "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.output, func(t *testing.T) {
+			version, platform, arch, err := parseGoVersion(tt.output)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseGoVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if version != tt.wantVersion || platform != tt.wantPlatform || arch != tt.wantArch {
+				t.Errorf("parseGoVersion() = %s, %s, %s, want %s, %s, %s", version, platform, arch, tt.wantVersion, tt.wantPlatform, tt.wantArch)
+			}
+		})
+	}
+}
+
+func TestToolchainCheck(t *testing.T) {
+	distList := "darwin/amd64\ndarwin/arm64\nlinux/amd64\nwindows/amd64\n"
+	tests := []struct {
+		name         string
+		arch         string
+		distList     string
+		targets      []string
+		wantWarnings int
+		wantErr      bool
+	}{
+		{"native", "arm64", distList, []string{"darwin/universal", "windows/amd64"}, 0, false},
+		{"emulated", "amd64", distList, []string{"darwin/arm64"}, 1, false},
+		{"unsupported target", "arm64", distList, []string{"windows/arm64"}, 0, true},
+		{"unknown targets", "arm64", "", []string{"windows/arm64"}, 0, false},
+		{"invalid target", "arm64", distList, []string{"freebsd"}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toolchain := &Toolchain{
+				Compiler:     "go",
+				Version:      "go1.18.1",
+				Platform:     "darwin",
+				Arch:         tt.arch,
+				hostPlatform: "darwin",
+				hostArch:     "arm64",
+				distList:     tt.distList,
+			}
+			warnings, err := toolchain.Check(tt.targets)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("expected %d warnings, got: %v", tt.wantWarnings, warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package build
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tc-hib/winres/version"
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+// ciBuildNumberVariables are the environment variables holding the number of the build on CI services, in the order
+// they are checked: GitHub Actions, GitLab, CircleCI, Travis, Bitrise, Azure Pipelines, then Jenkins and TeamCity
+var ciBuildNumberVariables = []string{
+	"GITHUB_RUN_NUMBER",
+	"CI_PIPELINE_IID",
+	"CIRCLE_BUILD_NUM",
+	"TRAVIS_BUILD_NUMBER",
+	"BITRISE_BUILD_NUMBER",
+	"BUILD_BUILDID",
+	"BUILD_NUMBER",
+}
+
+// AppVersion is the version written to the Info.plist on macOS and the version information on Windows
+type AppVersion struct {
+	ShortVersion string // Empty if not set
+	BuildNumber  int    // 0 if not set
+}
+
+// resolveAppVersion returns the version of the application set in the project config, or nil if it has no short
+// version or build number. The build number defaults to the one of the CI service given in env. A CI build number
+// that can't be used for the platform is left out with a warning, as it wasn't set by the user.
+func resolveAppVersion(info project.Info, platform string, env []string) (result *AppVersion, warnings []string, err error) {
+	if info.ShortVersion == "" && info.BuildNumber == 0 {
+		return nil, nil, nil
+	}
+	result = &AppVersion{
+		ShortVersion: info.ShortVersion,
+		BuildNumber:  info.BuildNumber,
+	}
+	if result.ShortVersion != "" {
+		err = project.ValidateShortVersion(result.ShortVersion)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if result.BuildNumber != 0 {
+		err = project.ValidateBuildNumber(result.BuildNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+		if platform == "windows" && result.BuildNumber > math.MaxUint16 {
+			return nil, nil, fmt.Errorf("invalid build number %d: it must be at most %d for the version information of Windows binaries", result.BuildNumber, math.MaxUint16)
+		}
+		return result, nil, nil
+	}
+	result.BuildNumber = ciBuildNumber(env)
+	if platform == "windows" && result.BuildNumber > math.MaxUint16 {
+		warnings = append(warnings, fmt.Sprintf("the build number %d of the CI service isn't used, as the version information of Windows binaries is limited to %d. Set info.BuildNumber to use another one", result.BuildNumber, math.MaxUint16))
+		result.BuildNumber = 0
+	}
+	return result, warnings, nil
+}
+
+// ciBuildNumber returns the build number of the CI service given in env, or 0 if there is none
+func ciBuildNumber(env []string) int {
+	for _, variable := range ciBuildNumberVariables {
+		buildNumber, err := strconv.Atoi(strings.TrimSpace(envValue(env, variable)))
+		if err == nil && buildNumber > 0 {
+			return buildNumber
+		}
+	}
+	return 0
+}
+
+// setWindowsVersion sets the product version of the version information to the short version, and the file version
+// to the short version followed by the build number. Parts that aren't set are kept from `build/windows/info.json`.
+func setWindowsVersion(info *version.Info, appVersion *AppVersion) {
+	fileVersion := info.FileVersion
+	if appVersion.ShortVersion != "" {
+		fileVersion = [4]uint16{}
+		for index, part := range strings.Split(appVersion.ShortVersion, ".") {
+			number, _ := strconv.Atoi(part)
+			fileVersion[index] = uint16(number)
+		}
+	}
+	if appVersion.BuildNumber != 0 {
+		fileVersion[3] = uint16(appVersion.BuildNumber)
+	}
+	info.SetFileVersion(fmt.Sprintf("%d.%d.%d.%d", fileVersion[0], fileVersion[1], fileVersion[2], fileVersion[3]))
+	if appVersion.ShortVersion != "" {
+		info.SetProductVersion(appVersion.ShortVersion)
+		info.ProductVersion = info.FileVersion
+	}
+}
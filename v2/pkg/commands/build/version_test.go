@@ -0,0 +1,71 @@
+package build
+
+import (
+	"testing"
+
+	"github.com/tc-hib/winres/version"
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func TestResolveAppVersion(t *testing.T) {
+	ci := []string{"GITHUB_RUN_NUMBER=42"}
+	tests := []struct {
+		name     string
+		info     project.Info
+		platform string
+		env      []string
+		want     *AppVersion
+		warnings int
+		wantErr  bool
+	}{
+		{"not set", project.Info{ProductVersion: "1.0.0"}, "darwin", ci, nil, 0, false},
+		{"short version", project.Info{ShortVersion: "1.2.3"}, "darwin", nil, &AppVersion{ShortVersion: "1.2.3"}, 0, false},
+		{"build number", project.Info{ShortVersion: "1.2.3", BuildNumber: 7}, "darwin", ci, &AppVersion{ShortVersion: "1.2.3", BuildNumber: 7}, 0, false},
+		{"ci build number", project.Info{ShortVersion: "1.2.3"}, "darwin", ci, &AppVersion{ShortVersion: "1.2.3", BuildNumber: 42}, 0, false},
+		{"invalid ci build number", project.Info{ShortVersion: "1.2.3"}, "darwin", []string{"BUILD_NUMBER=abc"}, &AppVersion{ShortVersion: "1.2.3"}, 0, false},
+		{"invalid short version", project.Info{ShortVersion: "1.2.3-beta"}, "darwin", nil, nil, 0, true},
+		{"negative build number", project.Info{BuildNumber: -1}, "darwin", nil, nil, 0, true},
+		{"large build number", project.Info{BuildNumber: 100000}, "darwin", nil, &AppVersion{BuildNumber: 100000}, 0, false},
+		{"large build number on windows", project.Info{BuildNumber: 100000}, "windows", nil, nil, 0, true},
+		{"large ci build number on windows", project.Info{ShortVersion: "1.2.3"}, "windows", []string{"BUILD_BUILDID=123456"}, &AppVersion{ShortVersion: "1.2.3"}, 1, false},
+		{"large ci build number", project.Info{ShortVersion: "1.2.3"}, "darwin", []string{"BUILD_BUILDID=123456"}, &AppVersion{ShortVersion: "1.2.3", BuildNumber: 123456}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warnings, err := resolveAppVersion(tt.info, tt.platform, tt.env)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveAppVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(warnings) != tt.warnings {
+				t.Errorf("resolveAppVersion() warnings = %v, want %d", warnings, tt.warnings)
+			}
+			if (got == nil) != (tt.want == nil) || got != nil && *got != *tt.want {
+				t.Errorf("resolveAppVersion() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetBundleVersion(t *testing.T) {
+	plist := "<dict>\n\t<key>CFBundleShortVersionString</key>\n\t<string>1.0.0</string>\n</dict>"
+	want := "<dict>\n\t<key>CFBundleShortVersionString</key>\n\t<string>1.2.3</string>\n\t<key>CFBundleVersion</key><string>42</string>\n</dict>"
+	got := string(setBundleVersion([]byte(plist), &AppVersion{ShortVersion: "1.2.3", BuildNumber: 42}))
+	if got != want {
+		t.Errorf("expected: %q, got: %q", want, got)
+	}
+}
+
+func TestSetWindowsVersion(t *testing.T) {
+	info := &version.Info{}
+	info.SetFileVersion("1.0.0.0")
+
+	setWindowsVersion(info, &AppVersion{BuildNumber: 42})
+	if info.FileVersion != [4]uint16{1, 0, 0, 42} {
+		t.Errorf("expected the file version to be 1.0.0.42, got: %v", info.FileVersion)
+	}
+
+	setWindowsVersion(info, &AppVersion{ShortVersion: "2.1", BuildNumber: 43})
+	if info.FileVersion != [4]uint16{2, 1, 0, 43} || info.ProductVersion != info.FileVersion {
+		t.Errorf("expected the file and product versions to be 2.1.0.43, got: %v and %v", info.FileVersion, info.ProductVersion)
+	}
+}
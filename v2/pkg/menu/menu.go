@@ -2,6 +2,9 @@ package menu
 
 type Menu struct {
 	Items []*MenuItem
+	// Translator resolves the labels of the items in the menu and its submenus, using the labels as keys.
+	// Only the Translator of the application menu is used.
+	Translator Translator
 }
 
 func NewMenu() *Menu {
@@ -51,6 +51,9 @@ type MenuItem struct {
 	// This holds the menu item's parent.
 	parent *MenuItem
 
+	// The label resolved by the Translator of the menu
+	resolvedLabel string
+
 	// Used for locking when removing elements
 	removeLock sync.Mutex
 }
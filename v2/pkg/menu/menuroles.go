@@ -10,7 +10,8 @@ type Role int
 const (
 	AppMenuRole  Role = 1
 	EditMenuRole      = 2
-	//AboutRole              Role = "about"
+	// AboutRole is handled by Wails on all platforms
+	AboutRole Role = 3
 	//UndoRole               Role = "undo"
 	//RedoRole               Role = "redo"
 	//CutRole                Role = "cut"
@@ -35,14 +36,16 @@ const (
 	//SeparatorItemRole      Role = "separatorItem"
 )
 
-/*
-// About provides a MenuItem with the About role
+// About provides a MenuItem that shows the about dialog of the application,
+// with the name, version and copyright from wails.json
 func About() *MenuItem {
 	return &MenuItem{
+		Type: TextType,
 		Role: AboutRole,
 	}
 }
 
+/*
 // Undo provides a MenuItem with the Undo role
 func Undo() *MenuItem {
 	return &MenuItem{
@@ -0,0 +1,45 @@
+package menu
+
+// Translator returns the label to display for the given label key, EG: from a translation catalog
+type Translator func(key string) string
+
+// MapTranslator returns a Translator that looks up labels in the given catalog.
+// Keys that aren't in the catalog are displayed as they are.
+func MapTranslator(catalog map[string]string) Translator {
+	return func(key string) string {
+		if label, exists := catalog[key]; exists {
+			return label
+		}
+		return key
+	}
+}
+
+// ResolveLabels resolves the labels of the items in the menu and its submenus
+// using the Translator of the menu. It is called whenever the menu is built, so
+// setting the application menu again displays the labels of the current Translator.
+func (m *Menu) ResolveLabels() {
+	if m == nil {
+		return
+	}
+	m.resolveLabels(m.Translator)
+}
+
+func (m *Menu) resolveLabels(translator Translator) {
+	for _, item := range m.Items {
+		item.resolvedLabel = item.Label
+		if translator != nil {
+			item.resolvedLabel = translator(item.Label)
+		}
+		if item.SubMenu != nil {
+			item.SubMenu.resolveLabels(translator)
+		}
+	}
+}
+
+// DisplayLabel returns the label displayed for the menu item
+func (m *MenuItem) DisplayLabel() string {
+	if m.resolvedLabel == "" {
+		return m.Label
+	}
+	return m.resolvedLabel
+}
@@ -0,0 +1,31 @@
+package menu
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestResolveLabels(t *testing.T) {
+	is := is.New(t)
+
+	quit := Text("menu.quit", nil, nil)
+	file := SubMenu("menu.file", NewMenuFromItems(quit, Text("Untranslated", nil, nil)))
+	appMenu := NewMenuFromItems(file)
+
+	appMenu.ResolveLabels()
+	is.Equal(file.DisplayLabel(), "menu.file")
+
+	appMenu.Translator = MapTranslator(map[string]string{
+		"menu.file": "Datei",
+		"menu.quit": "Beenden",
+	})
+	appMenu.ResolveLabels()
+	is.Equal(file.DisplayLabel(), "Datei")
+	is.Equal(quit.DisplayLabel(), "Beenden")
+	is.Equal(file.SubMenu.Items[1].DisplayLabel(), "Untranslated")
+
+	appMenu.Translator = nil
+	appMenu.ResolveLabels()
+	is.Equal(quit.DisplayLabel(), "menu.quit")
+}
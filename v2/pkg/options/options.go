@@ -5,6 +5,7 @@ import (
 	"io/fs"
 	"log"
 	"runtime"
+	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/options/linux"
 	"github.com/wailsapp/wails/v2/pkg/options/mac"
@@ -25,32 +26,79 @@ const (
 	Fullscreen WindowStartState = 3
 )
 
+// BindingNamespace defines how bound structs are namespaced in the frontend
+type BindingNamespace int
+
+const (
+	// PackageName namespaces bound structs by their package name, EG: `window.go.services.Auth.Login`
+	PackageName BindingNamespace = 0
+	// PackagePath namespaces bound structs by their package path, relative to the main module,
+	// EG: `window.go.internal.auth.services.Auth.Login`
+	PackagePath BindingNamespace = 1
+)
+
+// NavigationAction is the action taken when the window navigates to a URL
+type NavigationAction int
+
+const (
+	// NavigationDefault allows navigations within the application and takes the ExternalNavigation
+	// action for the others
+	NavigationDefault NavigationAction = 0
+	// NavigationAllow loads the URL in the window
+	NavigationAllow NavigationAction = 1
+	// NavigationCancel cancels the navigation
+	NavigationCancel NavigationAction = 2
+	// NavigationOpenInBrowser cancels the navigation and opens the URL in the default browser
+	NavigationOpenInBrowser NavigationAction = 3
+)
+
 // App contains options for creating the App
 type App struct {
-	Title             string
-	Width             int
-	Height            int
-	DisableResize     bool
-	Fullscreen        bool
-	Frameless         bool
-	MinWidth          int
-	MinHeight         int
-	MaxWidth          int
-	MaxHeight         int
-	StartHidden       bool
-	HideWindowOnClose bool
-	AlwaysOnTop       bool
-	RGBA              *RGBA
-	Assets            fs.FS
-	Menu              *menu.Menu
-	Logger            logger.Logger `json:"-"`
-	LogLevel          logger.LogLevel
-	OnStartup         func(ctx context.Context)                `json:"-"`
-	OnDomReady        func(ctx context.Context)                `json:"-"`
-	OnShutdown        func(ctx context.Context)                `json:"-"`
-	OnBeforeClose     func(ctx context.Context) (prevent bool) `json:"-"`
-	Bind              []interface{}
-	WindowStartState  WindowStartState
+	Title               string
+	Width               int
+	Height              int
+	DisableResize       bool
+	Fullscreen          bool
+	Frameless           bool
+	MinWidth            int
+	MinHeight           int
+	MaxWidth            int
+	MaxHeight           int
+	StartHidden         bool
+	HideWindowOnClose   bool
+	AlwaysOnTop         bool
+	RGBA                *RGBA
+	Assets              fs.FS
+	AssetsBasePath      string
+	DisableAssetCaching bool
+	Menu                *menu.Menu
+	Logger              logger.Logger `json:"-"`
+	LogLevel            logger.LogLevel
+	OnStartup           func(ctx context.Context)                `json:"-"`
+	OnDomReady          func(ctx context.Context)                `json:"-"`
+	OnShutdown          func(ctx context.Context)                `json:"-"`
+	OnBeforeClose       func(ctx context.Context) (prevent bool) `json:"-"`
+	OnPanic             func(details *PanicDetails)              `json:"-"`
+	Bind                []interface{}
+	BindFunctions       map[string]interface{}
+	BindingNamespace    BindingNamespace
+	EnumBind            []interface{}
+	WindowStartState    WindowStartState
+	VersionFlag         bool
+	AutoUpdate          *AutoUpdate
+	WorkingDirectory    string
+	InputEvents         *InputEvents
+	// RestrictWindowLoadURL prevents WindowLoadURL from loading URLs outside the application
+	RestrictWindowLoadURL bool
+	// ExternalNavigation is the action taken when the window navigates from the application to a URL
+	// outside it, EG: when an external link is clicked. Defaults to NavigationOpenInBrowser. Only supported on Windows.
+	ExternalNavigation NavigationAction
+	// OnBeforeNavigate is called with the URL before the window navigates to it. It is called on the main
+	// thread, so it must not block. Only supported on Windows.
+	OnBeforeNavigate func(ctx context.Context, url string) NavigationAction `json:"-"`
+	// OnPermissionRequest is called when a page requests a permission, EG: to use the camera. If it isn't set,
+	// all permissions are allowed. Only supported on Windows.
+	OnPermissionRequest func(ctx context.Context, request PermissionRequest) PermissionState `json:"-"`
 
 	//ContextMenus []*menu.ContextMenu
 	//TrayMenus    []*menu.TrayMenu
@@ -59,6 +107,69 @@ type App struct {
 	Linux   *linux.Options
 }
 
+// ExecutableDirectory sets the working directory to the directory of the executable
+const ExecutableDirectory = "."
+
+// AutoUpdate configures checking for updates of the application
+type AutoUpdate struct {
+	// The URL of the JSON manifest describing the latest version
+	ManifestURL string
+	// The time between checks. Defaults to 24 hours. The first check is made once the frontend has loaded
+	Interval time.Duration
+}
+
+// InputEvents forwards input events from the webview as Wails events, for apps implementing
+// custom zoom or gestures. Events are forwarded at most once per frame.
+type InputEvents struct {
+	// Wheel forwards wheel events as "wails:input:wheel"
+	Wheel bool `json:"wheel"`
+	// Touch forwards touch events as "wails:input:touch"
+	Touch bool `json:"touch"`
+	// Gesture forwards the pinch and rotate gestures of macOS as "wails:input:gesture"
+	Gesture bool `json:"gesture"`
+}
+
+// PermissionKind is a kind of permission requested by a page
+type PermissionKind int
+
+const (
+	PermissionUnknown       PermissionKind = 0
+	PermissionMicrophone    PermissionKind = 1
+	PermissionCamera        PermissionKind = 2
+	PermissionGeolocation   PermissionKind = 3
+	PermissionNotifications PermissionKind = 4
+	PermissionOtherSensors  PermissionKind = 5
+	PermissionClipboardRead PermissionKind = 6
+)
+
+// PermissionState is the decision for a PermissionRequest
+type PermissionState int
+
+const (
+	// PermissionDefault shows the permission prompt of the webview
+	PermissionDefault PermissionState = 0
+	PermissionAllow   PermissionState = 1
+	PermissionDeny    PermissionState = 2
+)
+
+// PermissionRequest is a request of a page for a permission
+type PermissionRequest struct {
+	// The origin of the page, EG: "https://example.com"
+	Origin string
+	Kind   PermissionKind
+	// UserInitiated is set if the request was made in response to the user interacting with the page
+	UserInitiated bool
+}
+
+// PanicDetails describes a panic that crashed the application
+type PanicDetails struct {
+	Time       time.Time
+	Error      error
+	StackTrace string
+	// The file the crash report was written to. Empty if it couldn't be written
+	CrashLog string
+}
+
 type RGBA struct {
 	R uint8 `json:"r"`
 	G uint8 `json:"g"`
@@ -1,5 +1,20 @@
 package windows
 
+// DPIChangePolicy is how the window is resized when it is moved to a monitor with a different DPI
+type DPIChangePolicy int
+
+const (
+	// DPIChangeFollowSuggestion applies the size and position suggested by Windows, which scales the whole window and
+	// keeps the cursor over the same point of it
+	DPIChangeFollowSuggestion DPIChangePolicy = 0
+	// DPIChangeKeepLogicalSize scales the client area of the window exactly by the change in DPI, and sizes the frame
+	// for the new DPI, so the page keeps its size in CSS pixels. The window is placed at the suggested position.
+	DPIChangeKeepLogicalSize DPIChangePolicy = 1
+	// DPIChangeKeepPhysicalSize keeps the size of the window in pixels, so more or less of its content fits on the
+	// new monitor. The window is placed at the suggested position.
+	DPIChangeKeepPhysicalSize DPIChangePolicy = 2
+)
+
 // Options are options specific to Windows
 type Options struct {
 	WebviewIsTransparent bool
@@ -9,7 +24,91 @@ type Options struct {
 	// Draw a border around the window, even if the window is frameless
 	EnableFramelessBorder bool
 
+	// DisableFramelessResize stops the edges of a frameless window resizing it. Unlike DisableResize,
+	// the window can still be maximised and resized with the runtime.
+	DisableFramelessResize bool
+
+	// DisableFramelessDrag stops the elements with the `data-wails-drag` attribute moving a frameless window.
+	// The edges still resize the window, unless DisableFramelessResize is set.
+	DisableFramelessDrag bool
+
+	// DoubleBufferFrameless double-buffers the painting of a frameless window, which stops it flickering while it is
+	// resized. Painting can be slower, so it is off by default. It has no effect on translucent windows.
+	DoubleBufferFrameless bool
+
+	// ConstrainToWorkArea stops the window being dragged so far off-screen that its titlebar can't be reached.
+	// The top of the window is kept within the work area of the monitor under the cursor, with part of it visible.
+	ConstrainToWorkArea bool
+
+	// RememberWindowPlacement saves the position, size and maximised state of the window in the settings store
+	// when the application quits, and restores them the next time it starts
+	RememberWindowPlacement bool
+
+	// SizeConstraintsInPixels treats MinWidth, MinHeight, MaxWidth and MaxHeight as sizes in pixels, which aren't
+	// scaled with the DPI of the monitor the window is on. By default, they are scaled like the size of the window.
+	SizeConstraintsInPixels bool
+
+	// DPIChangePolicy is how the window is resized when it is moved to a monitor with a different DPI.
+	// Defaults to DPIChangeFollowSuggestion.
+	DPIChangePolicy DPIChangePolicy
+
 	// Path where the WebView2 stores the user data. If empty %APPDATA%\[BinaryName.exe] will be used.
 	// If the path is not valid, a messagebox will be displayed with the error and the app will exit with error code.
 	WebviewUserDataPath string
+
+	// WebviewDisableGPU turns off hardware acceleration in the webview, which renders in software instead.
+	// This avoids a black window in some virtual machines and remote desktop sessions, at the cost of performance.
+	WebviewDisableGPU bool
+
+	// WebviewStylesOnLoad are stylesheets added to every page before it is rendered
+	WebviewStylesOnLoad []string
+
+	// WebviewScriptsOnLoad are scripts run in every page before any of its own scripts, including the Wails runtime.
+	// They are run in the order given, after the stylesheets in WebviewStylesOnLoad have been added.
+	WebviewScriptsOnLoad []string
+
+	// WebviewDownloadDirectory is the directory downloads are saved to.
+	// If empty, the Downloads folder of the user is used.
+	WebviewDownloadDirectory string
+
+	// WebviewHideDownloadUI hides the download UI of the webview, for apps showing the progress of
+	// downloads themselves with the download events
+	WebviewHideDownloadUI bool
+
+	// ExitFullscreenOnEscape will leave fullscreen mode when the Escape key is pressed.
+	// Escape is only intercepted while the window is fullscreen, otherwise it is passed through to the frontend.
+	ExitFullscreenOnEscape bool
+
+	// ToolWindow gives the window the tool window style, so it has no taskbar button and isn't shown in Alt+Tab,
+	// EG: for palettes and overlays
+	ToolWindow bool
+
+	// WindowIconFromFavicon sets the icon of the window and its taskbar button to the favicon of the page.
+	// The icon of the application is restored when the page has no favicon. Only .ico and .png favicons are supported,
+	// from the assets, data URLs or the origin of the page.
+	WindowIconFromFavicon bool
+
+	// WindowTitleFromDocument sets the title of the window to the title of the page whenever it changes,
+	// EG: for single page applications updating `document.title` when routing.
+	// Pages without a title use the title of the application.
+	WindowTitleFromDocument bool
+
+	// DisableWindowAnimations turns off the animations of the window when it is shown, hidden, minimised and restored
+	DisableWindowAnimations bool
+
+	// AutomationName is the name of the window announced by screen readers and reported by UI Automation.
+	// If empty, the window title is used.
+	AutomationName string
+
+	// AutomationID identifies the window to UI Automation, EG: for UI test frameworks
+	AutomationID string
+
+	// AppUserModelID identifies the application to Windows, which uses it to group the taskbar buttons,
+	// pin the application and attribute its notifications and jump lists.
+	// If empty, the identifier in the project config is used, or an ID derived from the project name if it isn't set.
+	AppUserModelID string
+
+	// EndSessionBlockReason is shown by Windows if the application is still saving its state in OnBeforeClose
+	// when the user logs off or shuts down the computer. If empty, no reason is shown.
+	EndSessionBlockReason string
 }
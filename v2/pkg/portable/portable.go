@@ -0,0 +1,20 @@
+// Package portable holds the WebView2 runtime embedded in portable Windows builds.
+// It is used by the code generated by `wails build -portable` and isn't intended to be used directly.
+package portable
+
+var (
+	runtimeID      string
+	runtimeArchive []byte
+)
+
+// SetWebView2Runtime sets the zipped WebView2 fixed version runtime embedded in the application.
+// The id uniquely identifies the archive, so it is only extracted once.
+func SetWebView2Runtime(id string, archive []byte) {
+	runtimeID = id
+	runtimeArchive = archive
+}
+
+// WebView2Runtime returns the id and the zipped WebView2 runtime embedded in the application, if any
+func WebView2Runtime() (string, []byte) {
+	return runtimeID, runtimeArchive
+}
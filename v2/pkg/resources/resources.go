@@ -0,0 +1,43 @@
+// Package resources holds the files embedded in the application from the `embed` list of the project config.
+// It is used by the code generated by `wails build` and isn't intended to be used directly. Use runtime.Assets
+// to read the files.
+package resources
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"sync"
+)
+
+var (
+	archive []byte
+
+	fsysOnce sync.Once
+	fsys     fs.FS
+)
+
+// SetArchive sets the zipped resources embedded in the application
+func SetArchive(data []byte) {
+	archive = data
+}
+
+// FS returns the resources embedded in the application. It is empty if there are none.
+func FS() fs.FS {
+	fsysOnce.Do(func() {
+		reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		if err != nil {
+			fsys = emptyFS{}
+			return
+		}
+		fsys = reader
+	})
+	return fsys
+}
+
+// emptyFS is used when no resources are embedded
+type emptyFS struct{}
+
+func (emptyFS) Open(name string) (fs.File, error) {
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
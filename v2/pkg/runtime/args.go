@@ -0,0 +1,23 @@
+package runtime
+
+import (
+	"context"
+	"log"
+	goruntime "runtime"
+)
+
+// Args returns the command-line arguments the application was launched with, without the name of the executable,
+// EG: the path of the file the application was opened with through a file association.
+// In dev mode, the flags used by `wails dev` are left out.
+func Args(ctx context.Context) []string {
+	if ctx == nil {
+		pc, _, _, _ := goruntime.Caller(1)
+		funcName := goruntime.FuncForPC(pc).Name()
+		log.Fatalf("cannot call '%s': context is nil", funcName)
+	}
+	result := ctx.Value("args")
+	if result == nil {
+		log.Fatalf("cannot call 'Args': %s", contextError)
+	}
+	return append([]string{}, result.([]string)...)
+}
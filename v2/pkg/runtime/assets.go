@@ -0,0 +1,32 @@
+package runtime
+
+import (
+	"context"
+	"io/fs"
+	"log"
+	goruntime "runtime"
+
+	"github.com/wailsapp/wails/v2/pkg/resources"
+)
+
+// Assets returns the files embedded in the application from the `embed` list in wails.json.
+// Paths are relative to the project directory, EG: "config/default.json".
+// It may be called before the application has started.
+func Assets() fs.FS {
+	return resources.FS()
+}
+
+// AssetsHash returns the hash of the frontend assets embedded in the application, which changes
+// whenever the assets change. It is computed by `wails build`, or from the assets in dev mode.
+func AssetsHash(ctx context.Context) (string, error) {
+	if ctx == nil {
+		pc, _, _, _ := goruntime.Caller(1)
+		funcName := goruntime.FuncForPC(pc).Name()
+		log.Fatalf("cannot call '%s': context is nil", funcName)
+	}
+	assetsHash, ok := ctx.Value("assetshash").(func() (string, error))
+	if !ok {
+		log.Fatalf("cannot call 'AssetsHash': %s", contextError)
+	}
+	return assetsHash()
+}
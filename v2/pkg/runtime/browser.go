@@ -2,10 +2,19 @@ package runtime
 
 import (
 	"context"
+
+	"github.com/wailsapp/wails/v2/internal/shell"
 )
 
-// BrowserOpenURL uses the system default browser to open the url
+// BrowserOpenURL uses the system default browser to open the url.
+// Only http, https and mailto URLs are opened.
 func BrowserOpenURL(ctx context.Context, url string) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.BrowserOpenURL(url)
 }
+
+// OpenFileInDefaultApp opens the given file in the application associated with its type.
+// Executables and directories are not opened.
+func OpenFileInDefaultApp(ctx context.Context, path string) error {
+	return shell.OpenFile(path)
+}
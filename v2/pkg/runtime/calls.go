@@ -0,0 +1,44 @@
+package runtime
+
+import (
+	"context"
+	"log"
+	goruntime "runtime"
+)
+
+// callProgressEventPrefix is prepended to the call ID to create the name of a call's progress event
+const callProgressEventPrefix = "wails:progress:"
+
+func getCallID(ctx context.Context) string {
+	if ctx == nil {
+		pc, _, _, _ := goruntime.Caller(1)
+		funcName := goruntime.FuncForPC(pc).Name()
+		log.Fatalf("cannot call '%s': context is nil", funcName)
+	}
+	result := ctx.Value("callid")
+	if result != nil {
+		return result.(string)
+	}
+	pc, _, _, _ := goruntime.Caller(1)
+	funcName := goruntime.FuncForPC(pc).Name()
+	log.Fatalf("cannot call '%s': this method requires the context given to a bound method", funcName)
+	return ""
+}
+
+// CallProgress sends progress data to the frontend for the bound method call the given context belongs to.
+// The data is passed to the handlers registered with `onProgress` on the promise of the call.
+// Progress is sent asynchronously, the bound method does not wait for the frontend to process it.
+func CallProgress(ctx context.Context, optionalData ...interface{}) {
+	callID := getCallID(ctx)
+	events := getEvents(ctx)
+	events.Emit(callProgressEventPrefix+callID, optionalData...)
+}
+
+// CallError may be implemented by errors returned from bound methods to send a structured
+// error to the frontend. The promise of the call is rejected with an object containing the
+// `message`, `code` and `details` of the error rather than just the message.
+type CallError interface {
+	error
+	ErrorCode() string
+	ErrorDetails() map[string]interface{}
+}
@@ -0,0 +1,19 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/clipboard"
+)
+
+// ClipboardGetImage returns the image on the clipboard as PNG data, or nil if the clipboard holds no image.
+// Only supported on Windows.
+func ClipboardGetImage(ctx context.Context) ([]byte, error) {
+	return clipboard.GetImage()
+}
+
+// ClipboardSetImage replaces the contents of the clipboard with the image, given as PNG data.
+// Only supported on Windows.
+func ClipboardSetImage(ctx context.Context, png []byte) error {
+	return clipboard.SetImage(png)
+}
@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"context"
+)
+
+// Cursor is the name of a CSS cursor
+type Cursor string
+
+// The cursors that can be set with SetCursor. Any other CSS cursor name may also be used.
+const (
+	CursorDefault    Cursor = "default"
+	CursorPointer    Cursor = "pointer"
+	CursorText       Cursor = "text"
+	CursorCrosshair  Cursor = "crosshair"
+	CursorWait       Cursor = "wait"
+	CursorProgress   Cursor = "progress"
+	CursorMove       Cursor = "move"
+	CursorNotAllowed Cursor = "not-allowed"
+	CursorGrab       Cursor = "grab"
+	CursorGrabbing   Cursor = "grabbing"
+	CursorResizeEW   Cursor = "ew-resize"
+	CursorResizeNS   Cursor = "ns-resize"
+	CursorResizeNESW Cursor = "nesw-resize"
+	CursorResizeNWSE Cursor = "nwse-resize"
+	CursorHelp       Cursor = "help"
+	CursorZoomIn     Cursor = "zoom-in"
+	CursorZoomOut    Cursor = "zoom-out"
+)
+
+// SetCursor sets the cursor shown over the whole window, overriding the cursors set by the page.
+// An empty cursor restores the cursors of the page.
+func SetCursor(ctx context.Context, cursor Cursor) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetCursor(string(cursor))
+}
+
+// SetCustomCursor sets the cursor shown over the whole window to the given PNG or ICO image.
+// The hotspot is the point of the image that is the position of the cursor.
+func SetCustomCursor(ctx context.Context, image []byte, hotspotX int, hotspotY int) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetCustomCursor(image, hotspotX, hotspotY)
+}
+
+// HideCursor hides the cursor while it is over the window
+func HideCursor(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowHideCursor()
+}
+
+// ShowCursor shows the cursor again after HideCursor
+func ShowCursor(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowShowCursor()
+}
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"github.com/wailsapp/wails/v2/internal/features"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/fs"
 )
@@ -30,6 +31,9 @@ type MessageDialogOptions = frontend.MessageDialogOptions
 
 // OpenDirectoryDialog prompts the user to select a directory
 func OpenDirectoryDialog(ctx context.Context, dialogOptions OpenDialogOptions) (string, error) {
+	if !features.Enabled(features.Dialogs) {
+		return "", features.Disabled(features.Dialogs)
+	}
 	appFrontend := getFrontend(ctx)
 	if dialogOptions.DefaultDirectory != "" {
 		if !fs.DirExists(dialogOptions.DefaultDirectory) {
@@ -41,6 +45,9 @@ func OpenDirectoryDialog(ctx context.Context, dialogOptions OpenDialogOptions) (
 
 // OpenFileDialog prompts the user to select a file
 func OpenFileDialog(ctx context.Context, dialogOptions OpenDialogOptions) (string, error) {
+	if !features.Enabled(features.Dialogs) {
+		return "", features.Disabled(features.Dialogs)
+	}
 	appFrontend := getFrontend(ctx)
 	if dialogOptions.DefaultDirectory != "" {
 		if !fs.DirExists(dialogOptions.DefaultDirectory) {
@@ -52,6 +59,9 @@ func OpenFileDialog(ctx context.Context, dialogOptions OpenDialogOptions) (strin
 
 // OpenMultipleFilesDialog prompts the user to select a file
 func OpenMultipleFilesDialog(ctx context.Context, dialogOptions OpenDialogOptions) ([]string, error) {
+	if !features.Enabled(features.Dialogs) {
+		return nil, features.Disabled(features.Dialogs)
+	}
 	appFrontend := getFrontend(ctx)
 	if dialogOptions.DefaultDirectory != "" {
 		if !fs.DirExists(dialogOptions.DefaultDirectory) {
@@ -63,6 +73,9 @@ func OpenMultipleFilesDialog(ctx context.Context, dialogOptions OpenDialogOption
 
 // SaveFileDialog prompts the user to select a file
 func SaveFileDialog(ctx context.Context, dialogOptions SaveDialogOptions) (string, error) {
+	if !features.Enabled(features.Dialogs) {
+		return "", features.Disabled(features.Dialogs)
+	}
 	appFrontend := getFrontend(ctx)
 	if dialogOptions.DefaultDirectory != "" {
 		if !fs.DirExists(dialogOptions.DefaultDirectory) {
@@ -74,6 +87,16 @@ func SaveFileDialog(ctx context.Context, dialogOptions SaveDialogOptions) (strin
 
 // MessageDialog show a message dialog to the user
 func MessageDialog(ctx context.Context, dialogOptions MessageDialogOptions) (string, error) {
+	if !features.Enabled(features.Dialogs) {
+		return "", features.Disabled(features.Dialogs)
+	}
 	appFrontend := getFrontend(ctx)
 	return appFrontend.MessageDialog(dialogOptions)
 }
+
+// ShowAboutDialog shows the about dialog of the application, with the name, version and copyright from wails.json
+func ShowAboutDialog(ctx context.Context) error {
+	appFrontend := getFrontend(ctx)
+	info, _ := ctx.Value("aboutinfo").(frontend.AboutInfo)
+	return appFrontend.ShowAboutDialog(info)
+}
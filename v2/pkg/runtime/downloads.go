@@ -0,0 +1,30 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// Download describes a file being downloaded by the webview
+type Download = frontend.Download
+
+// The events emitted with a Download as the webview downloads a file. Only supported on Windows.
+const (
+	DownloadStartedEvent   = frontend.DownloadStartedEvent
+	DownloadProgressEvent  = frontend.DownloadProgressEvent
+	DownloadCompletedEvent = frontend.DownloadCompletedEvent
+)
+
+// The states of a Download
+const (
+	DownloadInProgress  = frontend.DownloadInProgress
+	DownloadCompleted   = frontend.DownloadCompleted
+	DownloadInterrupted = frontend.DownloadInterrupted
+)
+
+// DownloadCancel cancels the download with the given ID. Only supported on Windows.
+func DownloadCancel(ctx context.Context, id string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.DownloadCancel(id)
+}
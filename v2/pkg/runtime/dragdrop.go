@@ -0,0 +1,13 @@
+package runtime
+
+import (
+	"context"
+)
+
+// StartDrag drags the files, given by their absolute paths, from the window to other applications, EG: to Explorer.
+// It must be called while the left mouse button is held down, and returns once the files have been dropped or
+// the drag has been cancelled. Only supported on Windows.
+func StartDrag(ctx context.Context, files []string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.StartDrag(files)
+}
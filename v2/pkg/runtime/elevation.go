@@ -0,0 +1,33 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/elevation"
+)
+
+// The errors returned by RelaunchElevated, for use with errors.Is
+var (
+	ErrElevationCancelled    = elevation.ErrCancelled
+	ErrAlreadyElevated       = elevation.ErrAlreadyElevated
+	ErrElevationNotSupported = elevation.ErrNotSupported
+)
+
+// IsElevated returns true if the application is running with administrator rights, or as root on macOS and Linux
+func IsElevated() bool {
+	return elevation.IsElevated()
+}
+
+// RelaunchElevated restarts the application with administrator rights and the same arguments, once the user has
+// accepted the UAC prompt. The running instance quits once the elevated one has been started.
+// ErrElevationCancelled is returned, and the application keeps running, if the prompt is cancelled.
+// Only supported on Windows.
+func RelaunchElevated(ctx context.Context) error {
+	appFrontend := getFrontend(ctx)
+	err := elevation.Relaunch()
+	if err != nil {
+		return err
+	}
+	appFrontend.Quit()
+	return nil
+}
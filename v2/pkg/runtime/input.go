@@ -0,0 +1,22 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// The events input events are forwarded as
+const (
+	InputWheelEvent   = frontend.InputWheelEvent
+	InputTouchEvent   = frontend.InputTouchEvent
+	InputGestureEvent = frontend.InputGestureEvent
+)
+
+// SetInputEvents sets which input events are forwarded from the webview as Wails events.
+// It replaces the InputEvents option until the frontend is reloaded.
+func SetInputEvents(ctx context.Context, events options.InputEvents) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetInputEvents(events)
+}
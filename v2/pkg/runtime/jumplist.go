@@ -0,0 +1,25 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/jumplist"
+)
+
+// JumpListTask is an entry in the Tasks category of the jump list on Windows
+type JumpListTask = jumplist.Task
+
+// JumpListTaskEvent is emitted with the ID of the task when the application is launched from a jump list task
+const JumpListTaskEvent = jumplist.TaskEvent
+
+// SetJumpListTasks replaces the tasks shown in the jump list of the application on Windows.
+// The jump list belongs to the AppUserModelID of the application.
+func SetJumpListTasks(ctx context.Context, tasks []JumpListTask) error {
+	return jumplist.SetTasks(tasks)
+}
+
+// AddRecentDocument adds a file to the recent documents of the application, shown in its jump list on Windows.
+// The path must be absolute.
+func AddRecentDocument(ctx context.Context, path string) error {
+	return jumplist.AddRecentDocument(path)
+}
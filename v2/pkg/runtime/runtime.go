@@ -67,6 +67,18 @@ func Quit(ctx context.Context) {
 	appFrontend.Quit()
 }
 
+// QuitWithExitCode quits the application like Quit, and the process exits with the given code once the application
+// has shut down. If OnBeforeClose prevents the application from quitting, the code is used when it quits later.
+func QuitWithExitCode(ctx context.Context, exitCode int) {
+	if ctx == nil {
+		log.Fatalf("cannot call QuitWithExitCode: context is nil")
+	}
+	if code, ok := ctx.Value("exitcode").(*frontend.ExitCode); ok {
+		code.Set(exitCode)
+	}
+	Quit(ctx)
+}
+
 type EnvironmentInfo struct {
 	BuildType string `json:"buildtype"`
 }
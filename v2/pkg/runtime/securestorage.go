@@ -0,0 +1,36 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/securestorage"
+)
+
+// SecureStorageError is returned by the secure storage methods. Its code is one of
+// "NotFound", "AccessDenied", "NotSupported" or "InvalidKey".
+type SecureStorageError = securestorage.Error
+
+// The errors returned by the secure storage methods, for use with errors.Is
+var (
+	ErrSecureStorageNotFound     = securestorage.ErrNotFound
+	ErrSecureStorageAccessDenied = securestorage.ErrAccessDenied
+	ErrSecureStorageNotSupported = securestorage.ErrNotSupported
+)
+
+// SecureStorageGet returns the secret stored with the given key.
+// Returns ErrSecureStorageNotFound if there is no secret with the key.
+func SecureStorageGet(ctx context.Context, key string) (string, error) {
+	return securestorage.Get(key)
+}
+
+// SecureStorageSet stores a secret with the given key in the credential store of the operating system,
+// replacing any existing secret
+func SecureStorageSet(ctx context.Context, key string, value string) error {
+	return securestorage.Set(key, value)
+}
+
+// SecureStorageDelete deletes the secret stored with the given key.
+// Returns ErrSecureStorageNotFound if there is no secret with the key.
+func SecureStorageDelete(ctx context.Context, key string) error {
+	return securestorage.Delete(key)
+}
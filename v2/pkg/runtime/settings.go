@@ -0,0 +1,96 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	goruntime "runtime"
+
+	"github.com/wailsapp/wails/v2/internal/settings"
+)
+
+func getSettings(ctx context.Context) *settings.Store {
+	if ctx == nil {
+		pc, _, _, _ := goruntime.Caller(1)
+		funcName := goruntime.FuncForPC(pc).Name()
+		log.Fatalf("cannot call '%s': context is nil", funcName)
+	}
+	result := ctx.Value("settings")
+	if result != nil {
+		return result.(*settings.Store)
+	}
+	pc, _, _, _ := goruntime.Caller(1)
+	funcName := goruntime.FuncForPC(pc).Name()
+	log.Fatalf("cannot call '%s': %s", funcName, contextError)
+	return nil
+}
+
+// SettingsGet unmarshals the setting with the given key into target, which must be a pointer.
+// Returns false if the setting doesn't exist.
+func SettingsGet(ctx context.Context, key string, target interface{}) (bool, error) {
+	value, exists, err := getSettings(ctx).Get(key)
+	if err != nil || !exists {
+		return false, err
+	}
+	return true, json.Unmarshal(value, target)
+}
+
+// SettingsGetString returns the string setting with the given key, or defaultValue if it doesn't
+// exist or isn't a string
+func SettingsGetString(ctx context.Context, key string, defaultValue string) string {
+	result := defaultValue
+	getSettingOrDefault(ctx, key, &result, defaultValue)
+	return result
+}
+
+// SettingsGetBool returns the boolean setting with the given key, or defaultValue if it doesn't
+// exist or isn't a boolean
+func SettingsGetBool(ctx context.Context, key string, defaultValue bool) bool {
+	result := defaultValue
+	getSettingOrDefault(ctx, key, &result, defaultValue)
+	return result
+}
+
+// SettingsGetInt returns the integer setting with the given key, or defaultValue if it doesn't
+// exist or isn't an integer
+func SettingsGetInt(ctx context.Context, key string, defaultValue int) int {
+	result := defaultValue
+	getSettingOrDefault(ctx, key, &result, defaultValue)
+	return result
+}
+
+// SettingsGetFloat returns the numeric setting with the given key, or defaultValue if it doesn't
+// exist or isn't a number
+func SettingsGetFloat(ctx context.Context, key string, defaultValue float64) float64 {
+	result := defaultValue
+	getSettingOrDefault(ctx, key, &result, defaultValue)
+	return result
+}
+
+// getSettingOrDefault unmarshals the setting into target, or leaves the default value in target
+func getSettingOrDefault(ctx context.Context, key string, target interface{}, defaultValue interface{}) {
+	value, exists, err := getSettings(ctx).Get(key)
+	if err != nil || !exists {
+		return
+	}
+	if json.Unmarshal(value, target) != nil {
+		// Unmarshalling may have partially modified the target
+		data, _ := json.Marshal(defaultValue)
+		_ = json.Unmarshal(data, target)
+	}
+}
+
+// SettingsSet sets the setting with the given key. The value is saved as JSON.
+func SettingsSet(ctx context.Context, key string, value interface{}) error {
+	return getSettings(ctx).Set(key, value)
+}
+
+// SettingsDelete deletes the setting with the given key
+func SettingsDelete(ctx context.Context, key string) error {
+	return getSettings(ctx).Delete(key)
+}
+
+// SettingsPath returns the path of the file the settings are saved to
+func SettingsPath(ctx context.Context) string {
+	return getSettings(ctx).Path()
+}
@@ -0,0 +1,17 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/startup"
+)
+
+// StartupMetrics are the times at which the phases of starting the application were reached, since the process
+// started, and the peak memory of the process
+type StartupMetrics = startup.Metrics
+
+// GetStartupMetrics returns the startup metrics of the application. They are only recorded in debug builds and
+// builds made with `wails build -profile`.
+func GetStartupMetrics(ctx context.Context) (StartupMetrics, error) {
+	return startup.MetricsFromContext(ctx)
+}
@@ -0,0 +1,64 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wailsapp/wails/v2/internal/updater"
+)
+
+// UpdateInfo describes a newer version of the application
+type UpdateInfo = updater.Update
+
+// UpdateProgress describes the progress of ApplyUpdate
+type UpdateProgress = updater.Progress
+
+func getUpdater(ctx context.Context) (*updater.Updater, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("context is nil")
+	}
+	result := ctx.Value("updater")
+	if result == nil {
+		return nil, fmt.Errorf("AutoUpdate is not configured in the application options")
+	}
+	return result.(*updater.Updater), nil
+}
+
+// UpdateCheck checks for a newer version of the application. Returns nil if the application is up to date.
+func UpdateCheck(ctx context.Context) (*UpdateInfo, error) {
+	appUpdater, err := getUpdater(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return appUpdater.Check(ctx)
+}
+
+// UpdateDownload downloads the latest version found by the update checks and verifies its checksum.
+// Returns the path of the downloaded file. Applying the update is left to the application.
+func UpdateDownload(ctx context.Context) (string, error) {
+	appUpdater, err := getUpdater(ctx)
+	if err != nil {
+		return "", err
+	}
+	return appUpdater.Download(ctx)
+}
+
+// ApplyUpdate downloads and verifies the latest version found by the update checks, replaces the
+// application with it and relaunches it. Progress is emitted in the `wails:update:progress` event.
+// Only supported on Windows.
+func ApplyUpdate(ctx context.Context) error {
+	appUpdater, err := getUpdater(ctx)
+	if err != nil {
+		return err
+	}
+	err = appUpdater.Apply(ctx)
+	if err != nil {
+		return err
+	}
+	err = updater.Relaunch()
+	if err != nil {
+		return err
+	}
+	getFrontend(ctx).Quit()
+	return nil
+}
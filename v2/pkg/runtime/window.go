@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
@@ -36,12 +37,37 @@ func WindowReload(ctx context.Context) {
 	appFrontend.WindowReload()
 }
 
+// WindowLoadURL loads the given URL in the window. Relative URLs, EG: "settings.html",
+// load the pages in the assets of the application. If options.App.RestrictWindowLoadURL is
+// set, an error is returned for URLs outside the application.
+func WindowLoadURL(ctx context.Context, url string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowLoadURL(url)
+}
+
+// NavigationStartEvent is emitted with the URL the window is navigating to. Only supported on Windows.
+const NavigationStartEvent = frontend.NavigationStartEvent
+
+// NavigationCompleteEvent is emitted with the URL of the page and whether it loaded successfully
+// once the navigation of the window has completed. Only supported on Windows.
+const NavigationCompleteEvent = frontend.NavigationCompleteEvent
+
+// EndSessionEvent is emitted when the user logs off or shuts down the computer, so the application can save
+// its work before it is closed. Only supported on Windows.
+const EndSessionEvent = frontend.EndSessionEvent
+
 // WindowShow shows the window if hidden
 func WindowShow(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.WindowShow()
 }
 
+// WindowShowNoActivate shows the window if hidden, without taking the focus from the application the user is in
+func WindowShowNoActivate(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowShowNoActivate()
+}
+
 // WindowHide the window
 func WindowHide(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
@@ -110,3 +136,54 @@ func WindowSetRGBA(ctx context.Context, col *options.RGBA) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.WindowSetRGBA(col)
 }
+
+// WindowSetIgnoreMouseEvents sets whether mouse events pass through the window to the windows beneath it
+func WindowSetIgnoreMouseEvents(ctx context.Context, ignore bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetIgnoreMouseEvents(ignore)
+}
+
+// WindowSetAlwaysOnBottom sets whether the window is kept beneath all other windows, EG: for desktop widgets
+func WindowSetAlwaysOnBottom(ctx context.Context, bottom bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetAlwaysOnBottom(bottom)
+}
+
+// EnterPresentationMode makes the window fullscreen and hides the cursor while it is over the window, EG: for a
+// slideshow. On Windows, the display is also kept on and the computer kept awake.
+func EnterPresentationMode(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowEnterPresentationMode()
+}
+
+// ExitPresentationMode leaves presentation mode, restoring the state of the window from before it was entered
+func ExitPresentationMode(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowExitPresentationMode()
+}
+
+// WindowSetEnabled enables or disables mouse and keyboard input to the window, EG: while a native dialog is shown.
+// Not supported on macOS.
+func WindowSetEnabled(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetEnabled(enabled)
+}
+
+// WindowIsEnabled returns true if the window accepts mouse and keyboard input
+func WindowIsEnabled(ctx context.Context) bool {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowIsEnabled()
+}
+
+// ThumbnailButton is a button in the toolbar of the taskbar thumbnail of the window on Windows
+type ThumbnailButton = frontend.ThumbnailButton
+
+// ThumbnailButtonClickEvent is emitted to the frontend with the ID of a thumbnail button when it is clicked
+const ThumbnailButtonClickEvent = frontend.ThumbnailButtonClickEvent
+
+// WindowSetThumbnailButtons replaces the buttons in the toolbar of the taskbar thumbnail of the window.
+// There is a maximum of 7 buttons. Only supported on Windows.
+func WindowSetThumbnailButtons(ctx context.Context, buttons []ThumbnailButton) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetThumbnailButtons(buttons)
+}
@@ -4,9 +4,14 @@ package wails
 
 import (
 	app "github.com/wailsapp/wails/v2/internal/appng"
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
+// ExitError is returned by Run when the application was quit with runtime.QuitWithExitCode and a non zero code.
+// The process isn't exited by Run, so the caller can exit it with Code.
+type ExitError = frontend.ExitError
+
 // Run creates an application based on the given config and executes it
 func Run(options *options.App) error {
 